@@ -0,0 +1,70 @@
+package main
+
+import (
+	"flag"
+	_ "github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/cmd/internal"
+	"github.com/rmarken5/mini-score/service/internal/digest"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rs/zerolog"
+	"os"
+	"time"
+	_ "time/tzdata"
+)
+
+const dateLayout = "2006-01-02"
+
+func init() {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		panic(err)
+	}
+	// Days are counted in the timezone the site shows scores in
+	time.Local = loc
+}
+
+// digest emails subscribers the previous day's finals of their teams. It is meant to be run once each morning.
+func main() {
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("service", "digest").Logger()
+
+	dateFlag := flag.String("date", "", "day of the finals to send, as YYYY-MM-DD; yesterday when empty")
+	flag.Parse()
+
+	date := time.Now().AddDate(0, 0, -1)
+	if *dateFlag != "" {
+		parsed, err := time.ParseInLocation(dateLayout, *dateFlag, time.Local)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("invalid date")
+		}
+		date = parsed
+	}
+
+	siteURL := os.Getenv("SITE_URL")
+	smtpConfig := digest.SMTPConfig{
+		Addr:     os.Getenv("SMTP_ADDR"),
+		Username: os.Getenv("SMTP_USERNAME"),
+		Password: os.Getenv("SMTP_PASSWORD"),
+		From:     os.Getenv("DIGEST_FROM"),
+	}
+	if siteURL == "" || smtpConfig.Addr == "" || smtpConfig.From == "" {
+		logger.Fatal().Msg("SITE_URL, SMTP_ADDR and DIGEST_FROM must be set")
+	}
+
+	db := internal.MustConnectDatabase(logger)
+	defer db.Close()
+	nflFacade := nflfacade.NewScoreboardFacade(logger, db)
+	fetch := fetcher.NewFetcher(httpclient.New(httpclient.StatsAPIConfig()))
+	mlbFacade := mlbfacade.NewScoreFacadeImpl(logger, fetch, fetch, fetch, fetch, fetch, fetch, nil, nil)
+
+	d := digest.NewDigest(logger, nflFacade, digest.NewSMTPMailer(smtpConfig), siteURL,
+		nflFacade.GetFinals,
+		func(date time.Time) ([]digest.Final, error) { return mlbfacade.ProcessFinals(mlbFacade, date) },
+	)
+	if err := d.Send(date); err != nil {
+		logger.Fatal().Err(err).Msg("while sending digest")
+	}
+}
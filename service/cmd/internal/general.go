@@ -3,23 +3,15 @@ package internal
 import (
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/rs/zerolog"
 	"os"
 	"time"
 )
 
 func MustConnectDatabase(logger zerolog.Logger) *sqlx.DB {
-	user := os.Getenv("POSTGRES_USER")
-	password := os.Getenv("POSTGRES_PASSWORD")
-	database := os.Getenv("POSTGRES_DATABASE")
-	host := os.Getenv("POSTGRES_HOST")
-	port := os.Getenv("POSTGRES_PORT")
-	sslMode := os.Getenv("POSTGRES_SSL_MODE")
-	options := os.Getenv("POSTGRES_OPTION")
-
-	connStr := fmt.Sprintf("user=%s password=%s dbname=%s host=%s port=%s sslmode=%s options=%s", user, password, database, host, port, sslMode, options)
 	// Create the connection pool
-	db, err := sqlx.Connect("postgres", connStr)
+	db, err := sqlx.Connect("postgres", connectionString())
 	if err != nil {
 		logger.Fatal().Err(err).Msg("error opening database connection")
 	}
@@ -29,3 +21,42 @@ func MustConnectDatabase(logger zerolog.Logger) *sqlx.DB {
 
 	return db
 }
+
+// MustListenDatabase returns the payloads notified on channel. An empty payload is sent after the connection was
+// lost and notifications may have been missed.
+func MustListenDatabase(logger zerolog.Logger, channel string) <-chan string {
+	logger = logger.With().Str("channel", channel).Logger()
+	listener := pq.NewListener(connectionString(), 10*time.Second, time.Minute, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			logger.Error().Err(err).Msg("while listening to the database")
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		logger.Fatal().Err(err).Msg("error listening to the database")
+	}
+
+	payloads := make(chan string)
+	go func() {
+		defer close(payloads)
+		for notification := range listener.Notify {
+			payload := ""
+			if notification != nil {
+				payload = notification.Extra
+			}
+			payloads <- payload
+		}
+	}()
+	return payloads
+}
+
+func connectionString() string {
+	user := os.Getenv("POSTGRES_USER")
+	password := os.Getenv("POSTGRES_PASSWORD")
+	database := os.Getenv("POSTGRES_DATABASE")
+	host := os.Getenv("POSTGRES_HOST")
+	port := os.Getenv("POSTGRES_PORT")
+	sslMode := os.Getenv("POSTGRES_SSL_MODE")
+	options := os.Getenv("POSTGRES_OPTION")
+
+	return fmt.Sprintf("user=%s password=%s dbname=%s host=%s port=%s sslmode=%s options=%s", user, password, database, host, port, sslMode, options)
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rs/zerolog"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// RateLimitConfig reads the rate limiting configuration for the public server from the environment.
+func RateLimitConfig(logger zerolog.Logger) rate_limit.Config {
+	cfg := rate_limit.Config{
+		Default: rate_limit.Budget{
+			Rate:  floatEnv(logger, "RATE_LIMIT_RPS", 2),
+			Burst: intEnv(logger, "RATE_LIMIT_BURST", 20),
+		},
+		Historical: rate_limit.Budget{
+			Rate:  floatEnv(logger, "HISTORICAL_RATE_LIMIT_RPS", 0.1),
+			Burst: intEnv(logger, "HISTORICAL_RATE_LIMIT_BURST", 5),
+		},
+		TrustedProxyHeader: os.Getenv("TRUSTED_PROXY_HEADER"),
+	}
+	if ranges := os.Getenv("TRUSTED_PROXY_RANGES"); ranges != "" {
+		cfg.TrustedProxyRanges = strings.Split(ranges, ",")
+	}
+	return cfg
+}
+
+func floatEnv(logger zerolog.Logger, key string, fallback float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("invalid value for %s", key)
+	}
+	return f
+}
+
+func intEnv(logger zerolog.Logger, key string, fallback int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return fallback
+	}
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		logger.Fatal().Err(err).Msgf("invalid value for %s", key)
+	}
+	return i
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	_ "github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/cmd/internal"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+	_ "time/tzdata"
+)
+
+const (
+	dateLayout    = "2006-01-02"
+	defaultServer = "http://localhost:8080"
+)
+
+// teamsFlag collects --team values, which may also be separated by commas.
+type teamsFlag []string
+
+func (t *teamsFlag) String() string {
+	return strings.Join(*t, ",")
+}
+
+func (t *teamsFlag) Set(value string) error {
+	teams := favorites.ParseTeams(value, ",")
+	if len(teams) == 0 {
+		return fmt.Errorf("%q is not a team abbreviation", value)
+	}
+	*t = append(*t, teams...)
+	return nil
+}
+
+// miniscore prints scoreboards in the terminal, and with --watch keeps them up to date like top.
+func main() {
+	server := defaultServer
+	if env := os.Getenv("MINISCORE_SERVER"); env != "" {
+		server = env
+	}
+	columns, _ := strconv.Atoi(os.Getenv("COLUMNS"))
+
+	var teams teamsFlag
+	flag.StringVar(&server, "server", server, "mini-score server to get boards from, also set by MINISCORE_SERVER")
+	direct := flag.Bool("direct", false, "get MLB boards from statsapi and NFL boards from the database (POSTGRES_ variables) instead of a server")
+	sport := flag.String("sport", "all", "sport to show: nfl, mlb or all")
+	flag.Var(&teams, "team", "only show games of the team, may be repeated or separated by commas")
+	date := flag.String("date", "", "day to show as YYYY-MM-DD; today when empty")
+	watch := flag.Bool("watch", false, "redraw the boards in place when scores change")
+	interval := flag.Duration("interval", 30*time.Second, "time between refreshes with --watch, at least "+cli.MinInterval.String())
+	flag.IntVar(&columns, "cols", columns, "terminal width boards are fit to, also set by COLUMNS")
+	color := flag.Bool("color", isTerminal(os.Stdout), "draw boards in color, on by default in a terminal")
+	flag.Parse()
+
+	opts := cli.Options{Teams: teams, Location: location(), Text: renderer.TextOptions{Width: columns, PerLine: 3, Color: *color},
+		ClearScreen: isTerminal(os.Stdout)}
+	switch *sport {
+	case "all":
+		opts.Sports = favorites.Sports
+	case string(favorites.NFL), string(favorites.MLB):
+		opts.Sports = []favorites.Sport{favorites.Sport(*sport)}
+	default:
+		exit(fmt.Errorf("unknown sport %q, use nfl, mlb or all", *sport))
+	}
+	if *date != "" {
+		parsed, err := time.ParseInLocation(dateLayout, *date, opts.Location)
+		if err != nil {
+			exit(fmt.Errorf("date must be YYYY-MM-DD: %w", err))
+		}
+		opts.Date = parsed
+	}
+
+	var source cli.Source = cli.NewAPISource(&http.Client{Timeout: 15 * time.Second}, server)
+	if *direct {
+		source = directSource()
+	}
+	client := cli.NewClient(source, opts)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	if *watch {
+		if err := client.Watch(ctx, os.Stdout, *interval); err != nil {
+			exit(err)
+		}
+		return
+	}
+
+	out, err := client.Draw(ctx)
+	fmt.Print(out)
+	if err != nil {
+		os.Exit(1)
+	}
+}
+
+// directSource reads boards without a server. Logs are dropped so they do not draw over the boards.
+func directSource() *cli.DirectSource {
+	logger := zerolog.Nop()
+	fetch := fetcher.NewFetcher(httpclient.New(httpclient.StatsAPIConfig()))
+	source := &cli.DirectSource{MLB: mlbfacade.NewScoreFacadeImpl(logger, fetch, fetch, fetch, fetch, fetch, fetch, nil, nil)}
+	if os.Getenv("POSTGRES_HOST") != "" {
+		source.NFL = nflfacade.NewScoreboardFacade(logger, internal.MustConnectDatabase(logger))
+	}
+	return source
+}
+
+// location is the timezone named by TZ, so the server is told it, or the local timezone.
+func location() *time.Location {
+	if name := os.Getenv("TZ"); name != "" {
+		if loc, err := time.LoadLocation(name); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+func exit(err error) {
+	fmt.Fprintln(os.Stderr, "miniscore:", err)
+	os.Exit(2)
+}
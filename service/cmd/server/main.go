@@ -6,13 +6,32 @@ import (
 	"github.com/labstack/echo/v4/middleware"
 	_ "github.com/lib/pq"
 	"github.com/rmarken5/mini-score/service/cmd/internal"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/digest"
+	"github.com/rmarken5/mini-score/service/internal/finger"
+	"github.com/rmarken5/mini-score/service/internal/gopher"
+	"github.com/rmarken5/mini-score/service/internal/graph"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
 	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
 	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
 	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/rest/color"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rest/format"
 	"github.com/rmarken5/mini-score/service/internal/rest/http/handlers"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rmarken5/mini-score/service/internal/rest/slash"
+	"github.com/rmarken5/mini-score/service/internal/rest/terminal"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
 	agent "github.com/rmarken5/mini-score/service/internal/rest/user-agent"
+	"github.com/rmarken5/mini-score/service/internal/rpc"
+	"github.com/rmarken5/mini-score/service/internal/rpc/scorespb"
+	"github.com/rmarken5/mini-score/service/internal/shell"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
 	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
 	"log"
+	"net"
 	h "net/http"
 	"os"
 	"time"
@@ -31,24 +50,149 @@ func init() {
 
 func main() {
 	logger := createLogger()
-	httpClient := &h.Client{}
+	httpClient := httpclient.New(httpclient.StatsAPIConfig())
 	fetch := fetcher.NewFetcher(httpClient)
-	mlbFacade := mlbfacade.NewScoreFacadeImpl(fetch, fetch)
 	nflFacade := nflfacade.NewScoreboardFacade(logger, internal.MustConnectDatabase(logger))
+	// MLB finals are kept in the database with the NFL ones, so the MLB feed outlives the server. MLB webhooks go to
+	// the webhooks the scheduler sends NFL events to.
+	webhooks := webhook.NewDispatcher(logger, httpclient.New(httpclient.WebhookConfig()), nflFacade.WebhookStore())
+	mlbFacade := mlbfacade.NewScoreFacadeImpl(logger, fetch, fetch, fetch, fetch, fetch, fetch, nflFacade, webhooks)
+	go mlbFacade.PollLiveGames(nil, mlbfacade.DefaultPollInterval)
 
 	s := handlers.NewServer(mlbFacade, nflFacade)
 
+	rateLimits := internal.RateLimitConfig(logger)
+	ipExtractor, err := rate_limit.IPExtractor(rateLimits)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("while configuring client ip extraction")
+	}
+	// Past dates are counted against one budget per client, by the routes and the protocols that load them.
+	historical := rate_limit.NewHistorical(rateLimits.Historical)
+
 	idxHandler := handlers.NewIndexHandler(&log.Logger{})
+	favoritesHandler := handlers.NewFavoritesHandler(logger, fetch, nflFacade)
 	e := echo.New()
+	e.IPExtractor = ipExtractor
+	e.Use(rate_limit.Limit(rateLimits.Default))
+	e.Use(rate_limit.HandleClient)
 	e.Use(agent.HandleUserAgent)
+	e.Use(favorites.HandleFavorites)
+	e.Use(timezone.HandleTimezone)
+	e.Use(terminal.HandleColumns)
+	e.Use(color.HandleColor)
+	e.Use(format.HandleFormat)
 	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
 		Level: 5,
 	}))
 	e.GET("/", idxHandler.ServeHTTP)
-	e.GET("/mlb/:date", s.PrintBaseballGames)
+	e.GET("/mlb/standings", s.PrintBaseballStandings)
+	e.GET("/mlb/:date", s.PrintBaseballGames, rate_limit.LimitHistorical(historical, s.IsHistoricalCacheMiss))
 	e.GET("/mlb", s.PrintBaseballGames)
+	e.GET("/mlb/game/:gamePk", s.PrintBaseballGame, rate_limit.LimitHistorical(historical, s.IsGameCacheMiss))
+	e.GET("/mlb/team/:abbr", s.PrintBaseballTeam)
+	e.GET("/mlb/calendar.ics", s.PrintBaseballCalendar)
+	e.GET("/mlb/feed.atom", s.PrintBaseballFeed)
+	e.GET("/nfl/standings", s.PrintFootballStandings)
 	e.GET("/nfl/:date", s.PrintFootballGames)
 	e.GET("/nfl", s.PrintFootballGames)
+	e.GET("/nfl/game/:id", s.PrintFootballGame)
+	e.GET("/nfl/team/:abbr", s.PrintFootballTeam)
+	e.GET("/nfl/calendar.ics", s.PrintFootballCalendar)
+	e.GET("/nfl/feed.atom", s.PrintFootballFeed)
+	favoritesCSRF := handlers.FavoritesCSRF()
+	e.GET("/favorites", favoritesHandler.ShowFavorites, favoritesCSRF)
+	e.POST("/favorites", favoritesHandler.SaveFavorites, favoritesCSRF)
+
+	// Slash commands are only answered when there is a secret to check them with.
+	slashConfig := slash.Config{SigningSecret: os.Getenv("SLASH_SIGNING_SECRET"), Token: os.Getenv("SLASH_TOKEN")}
+	if slashConfig.SigningSecret != "" || slashConfig.Token != "" {
+		slashHandler := handlers.NewSlashHandler(logger, mlbFacade, nflFacade)
+		e.POST("/slash/score", slashHandler.HandleCommand, slash.Verify(slashConfig))
+	}
+
+	// The webhook admin API is only served when there is a token to guard it with.
+	if token := os.Getenv("WEBHOOK_ADMIN_TOKEN"); token != "" {
+		webhookHandler := handlers.NewWebhookHandler(logger, nflFacade)
+		admin := e.Group("/webhooks", internal.AdminAuth(token))
+		admin.POST("", webhookHandler.RegisterWebhook)
+		admin.GET("", webhookHandler.ListWebhooks)
+		admin.DELETE("/:id", webhookHandler.DeleteWebhook)
+	}
+
+	// Unsubscribe links always work, subscribing is an admin API like webhooks.
+	digestHandler := handlers.NewDigestHandler(logger, nflFacade)
+	e.GET(digest.UnsubscribePath, digestHandler.ShowUnsubscribe)
+	e.POST(digest.UnsubscribePath, digestHandler.Unsubscribe)
+	if token := os.Getenv("DIGEST_ADMIN_TOKEN"); token != "" {
+		admin := e.Group("/digest/subscriptions", internal.AdminAuth(token))
+		admin.POST("", digestHandler.Subscribe)
+		admin.GET("", digestHandler.ListSubscriptions)
+	}
+
+	// GraphQL and the terminal front-ends and text protocols draw boards from the same facades as the handlers, so
+	// they share their caches. The terminal front-ends and text protocols are only served when there is an address
+	// to listen on.
+	source := &cli.DirectSource{MLB: mlbFacade, NFL: nflFacade, Historical: historical}
+	graphHandler, err := graph.NewHandler(logger, source, nflFacade, fetch)
+	if err != nil {
+		logger.Fatal().Err(err).Msg("while parsing the GraphQL schema")
+	}
+	e.POST("/graphql", echo.WrapHandler(graphHandler))
+
+	shellServer := shell.NewServer(logger, source, shell.DefaultInterval)
+	if addr := os.Getenv("TELNET_ADDR"); addr != "" {
+		l := mustListen(logger, addr)
+		go func() {
+			logger.Error().Err(shellServer.ServeTelnet(l)).Msg("telnet server stopped")
+		}()
+	}
+	if addr := os.Getenv("SSH_ADDR"); addr != "" {
+		hostKey, err := os.ReadFile(os.Getenv("SSH_HOST_KEY"))
+		if err != nil {
+			logger.Fatal().Err(err).Msg("while reading the ssh host key from SSH_HOST_KEY")
+		}
+		config, err := shell.NewSSHConfig(hostKey)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("while parsing the ssh host key")
+		}
+		l := mustListen(logger, addr)
+		go func() {
+			logger.Error().Err(shellServer.ServeSSH(l, config)).Msg("ssh server stopped")
+		}()
+	}
+
+	if addr := os.Getenv("GOPHER_ADDR"); addr != "" {
+		_, port, err := net.SplitHostPort(addr)
+		if err != nil {
+			logger.Fatal().Err(err).Msg("while reading GOPHER_ADDR")
+		}
+		host := os.Getenv("GOPHER_HOST")
+		if host == "" {
+			host = "localhost"
+		}
+		gopherServer := gopher.NewServer(logger, source, host, port)
+		l := mustListen(logger, addr)
+		go func() {
+			logger.Error().Err(gopherServer.Serve(l)).Msg("gopher server stopped")
+		}()
+	}
+	if addr := os.Getenv("FINGER_ADDR"); addr != "" {
+		fingerServer := finger.NewServer(logger, source)
+		l := mustListen(logger, addr)
+		go func() {
+			logger.Error().Err(fingerServer.Serve(l)).Msg("finger server stopped")
+		}()
+	}
+
+	if addr := os.Getenv("GRPC_ADDR"); addr != "" {
+		grpcServer := grpc.NewServer()
+		changes := internal.MustListenDatabase(logger, nflfacade.GameChangesChannel)
+		scorespb.RegisterScoresServer(grpcServer, rpc.NewServer(logger, source, mlbFacade.Events(), changes, rpc.DefaultInterval))
+		l := mustListen(logger, addr)
+		go func() {
+			logger.Error().Err(grpcServer.Serve(l)).Msg("grpc server stopped")
+		}()
+	}
 
 	httpServer := h.Server{Addr: ":8080", Handler: e}
 
@@ -58,6 +202,14 @@ func main() {
 
 }
 
+func mustListen(logger zerolog.Logger, addr string) net.Listener {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		logger.Fatal().Err(err).Str("addr", addr).Msg("while listening")
+	}
+	return l
+}
+
 func createLogger() zerolog.Logger {
 	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
 	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
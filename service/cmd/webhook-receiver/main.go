@@ -0,0 +1,38 @@
+package main
+
+import (
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"github.com/rs/zerolog"
+	"net/http"
+	"os"
+)
+
+// webhook-receiver logs the events posted to it, to try out webhooks locally. Register it with the url
+// http://localhost:8081/ and start it with the secret returned by the registration in WEBHOOK_SECRET.
+func main() {
+	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
+	logger := zerolog.New(os.Stdout).With().Timestamp().Str("service", "webhookReceiver").Logger()
+
+	secret := os.Getenv("WEBHOOK_SECRET")
+	if secret == "" {
+		logger.Fatal().Msg("WEBHOOK_SECRET must be set")
+	}
+	addr := os.Getenv("WEBHOOK_RECEIVER_ADDR")
+	if addr == "" {
+		addr = ":8081"
+	}
+
+	receiver := &webhook.Receiver{
+		Secret: secret,
+		OnEvent: func(event webhook.Event) error {
+			logger.Info().Interface("event", event).Msgf("received %s", event.Type)
+			return nil
+		},
+	}
+
+	logger.Info().Msgf("listening on %s", addr)
+	if err := http.ListenAndServe(addr, receiver); !errors.Is(err, http.ErrServerClosed) {
+		logger.Fatal().Err(err).Msg("while serving")
+	}
+}
@@ -0,0 +1,144 @@
+package ansi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	esc = "\x1b["
+
+	boldOn       = esc + "1m"
+	dimOn        = esc + "2m"
+	intensityOff = esc + "22m"
+	yellowOn     = esc + "33m"
+	colorOff     = esc + "39m"
+
+	// ClearScreen moves the cursor to the top left and clears the screen, so a board can be drawn again in place.
+	ClearScreen = esc + "H" + esc + "2J"
+
+	// minLuminance is the relative luminance below which a team color is too dark to read on a dark terminal.
+	minLuminance = 0.1
+)
+
+// Bold returns s in bold.
+func Bold(s string) string {
+	return boldOn + s + intensityOff
+}
+
+// Dim returns s dimmed.
+func Dim(s string) string {
+	return dimOn + s + intensityOff
+}
+
+// Highlight returns s in yellow.
+func Highlight(s string) string {
+	return yellowOn + s + colorOff
+}
+
+// Hex returns s in the 24-bit color hex, given as RRGGBB with or without a leading #. s is returned unchanged
+// when hex is not a color.
+func Hex(hex, s string) string {
+	r, g, b, ok := parseHex(hex)
+	if !ok {
+		return s
+	}
+	return fmt.Sprintf("%s38;2;%d;%d;%dm%s%s", esc, r, g, b, s, colorOff)
+}
+
+// TeamColor returns primary unless it is too dark to read, in which case alt is returned.
+func TeamColor(primary, alt string) string {
+	r, g, b, ok := parseHex(primary)
+	if ok && luminance(r, g, b) >= minLuminance {
+		return primary
+	}
+	if _, _, _, altOK := parseHex(alt); altOK {
+		return alt
+	}
+	return primary
+}
+
+// Strip returns s without escape sequences.
+func Strip(s string) string {
+	if !strings.Contains(s, esc) {
+		return s
+	}
+	sb := strings.Builder{}
+	for i := 0; i < len(s); {
+		if n := sequenceLen(s[i:]); n > 0 {
+			i += n
+			continue
+		}
+		sb.WriteByte(s[i])
+		i++
+	}
+	return sb.String()
+}
+
+// Width returns the number of columns s takes on a terminal, ignoring escape sequences.
+func Width(s string) int {
+	return utf8.RuneCountInString(Strip(s))
+}
+
+// Truncate cuts s to width visible columns. Escape sequences are kept, and colors are reset when s is cut so
+// they do not run into the next line.
+func Truncate(s string, width int) string {
+	if Width(s) <= width {
+		return s
+	}
+	sb := strings.Builder{}
+	visible := 0
+	for i := 0; i < len(s); {
+		if n := sequenceLen(s[i:]); n > 0 {
+			sb.WriteString(s[i : i+n])
+			i += n
+			continue
+		}
+		if visible == width {
+			break
+		}
+		r, size := utf8.DecodeRuneInString(s[i:])
+		sb.WriteRune(r)
+		visible++
+		i += size
+	}
+	if strings.Contains(s, esc) {
+		sb.WriteString(esc + "0m")
+	}
+	return sb.String()
+}
+
+// sequenceLen returns the length of the SGR escape sequence s starts with, or 0.
+func sequenceLen(s string) int {
+	if !strings.HasPrefix(s, esc) {
+		return 0
+	}
+	for i := len(esc); i < len(s); i++ {
+		if s[i] == 'm' {
+			return i + 1
+		}
+		if (s[i] < '0' || s[i] > '9') && s[i] != ';' {
+			return 0
+		}
+	}
+	return 0
+}
+
+func parseHex(hex string) (r, g, b uint8, ok bool) {
+	hex = strings.TrimPrefix(hex, "#")
+	if len(hex) != 6 {
+		return 0, 0, 0, false
+	}
+	v, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return 0, 0, 0, false
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
+
+// luminance approximates relative luminance from 0 for black to 1 for white.
+func luminance(r, g, b uint8) float64 {
+	return (0.2126*float64(r) + 0.7152*float64(g) + 0.0722*float64(b)) / 255
+}
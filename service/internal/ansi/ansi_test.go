@@ -0,0 +1,69 @@
+package ansi
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestHex(t *testing.T) {
+	assert.Equal(t, "\x1b[38;2;227;24;55mKC\x1b[39m", Hex("e31837", "KC"))
+	assert.Equal(t, "\x1b[38;2;227;24;55mKC\x1b[39m", Hex("#E31837", "KC"))
+	assert.Equal(t, "KC", Hex("", "KC"))
+	assert.Equal(t, "KC", Hex("red", "KC"))
+}
+
+func TestTeamColor(t *testing.T) {
+	testCases := map[string]struct {
+		primary, alt string
+		expected     string
+	}{
+		"should use primary color":                      {primary: "e31837", alt: "ffb612", expected: "e31837"},
+		"should use alt color when primary is too dark": {primary: "000000", alt: "ffb612", expected: "ffb612"},
+		"should use primary color when alt is invalid":  {primary: "000000", alt: "", expected: "000000"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, TeamColor(tc.primary, tc.alt))
+		})
+	}
+}
+
+func TestWidth(t *testing.T) {
+	assert.Equal(t, 7, Width("* "+Bold(Hex("e31837", "KC"))+"  *"))
+	assert.Equal(t, 5, Width("KC 21"))
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := map[string]struct {
+		s        string
+		width    int
+		expected string
+	}{
+		"should not cut short lines": {
+			s:        Bold("KC 21"),
+			width:    10,
+			expected: Bold("KC 21"),
+		},
+		"should cut visible text and reset": {
+			s:        Bold("KC 21"),
+			width:    2,
+			expected: "\x1b[1mKC\x1b[0m",
+		},
+		"should cut plain text": {
+			s:        "KC 21",
+			width:    2,
+			expected: "KC",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Truncate(tc.s, tc.width))
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package calendar
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+const (
+	ContentType = "text/calendar; charset=UTF-8"
+
+	prodID     = "-//mini-score//schedule//EN"
+	timeLayout = "20060102T150405Z"
+	// lineLimit is the most octets a content line may have before it is folded.
+	lineLimit = 75
+)
+
+var escaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+type (
+	// Calendar is an iCalendar (RFC 5545) feed of games that calendar apps subscribe to.
+	Calendar struct {
+		Name   string
+		Events []Event
+	}
+
+	// Event is a game. UID must stay the same for the life of the game and Sequence must grow each time the
+	// start moves so apps replace the event they have.
+	Event struct {
+		UID         string
+		Start       time.Time
+		Duration    time.Duration
+		Summary     string
+		Description string
+		Location    string
+		Sequence    int
+	}
+)
+
+// Write writes the calendar as iCalendar with stamp as when the events were last read.
+func (c Calendar) Write(w io.Writer, stamp time.Time) error {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:" + prodID,
+		"CALSCALE:GREGORIAN",
+		"METHOD:PUBLISH",
+		"X-WR-CALNAME:" + escape(c.Name),
+	}
+	for _, e := range c.Events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+escape(e.UID),
+			"DTSTAMP:"+stamp.UTC().Format(timeLayout),
+			"DTSTART:"+e.Start.UTC().Format(timeLayout),
+			"DTEND:"+e.Start.Add(e.Duration).UTC().Format(timeLayout),
+			fmt.Sprintf("SEQUENCE:%d", e.Sequence),
+			"SUMMARY:"+escape(e.Summary),
+		)
+		if e.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+escape(e.Description))
+		}
+		if e.Location != "" {
+			lines = append(lines, "LOCATION:"+escape(e.Location))
+		}
+		lines = append(lines, "END:VEVENT")
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	sb := strings.Builder{}
+	for _, line := range lines {
+		sb.WriteString(fold(line))
+		sb.WriteString("\r\n")
+	}
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+func escape(text string) string {
+	return escaper.Replace(text)
+}
+
+// fold splits a content line longer than lineLimit octets into lines that continue with a space, without
+// splitting a UTF-8 character.
+func fold(line string) string {
+	sb := strings.Builder{}
+	limit := lineLimit
+	octets := 0
+	for _, r := range line {
+		size := len(string(r))
+		if octets+size > limit {
+			sb.WriteString("\r\n ")
+			octets = 0
+			// the leading space counts toward the limit of continued lines
+			limit = lineLimit - 1
+		}
+		sb.WriteRune(r)
+		octets += size
+	}
+	return sb.String()
+}
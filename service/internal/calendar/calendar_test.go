@@ -0,0 +1,99 @@
+package calendar
+
+import (
+	"bytes"
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestCalendar_Write(t *testing.T) {
+	kickoff := time.Date(2023, 9, 11, 23, 15, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		calendar Calendar
+		golden   string
+	}{
+		"should write events with escaped text": {
+			calendar: Calendar{
+				Name: "BUF NFL Schedule",
+				Events: []Event{
+					{
+						UID:         "nfl-401547356@mini-score",
+						Start:       kickoff,
+						Duration:    3*time.Hour + 30*time.Minute,
+						Summary:     "BUF @ NYJ",
+						Description: "Final: BUF 16, NYJ 22 (OT)",
+						Sequence:    1,
+					},
+					{
+						UID:      "mlb-718781@mini-score",
+						Start:    time.Date(2023, 3, 30, 18, 10, 0, 0, time.UTC),
+						Duration: 3 * time.Hour,
+						Summary:  "BAL @ BOS",
+						Location: "Fenway Park; Boston",
+					},
+				},
+			},
+			golden: "schedule.ics",
+		},
+		"should write a calendar without events": {
+			calendar: Calendar{Name: "BUF NFL Schedule"},
+			golden:   "empty.ics",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			require.NoError(t, tc.calendar.Write(&buf, kickoff.Add(-time.Hour)))
+
+			golden := filepath.Join("test-data", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
+
+func TestFold(t *testing.T) {
+	testCases := map[string]struct {
+		line          string
+		expectedLines int
+	}{
+		"should not fold short lines":          {line: strings.Repeat("a", 75), expectedLines: 1},
+		"should fold long lines":               {line: strings.Repeat("a", 76), expectedLines: 2},
+		"should count the space of continuing": {line: strings.Repeat("a", 75+74+1), expectedLines: 3},
+		"should not split characters":          {line: strings.Repeat("é", 80), expectedLines: 3},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			lines := strings.Split(fold(tc.line), "\r\n")
+
+			assert.Len(t, lines, tc.expectedLines)
+			unfolded := lines[0]
+			for _, line := range lines[1:] {
+				assert.True(t, strings.HasPrefix(line, " "))
+				unfolded += line[1:]
+			}
+			for _, line := range lines {
+				assert.LessOrEqual(t, len(line), 75)
+			}
+			assert.Equal(t, tc.line, unfolded)
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"io"
+	"strings"
+	"time"
+)
+
+// MinInterval is the shortest time between refreshes in watch mode, the same as the shortest page refresh.
+const MinInterval = 10 * time.Second
+
+type (
+	// Source gets the board of a sport on date, only showing teams when there are any.
+	Source interface {
+		Board(ctx context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error)
+	}
+
+	// Options pick the boards to draw and how they are drawn.
+	Options struct {
+		Sports []favorites.Sport
+		Teams  []string
+		// Date is the day of the boards. Zero means today, which moves on to the next day while watching.
+		Date time.Time
+		// Location is the timezone of today and of game times. Nil means time.Local.
+		Location *time.Location
+		Text     renderer.TextOptions
+		// Footer is written under the boards, e.g. to say how to stop watching them.
+		Footer string
+		// ClearScreen clears the screen before each redraw in watch mode so boards are redrawn in place. Only
+		// terminals that understand ANSI escapes should set it, other clients get each redraw below the last.
+		ClearScreen bool
+	}
+
+	// Client draws boards from a source for a terminal.
+	Client struct {
+		source Source
+		opts   Options
+		now    func() time.Time
+		after  func(d time.Duration) <-chan time.Time
+	}
+)
+
+func NewClient(source Source, opts Options) *Client {
+	return &Client{source: source, opts: opts, now: time.Now, after: time.After}
+}
+
+// Draw returns the board of each sport under its name. A sport that can not be loaded says so in place of its
+// board, and the errors are returned with the boards that could be drawn.
+func (c *Client) Draw(ctx context.Context) (string, error) {
+	date := c.opts.Date
+	if date.IsZero() {
+		loc := c.opts.Location
+		if loc == nil {
+			loc = time.Local
+		}
+		now := c.now().In(loc)
+		date = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	}
+
+	sb := strings.Builder{}
+	var errs []error
+	for i, sport := range c.opts.Sports {
+		if i > 0 {
+			sb.WriteString("\n\n")
+		}
+		heading := strings.ToUpper(string(sport))
+		if c.opts.Text.Color {
+			heading = ansi.Bold(heading)
+		}
+		sb.WriteString(heading + "\n")
+
+		board, err := c.source.Board(ctx, sport, date, c.opts.Teams)
+		if err != nil {
+			sb.WriteString(fmt.Sprintf("%s scores are unavailable: %s", strings.ToUpper(string(sport)), err))
+			errs = append(errs, err)
+			continue
+		}
+		if err := (renderer.Text{Options: c.opts.Text}).Render(&sb, board); err != nil {
+			return "", err
+		}
+	}
+	sb.WriteString("\n")
+	if c.opts.Footer != "" {
+		sb.WriteString("\n" + c.opts.Footer + "\n")
+	}
+
+	return sb.String(), errors.Join(errs...)
+}
+
+// Watch draws the boards to w every interval until ctx is done. The boards are only redrawn when they change,
+// in place when the options clear the screen. A sport that can not be loaded says so in place of its board until it can be loaded again.
+func (c *Client) Watch(ctx context.Context, w io.Writer, interval time.Duration) error {
+	if interval < MinInterval {
+		interval = MinInterval
+	}
+
+	shown := ""
+	for {
+		out, _ := c.Draw(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		if out != shown {
+			screen := out
+			if c.opts.ClearScreen {
+				screen = ansi.ClearScreen + out
+			} else if shown != "" {
+				screen = "\n" + out
+			}
+			if _, err := io.WriteString(w, screen); err != nil {
+				return err
+			}
+			shown = out
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-c.after(interval):
+		}
+	}
+}
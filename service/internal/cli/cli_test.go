@@ -0,0 +1,194 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+	"time"
+)
+
+type (
+	boardRequest struct {
+		sport favorites.Sport
+		date  time.Time
+		teams []string
+	}
+
+	// fakeSource answers each sport with its boards in turn, repeating the last one.
+	fakeSource struct {
+		boards   map[favorites.Sport][]renderer.Board
+		err      map[favorites.Sport]error
+		requests []boardRequest
+	}
+)
+
+func (f *fakeSource) Board(_ context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	f.requests = append(f.requests, boardRequest{sport: sport, date: date, teams: teams})
+	if err := f.err[sport]; err != nil {
+		return renderer.Board{}, err
+	}
+	boards := f.boards[sport]
+	board := boards[0]
+	if len(boards) > 1 {
+		f.boards[sport] = boards[1:]
+	}
+	return board, nil
+}
+
+func testBoard(date time.Time, away, home string, awayScore, homeScore string) renderer.Board {
+	return renderer.Board{Date: date, Games: []renderer.Game{{
+		PeriodLabel: "Q",
+		Periods:     4,
+		TotalLabels: []string{"T"},
+		Away:        renderer.Team{Name: away, Color: "e31837", Totals: []string{awayScore}},
+		Home:        renderer.Team{Name: home, Color: "00338d", Totals: []string{homeScore}},
+		Status:      renderer.Status{State: renderer.Live, Detail: "Q1", Clock: "10:00"},
+	}}}
+}
+
+func TestClient_Draw(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	// 02:00 UTC is still the evening before in New York.
+	now := time.Date(2023, 9, 11, 2, 0, 0, 0, time.UTC)
+	today := time.Date(2023, 9, 10, 0, 0, 0, 0, loc)
+	date := time.Date(2023, 9, 7, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		opts             Options
+		err              map[favorites.Sport]error
+		expectedDate     time.Time
+		expectedContains []string
+		expectedErr      bool
+	}{
+		"should draw each sport under its name for today": {
+			opts:             Options{Sports: []favorites.Sport{favorites.NFL, favorites.MLB}},
+			expectedDate:     today,
+			expectedContains: []string{"NFL\n", "\n\nMLB\n", "KC", "NYY"},
+		},
+		"should ask for the date and teams": {
+			opts:             Options{Sports: []favorites.Sport{favorites.NFL}, Teams: []string{"KC"}, Date: date},
+			expectedDate:     date,
+			expectedContains: []string{"NFL\n", "KC"},
+		},
+		"should say when a sport is unavailable": {
+			opts:             Options{Sports: []favorites.Sport{favorites.NFL, favorites.MLB}},
+			err:              map[favorites.Sport]error{favorites.NFL: errors.New("connection refused")},
+			expectedDate:     today,
+			expectedContains: []string{"NFL scores are unavailable: connection refused", "NYY"},
+			expectedErr:      true,
+		},
+		"should draw in color": {
+			opts:             Options{Sports: []favorites.Sport{favorites.NFL}, Text: renderer.TextOptions{Color: true}},
+			expectedDate:     today,
+			expectedContains: []string{ansi.Bold("NFL")},
+		},
+		"should write the footer under the boards": {
+			opts:             Options{Sports: []favorites.Sport{favorites.NFL}, Footer: "Press Enter for the menu"},
+			expectedDate:     today,
+			expectedContains: []string{"\n\nPress Enter for the menu\n"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			source := &fakeSource{
+				boards: map[favorites.Sport][]renderer.Board{
+					favorites.NFL: {testBoard(tc.expectedDate, "KC", "DET", "7", "0")},
+					favorites.MLB: {testBoard(tc.expectedDate, "NYY", "BOS", "1", "2")},
+				},
+				err: tc.err,
+			}
+			tc.opts.Location = loc
+			c := NewClient(source, tc.opts)
+			c.now = func() time.Time { return now }
+
+			out, err := c.Draw(context.Background())
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, out, s)
+			}
+			if !tc.opts.Text.Color {
+				assert.Equal(t, ansi.Strip(out), out, "plain boards should not have escape sequences")
+			}
+			require.Len(t, source.requests, len(tc.opts.Sports))
+			for _, r := range source.requests {
+				assert.Equal(t, tc.expectedDate, r.date)
+				assert.Equal(t, tc.opts.Teams, r.teams)
+			}
+		})
+	}
+}
+
+func TestClient_Watch(t *testing.T) {
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC)
+	source := &fakeSource{boards: map[favorites.Sport][]renderer.Board{
+		favorites.NFL: {
+			testBoard(date, "KC", "DET", "0", "0"),
+			testBoard(date, "KC", "DET", "0", "0"),
+			testBoard(date, "KC", "DET", "7", "0"),
+		},
+	}}
+	c := NewClient(source, Options{Sports: []favorites.Sport{favorites.NFL}, Date: date, ClearScreen: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var intervals []time.Duration
+	c.after = func(d time.Duration) <-chan time.Time {
+		intervals = append(intervals, d)
+		if len(intervals) == 4 {
+			cancel()
+		}
+		tick := make(chan time.Time, 1)
+		tick <- time.Time{}
+		return tick
+	}
+
+	out := bytes.Buffer{}
+	require.NoError(t, c.Watch(ctx, &out, time.Second))
+
+	screens := strings.Split(out.String(), ansi.ClearScreen)[1:]
+	require.Len(t, screens, 2, "the screen should only be redrawn when the board changes")
+	assert.Contains(t, screens[0], " 0 ")
+	assert.Contains(t, screens[1], " 7 ")
+	for _, d := range intervals {
+		assert.Equal(t, MinInterval, d, "intervals should not be shorter than the minimum")
+	}
+}
+
+func TestClient_WatchWithoutClearScreen(t *testing.T) {
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC)
+	source := &fakeSource{boards: map[favorites.Sport][]renderer.Board{
+		favorites.NFL: {
+			testBoard(date, "KC", "DET", "0", "0"),
+			testBoard(date, "KC", "DET", "7", "0"),
+		},
+	}}
+	c := NewClient(source, Options{Sports: []favorites.Sport{favorites.NFL}, Date: date})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ticks := 0
+	c.after = func(d time.Duration) <-chan time.Time {
+		if ticks++; ticks == 2 {
+			cancel()
+		}
+		tick := make(chan time.Time, 1)
+		tick <- time.Time{}
+		return tick
+	}
+
+	out := bytes.Buffer{}
+	require.NoError(t, c.Watch(ctx, &out, time.Second))
+
+	assert.NotContains(t, out.String(), "\x1b", "boards should be written without escapes")
+	assert.Equal(t, 2, strings.Count(out.String(), "NFL\n"), "each redraw should follow the last")
+}
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	userAgent  = "miniscore"
+	// maxBoard bounds the board read from the server.
+	maxBoard = 1 << 20
+)
+
+// ErrNoNFL is returned by DirectSource for NFL boards when there is no database to read them from.
+var ErrNoNFL = errors.New("NFL scores are read from the database, set the POSTGRES_ variables or use a server")
+
+var (
+	_ Source = &APISource{}
+	_ Source = &DirectSource{}
+)
+
+type (
+	// APISource gets boards from a mini-score server as JSON, so they can be drawn to fit the terminal.
+	APISource struct {
+		client *http.Client
+		server string
+	}
+
+	// DirectSource gets boards without a server: MLB boards from statsapi and NFL boards from the database the
+	// scheduler fills. NFL is nil when there is no database. Historical, when set, limits the past MLB boards and
+	// the MLB game pages that are not cached to the budget of the client on the context.
+	DirectSource struct {
+		MLB        mlbfacade.ScoreFacade
+		NFL        nflfacade.ScoreboardFacade
+		Historical *rate_limit.Historical
+	}
+)
+
+func NewAPISource(client *http.Client, server string) *APISource {
+	return &APISource{client: client, server: strings.TrimRight(server, "/")}
+}
+
+// Board gets the board from /<sport>/<date>, with the game times in date's location.
+func (a *APISource) Board(ctx context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	query := url.Values{"format": {renderer.FormatJSON}}
+	if len(teams) > 0 {
+		query.Set("teams", strings.Join(teams, ","))
+	}
+	u := a.server + "/" + string(sport) + "/" + date.Format(dateLayout) + "?" + query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return renderer.Board{}, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	if name := date.Location().String(); name != "Local" {
+		req.Header.Set(timezone.HeaderTimeZone, name)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return renderer.Board{}, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return renderer.Board{}, fmt.Errorf("server answered %s", resp.Status)
+	}
+	return renderer.DecodeJSON(io.LimitReader(resp.Body, maxBoard), date.Location())
+}
+
+// Board gets the board as the server would.
+func (d *DirectSource) Board(ctx context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	switch sport {
+	case favorites.MLB:
+		if d.Historical != nil && isPast(date) && !d.MLB.IsCached(date) && !d.Historical.Allow(rate_limit.Client(ctx), 1) {
+			return renderer.Board{}, rate_limit.ErrTooManyDates
+		}
+		return mlbfacade.ProcessBoard(d.MLB, favorites.WithTeams(ctx, teams), date)
+	case favorites.NFL:
+		if d.NFL == nil {
+			return renderer.Board{}, ErrNoNFL
+		}
+		scores, err := d.NFL.GetScoreboardForDate(date)
+		if err != nil {
+			return renderer.Board{}, err
+		}
+		return scores.Favorites(favorites.Selection{Teams: teams, HideOthers: len(teams) > 0}).Board(date), nil
+	default:
+		return renderer.Board{}, fmt.Errorf("unknown sport %q", sport)
+	}
+}
+
+// Game gets the page of a game as the server would, by game pk for MLB and by id for NFL, with times in loc.
+func (d *DirectSource) Game(ctx context.Context, sport favorites.Sport, id string, loc *time.Location) (renderer.Board, error) {
+	switch sport {
+	case favorites.MLB:
+		gamePk, err := strconv.Atoi(id)
+		if err != nil {
+			return renderer.Board{}, fmt.Errorf("%w: MLB game ids are numbers", mlbfacade.ErrNoGame)
+		}
+		if d.Historical != nil && !d.MLB.IsGameCached(gamePk) && !d.Historical.Allow(rate_limit.Client(ctx), 1) {
+			return renderer.Board{}, rate_limit.ErrTooManyDates
+		}
+		return mlbfacade.ProcessGamePage(d.MLB, timezone.WithLocation(ctx, loc), gamePk)
+	case favorites.NFL:
+		if d.NFL == nil {
+			return renderer.Board{}, ErrNoNFL
+		}
+		game, err := d.NFL.GetGame(id, loc)
+		if err != nil {
+			return renderer.Board{}, err
+		}
+		return game.Board(), nil
+	default:
+		return renderer.Board{}, fmt.Errorf("unknown sport %q", sport)
+	}
+}
+
+// isPast reports if date is before today in its location.
+func isPast(date time.Time) bool {
+	return date.Format(dateLayout) < time.Now().In(date.Location()).Format(dateLayout)
+}
@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"context"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAPISource_Board(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	assert.NoError(t, err)
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, loc)
+
+	testCases := map[string]struct {
+		teams         []string
+		status        int
+		expectedQuery string
+		expectedErr   bool
+	}{
+		"should get the board as json": {
+			status:        http.StatusOK,
+			expectedQuery: "format=json",
+		},
+		"should ask for teams": {
+			teams:         []string{"KC", "DET"},
+			status:        http.StatusOK,
+			expectedQuery: "format=json&teams=KC%2CDET",
+		},
+		"should return error for other statuses": {
+			status:        http.StatusTooManyRequests,
+			expectedQuery: "format=json",
+			expectedErr:   true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/nfl/2023-09-10", r.URL.Path)
+				assert.Equal(t, tc.expectedQuery, r.URL.RawQuery)
+				assert.Equal(t, "America/Chicago", r.Header.Get(timezone.HeaderTimeZone))
+				w.WriteHeader(tc.status)
+				_ = renderer.JSON{}.Render(w, testBoard(date, "KC", "DET", "7", "0"))
+			}))
+			defer server.Close()
+
+			board, err := NewAPISource(server.Client(), server.URL+"/").Board(context.Background(), favorites.NFL, date, tc.teams)
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, date, board.Date)
+			assert.Len(t, board.Games, 1)
+			assert.Equal(t, "KC", board.Games[0].Away.Name)
+			assert.Equal(t, renderer.Live, board.Games[0].Status.State)
+		})
+	}
+}
+
+func TestDirectSource_Board(t *testing.T) {
+	source := &DirectSource{}
+
+	_, err := source.Board(context.Background(), favorites.NFL, time.Now(), nil)
+	assert.ErrorIs(t, err, ErrNoNFL)
+
+	_, err = source.Board(context.Background(), favorites.Sport("nhl"), time.Now(), nil)
+	assert.Error(t, err)
+}
+
+func TestDirectSource_Game(t *testing.T) {
+	source := &DirectSource{}
+
+	_, err := source.Game(context.Background(), favorites.NFL, "1", time.UTC)
+	assert.ErrorIs(t, err, ErrNoNFL)
+
+	_, err = source.Game(context.Background(), favorites.MLB, "not-a-pk", time.UTC)
+	assert.ErrorIs(t, err, mlbfacade.ErrNoGame)
+
+	_, err = source.Game(context.Background(), favorites.Sport("nhl"), "1", time.UTC)
+	assert.Error(t, err)
+}
@@ -0,0 +1,166 @@
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// UnsubscribePath is the page unsubscribe links point to, with the token of the subscription in the token query
+// parameter.
+const UnsubscribePath = "/digest/unsubscribe"
+
+type (
+	// Final is the final score of a game, with the line score as it is on the board.
+	Final struct {
+		Sport     favorites.Sport
+		AwayTeam  string
+		HomeTeam  string
+		Title     string
+		LineScore string
+	}
+
+	// Subscriber is someone emailed the finals of Teams each day.
+	Subscriber struct {
+		ID               string
+		Email            string
+		Teams            map[favorites.Sport][]string
+		UnsubscribeToken string
+	}
+
+	// Message is a digest ready to be sent to one subscriber.
+	Message struct {
+		To             string
+		Subject        string
+		Body           string
+		UnsubscribeURL string
+	}
+
+	// Store holds the subscribers and which of them were sent the digest of each day.
+	Store interface {
+		Subscribers() ([]Subscriber, error)
+		SentDigests(date time.Time) (map[string]bool, error)
+		MarkDigestSent(subscriberID string, date time.Time) error
+	}
+
+	// Source returns the finals of a sport played on the day of date.
+	Source func(date time.Time) ([]Final, error)
+
+	// Digest emails each subscriber the finals of their teams.
+	Digest struct {
+		logger  zerolog.Logger
+		store   Store
+		mailer  Mailer
+		siteURL string
+		sources []Source
+	}
+)
+
+func NewDigest(logger zerolog.Logger, store Store, mailer Mailer, siteURL string, sources ...Source) *Digest {
+	return &Digest{
+		logger:  logger.With().Str("service", "Digest").Logger(),
+		store:   store,
+		mailer:  mailer,
+		siteURL: strings.TrimRight(siteURL, "/"),
+		sources: sources,
+	}
+}
+
+// Send emails the finals played on the day of date. Subscribers whose teams did not play are not emailed.
+// Nothing is sent when the finals of any sport can not be loaded. Each digest sent is logged and subscribers
+// already sent the digest of date are skipped, so the digest can be run again after some could not be sent.
+func (d *Digest) Send(date time.Time) error {
+	logger := d.logger.With().Str("method", "Send").Str("date", date.Format("2006-01-02")).Logger()
+
+	var finals []Final
+	for _, source := range d.sources {
+		sportFinals, err := source(date)
+		if err != nil {
+			logger.Error().Err(err).Msg("while getting finals")
+			return err
+		}
+		finals = append(finals, sportFinals...)
+	}
+
+	subscribers, err := d.store.Subscribers()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting subscribers")
+		return err
+	}
+
+	alreadySent, err := d.store.SentDigests(date)
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting sent digests")
+		return err
+	}
+
+	var errs []error
+	sent := 0
+	for _, sub := range subscribers {
+		if alreadySent[sub.ID] {
+			continue
+		}
+		msg, ok := Compose(sub, date, finals, d.unsubscribeURL(sub))
+		if !ok {
+			continue
+		}
+		if err := d.mailer.Send(msg); err != nil {
+			logger.Error().Err(err).Msg("while sending digest")
+			errs = append(errs, err)
+			continue
+		}
+		sent++
+		if err := d.store.MarkDigestSent(sub.ID, date); err != nil {
+			logger.Error().Err(err).Msg("while logging sent digest")
+			errs = append(errs, err)
+		}
+	}
+	logger.Info().Msgf("sent %d digests of %d finals", sent, len(finals))
+
+	return errors.Join(errs...)
+}
+
+func (d *Digest) unsubscribeURL(sub Subscriber) string {
+	return d.siteURL + UnsubscribePath + "?token=" + url.QueryEscape(sub.UnsubscribeToken)
+}
+
+// Compose writes the digest of the finals of the subscriber's teams played on the day of date. It reports false
+// when none of their teams played.
+func Compose(sub Subscriber, date time.Time, finals []Final, unsubscribeURL string) (Message, bool) {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("Finals for %s\n", date.Format("Monday, January 2, 2006")))
+
+	games := 0
+	for _, sport := range favorites.Sports {
+		sel := favorites.Selection{Teams: sub.Teams[sport]}
+		heading := false
+		for _, f := range finals {
+			if f.Sport != sport || !(sel.Contains(f.AwayTeam) || sel.Contains(f.HomeTeam)) {
+				continue
+			}
+			if !heading {
+				sb.WriteString("\n" + strings.ToUpper(string(sport)) + "\n")
+				heading = true
+			}
+			sb.WriteString("\n" + f.Title + "\n" + f.LineScore + "\n")
+			games++
+		}
+	}
+	if games == 0 {
+		return Message{}, false
+	}
+
+	sb.WriteString("\n-- \nYou get this email because you subscribed to the mini-score digest.\n")
+	sb.WriteString("Unsubscribe: " + unsubscribeURL + "\n")
+
+	return Message{
+		To:             sub.Email,
+		Subject:        fmt.Sprintf("mini-score: finals for %s", date.Format("Jan 2, 2006")),
+		Body:           sb.String(),
+		UnsubscribeURL: unsubscribeURL,
+	}, true
+}
@@ -0,0 +1,205 @@
+package digest
+
+import (
+	"errors"
+	"flag"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+var (
+	testDate   = time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)
+	testFinals = []Final{
+		{Sport: favorites.NFL, AwayTeam: "BUF", HomeTeam: "NYJ", Title: "Final/OT: BUF 16 @ NYJ 22", LineScore: "* Q    1  2  3  4  5    T *\n* BUF  0 13  0  3  0   16 *"},
+		{Sport: favorites.NFL, AwayTeam: "LAR", HomeTeam: "SEA", Title: "Final: LAR 30 @ SEA 13", LineScore: "LAR 30, SEA 13"},
+		{Sport: favorites.MLB, AwayTeam: "NYY", HomeTeam: "BOS", Title: "Final/11: NYY 2 @ BOS 1", LineScore: "NYY 2, BOS 1"},
+	}
+)
+
+type memoryStore struct {
+	subscribers []Subscriber
+	sent        map[string]bool
+	err         error
+}
+
+func (m *memoryStore) Subscribers() ([]Subscriber, error) {
+	return m.subscribers, m.err
+}
+
+func (m *memoryStore) SentDigests(date time.Time) (map[string]bool, error) {
+	sent := make(map[string]bool, len(m.sent))
+	for id := range m.sent {
+		sent[id] = true
+	}
+	return sent, nil
+}
+
+func (m *memoryStore) MarkDigestSent(subscriberID string, date time.Time) error {
+	if m.sent == nil {
+		m.sent = make(map[string]bool)
+	}
+	m.sent[subscriberID] = true
+	return nil
+}
+
+// flakyMailer fails to send to the addresses in failing, recording who it sent to.
+type flakyMailer struct {
+	failing map[string]bool
+	sent    []string
+}
+
+func (f *flakyMailer) Send(msg Message) error {
+	if f.failing[msg.To] {
+		return errors.New("mailbox unavailable")
+	}
+	f.sent = append(f.sent, msg.To)
+	return nil
+}
+
+func TestCompose(t *testing.T) {
+	const unsubscribeURL = "http://localhost:8080/digest/unsubscribe?token=t0ken"
+
+	testCases := map[string]struct {
+		teams      map[favorites.Sport][]string
+		expectedOK bool
+		golden     string
+	}{
+		"should list finals of teams in every sport": {
+			teams:      map[favorites.Sport][]string{favorites.NFL: {"nyj", "SEA"}, favorites.MLB: {"BOS"}},
+			expectedOK: true,
+			golden:     "digest.txt",
+		},
+		"should leave out sports without finals of their teams": {
+			teams:      map[favorites.Sport][]string{favorites.NFL: {"LAR"}, favorites.MLB: {"TB"}},
+			expectedOK: true,
+			golden:     "nfl.txt",
+		},
+		"should not match teams of another sport": {
+			teams: map[favorites.Sport][]string{favorites.MLB: {"SEA"}},
+		},
+		"should not compose without teams": {},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			msg, ok := Compose(Subscriber{Email: "fan@example.com", Teams: tc.teams}, testDate, testFinals, unsubscribeURL)
+
+			require.Equal(t, tc.expectedOK, ok)
+			if !ok {
+				return
+			}
+			assert.Equal(t, "fan@example.com", msg.To)
+			assert.Equal(t, "mini-score: finals for Sep 11, 2023", msg.Subject)
+			assert.Equal(t, unsubscribeURL, msg.UnsubscribeURL)
+
+			golden := filepath.Join("test-data", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, []byte(msg.Body), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), msg.Body)
+		})
+	}
+}
+
+func TestDigest_Send(t *testing.T) {
+	subscribers := []Subscriber{
+		{ID: "1", Email: "bills@example.com", Teams: map[favorites.Sport][]string{favorites.NFL: {"BUF"}}, UnsubscribeToken: "a b"},
+		{ID: "2", Email: "rays@example.com", Teams: map[favorites.Sport][]string{favorites.MLB: {"TB"}}, UnsubscribeToken: "c"},
+		{ID: "3", Email: "yankees@example.com", Teams: map[favorites.Sport][]string{favorites.MLB: {"NYY"}}, UnsubscribeToken: "d"},
+	}
+	nfl := func(date time.Time) ([]Final, error) { return testFinals[:2], nil }
+	mlb := func(date time.Time) ([]Final, error) { return testFinals[2:], nil }
+	failing := func(date time.Time) ([]Final, error) { return nil, errors.New("statsapi is down") }
+
+	testCases := map[string]struct {
+		store       Store
+		sources     []Source
+		expectedTo  []string
+		expectedErr bool
+	}{
+		"should email subscribers whose teams played": {
+			store:      &memoryStore{subscribers: subscribers},
+			sources:    []Source{nfl, mlb},
+			expectedTo: []string{"<bills@example.com>", "<yankees@example.com>"},
+		},
+		"should not email subscribers already sent the digest": {
+			store:      &memoryStore{subscribers: subscribers, sent: map[string]bool{"1": true}},
+			sources:    []Source{nfl, mlb},
+			expectedTo: []string{"<yankees@example.com>"},
+		},
+		"should not email anyone when finals fail": {
+			store:       &memoryStore{subscribers: subscribers},
+			sources:     []Source{nfl, failing},
+			expectedErr: true,
+		},
+		"should not email anyone when subscribers fail": {
+			store:       &memoryStore{err: errors.New("database is down")},
+			sources:     []Source{nfl, mlb},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			server := newSMTPStandIn(t, false)
+			d := NewDigest(zerolog.Nop(), tc.store, NewSMTPMailer(SMTPConfig{Addr: server.addr(), From: "digest@mini-score.test"}),
+				"http://localhost:8080/", tc.sources...)
+
+			err := d.Send(testDate)
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+			to := make([]string, 0)
+			for _, mail := range server.received() {
+				to = append(to, mail.To...)
+			}
+			assert.ElementsMatch(t, tc.expectedTo, to)
+		})
+	}
+}
+
+func TestDigest_SendRerun(t *testing.T) {
+	store := &memoryStore{subscribers: []Subscriber{
+		{ID: "1", Email: "bills@example.com", Teams: map[favorites.Sport][]string{favorites.NFL: {"BUF"}}},
+		{ID: "2", Email: "yankees@example.com", Teams: map[favorites.Sport][]string{favorites.MLB: {"NYY"}}},
+	}}
+	sources := func(date time.Time) ([]Final, error) { return testFinals, nil }
+	mailer := &flakyMailer{failing: map[string]bool{"yankees@example.com": true}}
+	d := NewDigest(zerolog.Nop(), store, mailer, "http://localhost:8080/", sources)
+
+	require.Error(t, d.Send(testDate))
+	assert.Equal(t, []string{"bills@example.com"}, mailer.sent)
+
+	mailer.failing = nil
+	require.NoError(t, d.Send(testDate))
+	assert.Equal(t, []string{"bills@example.com", "yankees@example.com"}, mailer.sent)
+}
+
+func TestDigest_SendUnsubscribeURL(t *testing.T) {
+	server := newSMTPStandIn(t, false)
+	store := &memoryStore{subscribers: []Subscriber{
+		{Email: "bills@example.com", Teams: map[favorites.Sport][]string{favorites.NFL: {"BUF"}}, UnsubscribeToken: "a+b/c"},
+	}}
+	nfl := func(date time.Time) ([]Final, error) { return testFinals, nil }
+	d := NewDigest(zerolog.Nop(), store, NewSMTPMailer(SMTPConfig{Addr: server.addr(), From: "digest@mini-score.test"}),
+		"http://localhost:8080/", nfl)
+
+	require.NoError(t, d.Send(testDate))
+
+	mail := server.received()
+	require.Len(t, mail, 1)
+	assert.Contains(t, mail[0].Data, "Unsubscribe: http://localhost:8080/digest/unsubscribe?token=a%2Bb%2Fc\r\n")
+}
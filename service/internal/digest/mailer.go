@@ -0,0 +1,73 @@
+package digest
+
+import (
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+)
+
+type (
+	// Mailer sends digests.
+	Mailer interface {
+		Send(msg Message) error
+	}
+
+	// SMTPConfig is the server digests are sent through. Username and Password are only used when Username is set.
+	SMTPConfig struct {
+		Addr     string
+		Username string
+		Password string
+		From     string
+	}
+
+	// SMTPMailer sends digests as plain text email through an SMTP server.
+	SMTPMailer struct {
+		addr string
+		from string
+		auth smtp.Auth
+		now  func() time.Time
+	}
+)
+
+func NewSMTPMailer(cfg SMTPConfig) *SMTPMailer {
+	m := &SMTPMailer{addr: cfg.Addr, from: cfg.From, now: time.Now}
+	if cfg.Username != "" {
+		host, _, _ := net.SplitHostPort(cfg.Addr)
+		m.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, host)
+	}
+	return m
+}
+
+// Send sends msg, upgrading to TLS when the server offers it.
+func (m *SMTPMailer) Send(msg Message) error {
+	return smtp.SendMail(m.addr, m.auth, m.from, []string{msg.To}, msg.bytes(m.from, m.now()))
+}
+
+// bytes writes the message as RFC 5322 email. The List-Unsubscribe headers let mail clients offer one click
+// unsubscribing (RFC 8058).
+func (msg Message) bytes(from string, date time.Time) []byte {
+	sb := strings.Builder{}
+	header := func(name, value string) {
+		sb.WriteString(name + ": " + stripNewlines(value) + "\r\n")
+	}
+	header("From", from)
+	header("To", msg.To)
+	header("Subject", msg.Subject)
+	header("Date", date.Format(time.RFC1123Z))
+	header("MIME-Version", "1.0")
+	header("Content-Type", "text/plain; charset=UTF-8")
+	header("Content-Transfer-Encoding", "8bit")
+	if msg.UnsubscribeURL != "" {
+		header("List-Unsubscribe", "<"+msg.UnsubscribeURL+">")
+		header("List-Unsubscribe-Post", "List-Unsubscribe=One-Click")
+	}
+	sb.WriteString("\r\n")
+	sb.WriteString(strings.ReplaceAll(msg.Body, "\n", "\r\n"))
+	return []byte(sb.String())
+}
+
+// stripNewlines keeps values from adding headers of their own.
+func stripNewlines(value string) string {
+	return strings.NewReplacer("\r", "", "\n", "").Replace(value)
+}
@@ -0,0 +1,182 @@
+package digest
+
+import (
+	"bufio"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	// smtpStandIn is a local SMTP server that keeps the mail it is sent, so sending can be tested offline.
+	smtpStandIn struct {
+		listener net.Listener
+		auth     bool
+		lock     sync.Mutex
+		mail     []receivedMail
+	}
+
+	receivedMail struct {
+		From string
+		To   []string
+		Auth string
+		Data string
+	}
+)
+
+func newSMTPStandIn(t *testing.T, auth bool) *smtpStandIn {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := &smtpStandIn{listener: listener, auth: auth}
+	t.Cleanup(func() { _ = listener.Close() })
+	go s.serve()
+	return s
+}
+
+func (s *smtpStandIn) addr() string {
+	return s.listener.Addr().String()
+}
+
+func (s *smtpStandIn) received() []receivedMail {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return append([]receivedMail(nil), s.mail...)
+}
+
+func (s *smtpStandIn) serve() {
+	for {
+		conn, err := s.listener.Accept()
+		if err != nil {
+			return
+		}
+		go s.handle(conn)
+	}
+}
+
+func (s *smtpStandIn) handle(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(lines ...string) {
+		_, _ = conn.Write([]byte(strings.Join(lines, "\r\n") + "\r\n"))
+	}
+
+	var mail receivedMail
+	reply("220 localhost stand-in")
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch verb {
+		case "EHLO":
+			if s.auth {
+				reply("250-localhost", "250-8BITMIME", "250 AUTH PLAIN")
+			} else {
+				reply("250-localhost", "250 8BITMIME")
+			}
+		case "AUTH":
+			mail.Auth = line
+			reply("235 accepted")
+		case "MAIL":
+			// parameters like BODY=8BITMIME follow the address
+			mail.From = strings.Fields(strings.TrimPrefix(line, "MAIL FROM:"))[0]
+			reply("250 ok")
+		case "RCPT":
+			mail.To = append(mail.To, strings.TrimPrefix(line, "RCPT TO:"))
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			data := strings.Builder{}
+			for {
+				dataLine, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if dataLine == ".\r\n" {
+					break
+				}
+				data.WriteString(dataLine)
+			}
+			mail.Data = data.String()
+			s.lock.Lock()
+			s.mail = append(s.mail, mail)
+			s.lock.Unlock()
+			mail = receivedMail{}
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 ok")
+		}
+	}
+}
+
+func TestSMTPMailer_Send(t *testing.T) {
+	date := time.Date(2023, 9, 12, 7, 0, 0, 0, time.UTC)
+	msg := Message{
+		To:             "fan@example.com",
+		Subject:        "mini-score: finals for Sep 11, 2023",
+		Body:           "Final: LAR 30 @ SEA 13\n.\n",
+		UnsubscribeURL: "http://localhost:8080/digest/unsubscribe?token=t0ken",
+	}
+
+	testCases := map[string]struct {
+		username     string
+		expectedAuth bool
+	}{
+		"should send without auth": {},
+		"should send with auth": {
+			username:     "user",
+			expectedAuth: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			server := newSMTPStandIn(t, tc.expectedAuth)
+			// PlainAuth only sends credentials unencrypted to localhost.
+			_, port, err := net.SplitHostPort(server.addr())
+			require.NoError(t, err)
+			m := NewSMTPMailer(SMTPConfig{Addr: "localhost:" + port, Username: tc.username, Password: "pass", From: "digest@mini-score.test"})
+			m.now = func() time.Time { return date }
+
+			require.NoError(t, m.Send(msg))
+
+			mail := server.received()
+			require.Len(t, mail, 1)
+			assert.Equal(t, "<digest@mini-score.test>", mail[0].From)
+			assert.Equal(t, []string{"<fan@example.com>"}, mail[0].To)
+			assert.Equal(t, tc.expectedAuth, strings.HasPrefix(mail[0].Auth, "AUTH PLAIN"))
+			assert.Equal(t, "From: digest@mini-score.test\r\n"+
+				"To: fan@example.com\r\n"+
+				"Subject: mini-score: finals for Sep 11, 2023\r\n"+
+				"Date: Tue, 12 Sep 2023 07:00:00 +0000\r\n"+
+				"MIME-Version: 1.0\r\n"+
+				"Content-Type: text/plain; charset=UTF-8\r\n"+
+				"Content-Transfer-Encoding: 8bit\r\n"+
+				"List-Unsubscribe: <http://localhost:8080/digest/unsubscribe?token=t0ken>\r\n"+
+				"List-Unsubscribe-Post: List-Unsubscribe=One-Click\r\n"+
+				"\r\n"+
+				"Final: LAR 30 @ SEA 13\r\n"+
+				"..\r\n", mail[0].Data, "lines of a single dot should be escaped")
+		})
+	}
+}
+
+func TestMessage_Bytes(t *testing.T) {
+	msg := Message{To: "fan@example.com\r\nBcc: everyone@example.com", Subject: "finals", Body: "body"}
+
+	data := string(msg.bytes("digest@mini-score.test", time.Date(2023, 9, 12, 7, 0, 0, 0, time.UTC)))
+
+	assert.Contains(t, data, "To: fan@example.comBcc: everyone@example.com\r\n")
+	assert.NotContains(t, data, "List-Unsubscribe")
+}
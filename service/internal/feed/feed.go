@@ -0,0 +1,118 @@
+package feed
+
+import (
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+const (
+	ContentType = "application/atom+xml; charset=UTF-8"
+
+	namespace = "http://www.w3.org/2005/Atom"
+	author    = "mini-score"
+)
+
+type (
+	// Feed is an Atom (RFC 4287) feed of final scores that feed readers subscribe to.
+	Feed struct {
+		Title string
+		// ID must stay the same for the life of the feed, like urn:mini-score:nfl.
+		ID string
+		// Link is the page the feed follows and Self is the feed itself. Either may be empty.
+		Link, Self string
+		// Entries are newest first.
+		Entries []Entry
+	}
+
+	// Entry is a completed game. Content is plain text, like a line score, and keeps its line breaks.
+	Entry struct {
+		ID        string
+		Title     string
+		Published time.Time
+		Updated   time.Time
+		Content   string
+	}
+
+	atomFeed struct {
+		XMLName xml.Name    `xml:"feed"`
+		XMLNS   string      `xml:"xmlns,attr"`
+		Title   string      `xml:"title"`
+		ID      string      `xml:"id"`
+		Links   []atomLink  `xml:"link"`
+		Updated string      `xml:"updated"`
+		Author  atomAuthor  `xml:"author"`
+		Entries []atomEntry `xml:"entry"`
+	}
+
+	atomLink struct {
+		Rel  string `xml:"rel,attr,omitempty"`
+		Href string `xml:"href,attr"`
+	}
+
+	atomAuthor struct {
+		Name string `xml:"name"`
+	}
+
+	atomEntry struct {
+		ID        string      `xml:"id"`
+		Title     string      `xml:"title"`
+		Published string      `xml:"published"`
+		Updated   string      `xml:"updated"`
+		Content   atomContent `xml:"content"`
+	}
+
+	atomContent struct {
+		Type string `xml:"type,attr"`
+		Text string `xml:",chardata"`
+	}
+)
+
+// Write writes the feed as Atom. The feed is as new as its newest entry, or stamp when there are no entries.
+func (f Feed) Write(w io.Writer, stamp time.Time) error {
+	af := atomFeed{
+		XMLNS:   namespace,
+		Title:   f.Title,
+		ID:      f.ID,
+		Updated: timestamp(stamp),
+		Author:  atomAuthor{Name: author},
+	}
+	if f.Link != "" {
+		af.Links = append(af.Links, atomLink{Href: f.Link})
+	}
+	if f.Self != "" {
+		af.Links = append(af.Links, atomLink{Rel: "self", Href: f.Self})
+	}
+
+	var updated time.Time
+	for _, e := range f.Entries {
+		if e.Updated.After(updated) {
+			updated = e.Updated
+		}
+		af.Entries = append(af.Entries, atomEntry{
+			ID:        e.ID,
+			Title:     e.Title,
+			Published: timestamp(e.Published),
+			Updated:   timestamp(e.Updated),
+			Content:   atomContent{Type: "text", Text: e.Content},
+		})
+	}
+	if !updated.IsZero() {
+		af.Updated = timestamp(updated)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(af); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+func timestamp(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
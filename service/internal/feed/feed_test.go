@@ -0,0 +1,69 @@
+package feed
+
+import (
+	"bytes"
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestFeed_Write(t *testing.T) {
+	final := time.Date(2023, 9, 12, 2, 41, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		feed   Feed
+		golden string
+	}{
+		"should write entries with escaped text": {
+			feed: Feed{
+				Title: "NFL Final Scores",
+				ID:    "urn:mini-score:nfl",
+				Link:  "http://localhost:8080/nfl",
+				Self:  "http://localhost:8080/nfl/feed.atom",
+				Entries: []Entry{
+					{
+						ID:        "urn:mini-score:nfl:401547356",
+						Title:     "Final/OT: BUF 16 @ NYJ 22",
+						Published: final,
+						Updated:   final.Add(5 * time.Minute),
+						Content:   "* Q    1  2  3  4  5    T *\n* BUF  0 13  0  3  0   16 *",
+					},
+					{
+						ID:        "urn:mini-score:nfl:401547355",
+						Title:     "Final: LAR 30 @ SEA 13 <Week 1 & done>",
+						Published: final.Add(-5 * time.Hour),
+						Updated:   final.Add(-5 * time.Hour),
+						Content:   "LAR 30, SEA 13",
+					},
+				},
+			},
+			golden: "feed.atom",
+		},
+		"should write a feed without entries": {
+			feed:   Feed{Title: "MLB Final Scores", ID: "urn:mini-score:mlb"},
+			golden: "empty.atom",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			require.NoError(t, tc.feed.Write(&buf, final.Add(time.Hour)))
+
+			golden := filepath.Join("test-data", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package finger
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+const (
+	// Usage is the reply to a query that is not a sport or team.
+	Usage = "Usage: finger [nfl|mlb] [TEAM ...]@host, e.g. finger nfl@host or finger KC@host"
+
+	// width fits boards to a classic 80 column terminal.
+	width   = 80
+	perLine = 3
+	// timeout bounds how long a client has to send its query and read the board.
+	timeout  = 30 * time.Second
+	maxQuery = 512
+)
+
+// ErrForwarding is returned for queries that ask to be forwarded to another host, which RFC 1288 lets servers refuse.
+var ErrForwarding = errors.New("finger forwarding is not supported")
+
+// Server answers finger queries with the boards they ask for.
+type Server struct {
+	logger zerolog.Logger
+	source cli.Source
+}
+
+func NewServer(logger zerolog.Logger, source cli.Source) *Server {
+	return &Server{logger: logger, source: source}
+}
+
+// Serve answers each finger connection accepted on l until l is closed.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	logger := s.logger.With().Str("remote", conn.RemoteAddr().String()).Str("protocol", "finger").Logger()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	query, err := bufio.NewReader(io.LimitReader(conn, maxQuery)).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Debug().Err(err).Msg("while reading finger query")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	reply := s.Reply(ctx, query)
+	if _, err := io.WriteString(conn, strings.ReplaceAll(reply, "\n", "\r\n")); err != nil {
+		logger.Debug().Err(err).Msg("while writing finger reply")
+	}
+}
+
+// Reply answers a query with today's boards. Boards that can not be loaded say so in place of the board.
+func (s *Server) Reply(ctx context.Context, query string) string {
+	opts, err := ParseQuery(query)
+	if err != nil {
+		return fmt.Sprintf("%s\n%s\n", err, Usage)
+	}
+
+	out, err := cli.NewClient(s.source, opts).Draw(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Str("query", query).Msg("while drawing boards for finger")
+	}
+	return out
+}
+
+// ParseQuery reads a finger query like "nfl", "KC" or "mlb NYY BOS". Words may also be separated by + or commas.
+// A query without a sport shows every sport, and one without teams shows every game.
+func ParseQuery(query string) (cli.Options, error) {
+	query = strings.TrimSpace(query)
+	// /W asks for a verbose answer, there is only one kind.
+	if strings.HasPrefix(strings.ToUpper(query), "/W") {
+		query = query[2:]
+	}
+	if strings.Contains(query, "@") {
+		return cli.Options{}, ErrForwarding
+	}
+
+	opts := cli.Options{Location: time.Local, Text: renderer.TextOptions{Width: width, PerLine: perLine}}
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return r == ' ' || r == '\t' || r == '+' || r == ','
+	})
+	for _, word := range words {
+		switch sport := favorites.Sport(strings.ToLower(word)); sport {
+		case favorites.NFL, favorites.MLB:
+			opts.Sports = append(opts.Sports, sport)
+			continue
+		}
+		teams := favorites.ParseTeams(word, ",")
+		if len(teams) == 0 {
+			return cli.Options{}, fmt.Errorf("%q is not a sport or team", word)
+		}
+		opts.Teams = append(opts.Teams, teams...)
+	}
+	if len(opts.Sports) == 0 {
+		opts.Sports = favorites.Sports
+	}
+	return opts, nil
+}
@@ -0,0 +1,145 @@
+package finger
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	boardRequest struct {
+		sport favorites.Sport
+		teams []string
+	}
+
+	fakeSource struct {
+		mu       sync.Mutex
+		requests []boardRequest
+	}
+)
+
+func (f *fakeSource) Board(_ context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, boardRequest{sport: sport, teams: teams})
+	return renderer.Board{Date: date, Games: []renderer.Game{{
+		PeriodLabel: "Q",
+		Periods:     4,
+		TotalLabels: []string{"T"},
+		Away:        renderer.Team{Name: "KC", Totals: []string{"7"}},
+		Home:        renderer.Team{Name: "DET", Totals: []string{"0"}},
+		Status:      renderer.Status{State: renderer.Live, Detail: "Q1", Clock: "10:00"},
+	}}}, nil
+}
+
+func TestParseQuery(t *testing.T) {
+	testCases := map[string]struct {
+		query          string
+		expectedSports []favorites.Sport
+		expectedTeams  []string
+		expectedErr    string
+	}{
+		"should show every sport for an empty query": {
+			query:          "\r\n",
+			expectedSports: favorites.Sports,
+		},
+		"should show a sport": {
+			query:          "nfl\r\n",
+			expectedSports: []favorites.Sport{favorites.NFL},
+		},
+		"should show the games of a team in every sport": {
+			query:          "KC\r\n",
+			expectedSports: favorites.Sports,
+			expectedTeams:  []string{"KC"},
+		},
+		"should read a sport and teams": {
+			query:          "/W MLB+nyy,bos\r\n",
+			expectedSports: []favorites.Sport{favorites.MLB},
+			expectedTeams:  []string{"NYY", "BOS"},
+		},
+		"should not read anything else": {
+			query:       "chiefs!\r\n",
+			expectedErr: `"chiefs!" is not a sport or team`,
+		},
+		"should refuse forwarding": {
+			query:       "nfl@other.example.com\r\n",
+			expectedErr: ErrForwarding.Error(),
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			opts, err := ParseQuery(tc.query)
+
+			if tc.expectedErr != "" {
+				assert.EqualError(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedSports, opts.Sports)
+			assert.Equal(t, tc.expectedTeams, opts.Teams)
+			assert.True(t, opts.Date.IsZero(), "finger should show today's boards")
+		})
+	}
+}
+
+func TestServer_Serve(t *testing.T) {
+	testCases := map[string]struct {
+		query            string
+		expectedContains []string
+		expectedRequests []boardRequest
+	}{
+		"should answer with the board": {
+			query:            "nfl\r\n",
+			expectedContains: []string{"NFL\r\n", "KC"},
+			expectedRequests: []boardRequest{{sport: favorites.NFL}},
+		},
+		"should answer with the games of a team": {
+			query:            "KC\r\n",
+			expectedContains: []string{"NFL\r\n", "\r\nMLB\r\n"},
+			expectedRequests: []boardRequest{{sport: favorites.NFL, teams: []string{"KC"}}, {sport: favorites.MLB, teams: []string{"KC"}}},
+		},
+		"should answer bad queries with the usage": {
+			query:            "hockey\r\n",
+			expectedContains: []string{"\"hockey\" is not a sport or team\r\n" + Usage + "\r\n"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			l, err := net.Listen("tcp", "127.0.0.1:0")
+			require.NoError(t, err)
+			defer l.Close()
+			source := &fakeSource{}
+			go NewServer(zerolog.Nop(), source).Serve(l)
+
+			conn, err := net.Dial("tcp", l.Addr().String())
+			require.NoError(t, err)
+			defer conn.Close()
+			_, err = io.WriteString(conn, tc.query)
+			require.NoError(t, err)
+
+			out, err := io.ReadAll(conn)
+
+			require.NoError(t, err)
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, string(out), s)
+			}
+			assert.NotContains(t, strings.ReplaceAll(string(out), "\r\n", ""), "\n", "lines should end in CR LF")
+			assert.Equal(t, tc.expectedRequests, source.requests)
+		})
+	}
+}
@@ -0,0 +1,207 @@
+package gopher
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rs/zerolog"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// Item types, see RFC 1436.
+const (
+	typeText   = '0'
+	typeMenu   = '1'
+	typeError  = '3'
+	typeSearch = '7'
+	typeInfo   = 'i'
+)
+
+const (
+	dateLayout = "2006-01-02"
+	// today is the selector of the board that moves on to the next day.
+	today = "today"
+	// pastDays is how many days before today a sport's menu lists.
+	pastDays = 7
+
+	// width keeps lines within the 70 columns RFC 1436 asks for.
+	width   = 70
+	perLine = 2
+	// timeout bounds how long a client has to send its selector and read the answer.
+	timeout     = 30 * time.Second
+	maxSelector = 1024
+)
+
+// Server answers gopher requests with a menu of sports and dates, and boards as text items.
+//
+// The root menu lists the sports. A sport's menu, /nfl or /mlb, lists today, tomorrow and the days before as
+// /<sport>/<YYYY-MM-DD> text items, and a search for the games of teams. Only the listed days are served.
+type Server struct {
+	logger zerolog.Logger
+	source cli.Source
+	// host and port are where menus point clients to, which is where this server can be reached.
+	host string
+	port string
+	now  func() time.Time
+}
+
+func NewServer(logger zerolog.Logger, source cli.Source, host, port string) *Server {
+	return &Server{logger: logger, source: source, host: host, port: port, now: time.Now}
+}
+
+// Serve answers each gopher connection accepted on l until l is closed.
+func (s *Server) Serve(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go s.serve(conn)
+	}
+}
+
+func (s *Server) serve(conn net.Conn) {
+	defer conn.Close()
+	logger := s.logger.With().Str("remote", conn.RemoteAddr().String()).Str("protocol", "gopher").Logger()
+	_ = conn.SetDeadline(time.Now().Add(timeout))
+
+	request, err := bufio.NewReader(io.LimitReader(conn, maxSelector)).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		logger.Debug().Err(err).Msg("while reading gopher selector")
+		return
+	}
+	// A search sends its words after a tab. Gopher+ clients add a tab and + which is ignored.
+	fields := strings.Split(strings.TrimRight(request, "\r\n"), "\t")
+	selector, search := fields[0], ""
+	if len(fields) > 1 && fields[1] != "+" {
+		search = fields[1]
+	}
+
+	client := conn.RemoteAddr().String()
+	if host, _, err := net.SplitHostPort(client); err == nil {
+		client = host
+	}
+	ctx, cancel := context.WithTimeout(rate_limit.WithClient(context.Background(), client), timeout)
+	defer cancel()
+	if _, err := io.WriteString(conn, s.Answer(ctx, selector, search)); err != nil {
+		logger.Debug().Err(err).Msg("while writing gopher answer")
+	}
+}
+
+// Answer returns the menu or text item at selector, with search being the words of a search.
+func (s *Server) Answer(ctx context.Context, selector, search string) string {
+	path := strings.Split(strings.Trim(selector, "/"), "/")
+	switch {
+	case len(path) == 1 && path[0] == "":
+		return s.rootMenu()
+	case len(path) == 1 && isSport(path[0]):
+		return s.sportMenu(favorites.Sport(path[0]))
+	case len(path) == 2 && isSport(path[0]):
+		return s.board(ctx, favorites.Sport(path[0]), path[1], search)
+	default:
+		return s.errorMenu(fmt.Sprintf("%q does not exist", selector))
+	}
+}
+
+func (s *Server) rootMenu() string {
+	sb := strings.Builder{}
+	sb.WriteString(s.item(typeInfo, "mini-score", ""))
+	sb.WriteString(s.item(typeInfo, "", ""))
+	for _, sport := range favorites.Sports {
+		sb.WriteString(s.item(typeMenu, strings.ToUpper(string(sport)), "/"+string(sport)))
+	}
+	sb.WriteString(".\r\n")
+	return sb.String()
+}
+
+func (s *Server) sportMenu(sport favorites.Sport) string {
+	date := s.today()
+	prefix := "/" + string(sport) + "/"
+	name := strings.ToUpper(string(sport))
+
+	sb := strings.Builder{}
+	sb.WriteString(s.item(typeInfo, name+" scores", ""))
+	sb.WriteString(s.item(typeInfo, "", ""))
+	sb.WriteString(s.item(typeText, "Today", prefix+today))
+	sb.WriteString(s.item(typeSearch, "Today's games of teams, e.g. KC BUF", prefix+today))
+	sb.WriteString(s.item(typeText, "Tomorrow, "+date.AddDate(0, 0, 1).Format("Mon Jan 2"), prefix+date.AddDate(0, 0, 1).Format(dateLayout)))
+	for i := 1; i <= pastDays; i++ {
+		day := date.AddDate(0, 0, -i)
+		sb.WriteString(s.item(typeText, day.Format("Mon Jan 2"), prefix+day.Format(dateLayout)))
+	}
+	sb.WriteString(".\r\n")
+	return sb.String()
+}
+
+// board draws the board of sport on the date of the selector as a text item. Search words are teams to show the
+// games of. Dates the sport's menu does not list are refused.
+func (s *Server) board(ctx context.Context, sport favorites.Sport, day, search string) string {
+	opts := cli.Options{Sports: []favorites.Sport{sport}, Location: time.Local, Text: renderer.TextOptions{Width: width, PerLine: perLine}}
+	if day != today {
+		date, err := time.ParseInLocation(dateLayout, day, time.Local)
+		if err != nil {
+			return s.errorMenu(fmt.Sprintf("%q is not a date", day))
+		}
+		if date.After(s.today().AddDate(0, 0, 1)) || date.Before(s.today().AddDate(0, 0, -pastDays)) {
+			return s.errorMenu(fmt.Sprintf("%q is not one of the listed days", day))
+		}
+		opts.Date = date
+	}
+	for _, word := range strings.Fields(strings.ReplaceAll(search, ",", " ")) {
+		teams := favorites.ParseTeams(word, ",")
+		if len(teams) == 0 {
+			return s.errorMenu(fmt.Sprintf("%q is not a team", word))
+		}
+		opts.Teams = append(opts.Teams, teams...)
+	}
+
+	out, err := cli.NewClient(s.source, opts).Draw(ctx)
+	if err != nil {
+		s.logger.Error().Err(err).Str("sport", string(sport)).Str("date", day).Msg("while drawing board for gopher")
+	}
+	return textItem(out)
+}
+
+// today returns the start of the current day.
+func (s *Server) today() time.Time {
+	now := s.now().In(time.Local)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// errorMenu is a menu of one error item, which is how gopher says a selector can not be answered.
+func (s *Server) errorMenu(message string) string {
+	return fmt.Sprintf("%c%s\t\terror.host\t1\r\n.\r\n", typeError, message)
+}
+
+func (s *Server) item(itemType byte, display, selector string) string {
+	if itemType == typeInfo {
+		return fmt.Sprintf("%c%s\t\terror.host\t1\r\n", itemType, display)
+	}
+	return fmt.Sprintf("%c%s\t%s\t%s\t%s\r\n", itemType, display, selector, s.host, s.port)
+}
+
+// textItem ends the lines of text with CRLF, doubles leading periods and adds the closing period line.
+func textItem(text string) string {
+	lines := strings.Split(strings.TrimRight(text, "\n"), "\n")
+	sb := strings.Builder{}
+	for _, line := range lines {
+		if strings.HasPrefix(line, ".") {
+			line = "." + line
+		}
+		sb.WriteString(line + "\r\n")
+	}
+	sb.WriteString(".\r\n")
+	return sb.String()
+}
+
+func isSport(s string) bool {
+	return s == string(favorites.NFL) || s == string(favorites.MLB)
+}
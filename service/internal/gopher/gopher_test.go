@@ -0,0 +1,161 @@
+package gopher
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	boardRequest struct {
+		sport favorites.Sport
+		date  time.Time
+		teams []string
+	}
+
+	fakeSource struct {
+		mu       sync.Mutex
+		requests []boardRequest
+	}
+)
+
+func (f *fakeSource) Board(_ context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, boardRequest{sport: sport, date: date, teams: teams})
+	return renderer.Board{Date: date, Games: []renderer.Game{{
+		PeriodLabel: "Q",
+		Periods:     4,
+		TotalLabels: []string{"T"},
+		Away:        renderer.Team{Name: "KC", Totals: []string{"7"}},
+		Home:        renderer.Team{Name: "DET", Totals: []string{"0"}},
+		Status:      renderer.Status{State: renderer.Live, Detail: "Q1", Clock: "10:00"},
+	}}}, nil
+}
+
+func newTestServer(source *fakeSource) *Server {
+	s := NewServer(zerolog.Nop(), source, "scores.example.com", "70")
+	s.now = func() time.Time { return time.Date(2023, 9, 10, 12, 0, 0, 0, time.Local) }
+	return s
+}
+
+func TestServer_Answer(t *testing.T) {
+	testCases := map[string]struct {
+		selector         string
+		search           string
+		expected         string
+		expectedContains []string
+		expectedRequest  *boardRequest
+	}{
+		"should list the sports": {
+			selector: "",
+			expected: "imini-score\t\terror.host\t1\r\n" +
+				"i\t\terror.host\t1\r\n" +
+				"1NFL\t/nfl\tscores.example.com\t70\r\n" +
+				"1MLB\t/mlb\tscores.example.com\t70\r\n" +
+				".\r\n",
+		},
+		"should list the days of a sport": {
+			selector: "/nfl",
+			expectedContains: []string{
+				"0Today\t/nfl/today\tscores.example.com\t70\r\n",
+				"7Today's games of teams, e.g. KC BUF\t/nfl/today\tscores.example.com\t70\r\n",
+				"0Tomorrow, Mon Sep 11\t/nfl/2023-09-11\tscores.example.com\t70\r\n",
+				"0Sat Sep 9\t/nfl/2023-09-09\tscores.example.com\t70\r\n",
+				"0Sun Sep 3\t/nfl/2023-09-03\tscores.example.com\t70\r\n",
+			},
+		},
+		"should serve a day's board as text": {
+			selector:         "/mlb/2023-09-07",
+			expectedContains: []string{"MLB\r\n", "KC", "\r\n.\r\n"},
+			expectedRequest:  &boardRequest{sport: favorites.MLB, date: time.Date(2023, 9, 7, 0, 0, 0, 0, time.Local)},
+		},
+		"should not serve days that are not listed": {
+			selector: "/mlb/2023-09-02",
+			expected: "3\"2023-09-02\" is not one of the listed days\t\terror.host\t1\r\n.\r\n",
+		},
+		"should not serve days after tomorrow": {
+			selector: "/mlb/2023-09-12",
+			expected: "3\"2023-09-12\" is not one of the listed days\t\terror.host\t1\r\n.\r\n",
+		},
+		"should search for the games of teams": {
+			selector:         "/nfl/today",
+			search:           "kc, buf",
+			expectedContains: []string{"NFL\r\n", "KC"},
+			expectedRequest:  &boardRequest{sport: favorites.NFL, teams: []string{"KC", "BUF"}},
+		},
+		"should not search for anything but teams": {
+			selector: "/nfl/today",
+			search:   "chiefs?",
+			expected: "3\"chiefs?\" is not a team\t\terror.host\t1\r\n.\r\n",
+		},
+		"should not serve bad dates": {
+			selector: "/nfl/someday",
+			expected: "3\"someday\" is not a date\t\terror.host\t1\r\n.\r\n",
+		},
+		"should not serve unknown selectors": {
+			selector: "/nhl",
+			expected: "3\"/nhl\" does not exist\t\terror.host\t1\r\n.\r\n",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			source := &fakeSource{}
+
+			out := newTestServer(source).Answer(context.Background(), tc.selector, tc.search)
+
+			if tc.expected != "" {
+				assert.Equal(t, tc.expected, out)
+			}
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, out, s)
+			}
+			if tc.expectedRequest == nil {
+				assert.Empty(t, source.requests)
+				return
+			}
+			require.Len(t, source.requests, 1)
+			r := source.requests[0]
+			assert.Equal(t, tc.expectedRequest.sport, r.sport)
+			assert.Equal(t, tc.expectedRequest.teams, r.teams)
+			if !tc.expectedRequest.date.IsZero() {
+				assert.Equal(t, tc.expectedRequest.date, r.date)
+			}
+		})
+	}
+}
+
+func TestTextItem(t *testing.T) {
+	assert.Equal(t, "NFL\r\n..dots\r\n.\r\n", textItem("NFL\n.dots\n"))
+}
+
+func TestServer_Serve(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	go newTestServer(&fakeSource{}).Serve(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	_, err = io.WriteString(conn, "/mlb/today\t+\r\n")
+	require.NoError(t, err)
+
+	out, err := io.ReadAll(conn)
+
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(string(out), "MLB\r\n"), "gopher+ clients should get the board")
+	assert.True(t, strings.HasSuffix(string(out), "\r\n.\r\n"))
+}
@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/relay"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"github.com/rs/zerolog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	// maxDays bounds the days of games a query asks for, MLB boards are loaded a day at a time.
+	maxDays = 31
+	// maxDepth keeps queries from following teams to games and back without end.
+	maxDepth = 8
+	// maxBody bounds the size of a POSTed query.
+	maxBody = 64 << 10
+)
+
+//go:embed schema.graphql
+var schema string
+
+type (
+	// Source gets MLB boards and the pages of games, as the HTTP handlers show them.
+	Source interface {
+		cli.Source
+		Game(ctx context.Context, sport favorites.Sport, id string, loc *time.Location) (renderer.Board, error)
+	}
+
+	// NFL lists NFL teams and games from the database, loading the quarter scores of many games at once.
+	NFL interface {
+		GetTeams() ([]nflfacade.Team, error)
+		nflfacade.GameLister
+	}
+
+	// Handler serves GraphQL queries over teams, games and their period scores, POSTed as JSON.
+	Handler struct {
+		relay relay.Handler
+		nfl   NFL
+	}
+
+	// Resolver resolves the fields of Query.
+	Resolver struct {
+		logger   zerolog.Logger
+		source   Source
+		nfl      NFL
+		mlbTeams fetcher.TeamFetcher
+	}
+
+	sportArgs struct {
+		Sport *string
+	}
+
+	teamArgs struct {
+		Sport        string
+		Abbreviation string
+	}
+
+	gamesArgs struct {
+		Sport string
+		From  string
+		To    *string
+		Team  *string
+	}
+
+	gameArgs struct {
+		Sport string
+		ID    graphql.ID
+	}
+)
+
+func NewHandler(logger zerolog.Logger, source Source, nfl NFL, mlbTeams fetcher.TeamFetcher) (*Handler, error) {
+	resolver := &Resolver{
+		logger:   logger.With().Str("service", "GraphQL").Logger(),
+		source:   source,
+		nfl:      nfl,
+		mlbTeams: mlbTeams,
+	}
+	s, err := graphql.ParseSchema(schema, resolver, graphql.MaxDepth(maxDepth))
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{relay: relay.Handler{Schema: s}, nfl: nfl}, nil
+}
+
+// ServeHTTP answers a query with loaders shared by its fields, so each team, day and batch of quarter scores is
+// loaded once.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBody)
+	h.relay.ServeHTTP(w, r.WithContext(withLoaders(r.Context(), h.nfl)))
+}
+
+func (r *Resolver) Teams(ctx context.Context, args sportArgs) ([]*teamResolver, error) {
+	sports := favorites.Sports
+	if args.Sport != nil {
+		sports = []favorites.Sport{toSport(*args.Sport)}
+	}
+
+	var teams []*teamResolver
+	for _, sport := range sports {
+		sportTeams, err := r.teams(ctx, sport)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, sportTeams...)
+	}
+	return teams, nil
+}
+
+func (r *Resolver) Team(ctx context.Context, args teamArgs) (*teamResolver, error) {
+	teams, err := r.teams(ctx, toSport(args.Sport))
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range teams {
+		if strings.EqualFold(t.abbreviation, args.Abbreviation) {
+			return t, nil
+		}
+	}
+	return nil, nil
+}
+
+func (r *Resolver) Games(ctx context.Context, args gamesArgs) ([]*gameResolver, error) {
+	team := ""
+	if args.Team != nil {
+		teams := favorites.ParseTeams(*args.Team, ",")
+		if len(teams) != 1 {
+			return nil, fmt.Errorf("%q is not a team abbreviation", *args.Team)
+		}
+		team = teams[0]
+	}
+	return r.games(ctx, toSport(args.Sport), args.From, args.To, team)
+}
+
+func (r *Resolver) Game(ctx context.Context, args gameArgs) (*gameResolver, error) {
+	sport := toSport(args.Sport)
+	page, err := r.source.Game(ctx, sport, string(args.ID), timezone.Location(ctx))
+	if errors.Is(err, mlbfacade.ErrNoGame) || errors.Is(err, nflfacade.ErrNoGame) || (err == nil && len(page.Games) == 0) {
+		return nil, nil
+	}
+	if err != nil {
+		r.logger.Error().Err(err).Str("sport", string(sport)).Str("id", string(args.ID)).Msg("while getting game")
+		return nil, unavailable(sport)
+	}
+	return r.newGame(sport, page.Games[0], true), nil
+}
+
+// teams returns the teams of sport ordered by abbreviation.
+func (r *Resolver) teams(ctx context.Context, sport favorites.Sport) ([]*teamResolver, error) {
+	return loadersFrom(ctx, r.nfl).teams.get(sport, func() ([]*teamResolver, error) {
+		var teams []*teamResolver
+		switch sport {
+		case favorites.NFL:
+			nflTeams, err := r.nfl.GetTeams()
+			if err != nil {
+				r.logger.Error().Err(err).Msg("while getting NFL teams")
+				return nil, unavailable(sport)
+			}
+			for _, t := range nflTeams {
+				teams = append(teams, &teamResolver{root: r, sport: sport, abbreviation: t.Abbreviation, name: t.Name})
+			}
+		case favorites.MLB:
+			mlbTeams, err := r.mlbTeams.FetchTeams()
+			if err != nil {
+				r.logger.Error().Err(err).Msg("while getting MLB teams")
+				return nil, unavailable(sport)
+			}
+			for _, t := range mlbTeams {
+				teams = append(teams, &teamResolver{root: r, sport: sport, abbreviation: t.Abbreviation, name: t.Name})
+			}
+			sort.Slice(teams, func(i, j int) bool { return teams[i].abbreviation < teams[j].abbreviation })
+		}
+		return teams, nil
+	})
+}
+
+// games returns the games of sport on the days from through to, the games of team when team is not empty. The
+// quarter scores of NFL games are queued so they are loaded together. The source counts each past MLB day that is
+// not cached against the client, the query fails once the client has used its budget.
+func (r *Resolver) games(ctx context.Context, sport favorites.Sport, from string, to *string, team string) ([]*gameResolver, error) {
+	loc := timezone.Location(ctx)
+	start, end, err := days(from, to, loc)
+	if err != nil {
+		return nil, err
+	}
+	l := loadersFrom(ctx, r.nfl)
+
+	var games []*gameResolver
+	switch sport {
+	case favorites.NFL:
+		key := start.Format(time.RFC3339) + "/" + end.Format(time.RFC3339)
+		summaries, err := l.nflGames.get(key, func() ([]nflfacade.GameSummary, error) {
+			return r.nfl.GetGames(start, end, loc)
+		})
+		if err != nil {
+			r.logger.Error().Err(err).Time("start", start).Time("end", end).Msg("while getting NFL games")
+			return nil, unavailable(sport)
+		}
+		var gameIDs []string
+		for _, s := range summaries {
+			if team != "" && s.AwayTeam != team && s.HomeTeam != team {
+				continue
+			}
+			gameIDs = append(gameIDs, s.ID)
+			games = append(games, r.newGame(sport, renderer.Game{
+				ID:     s.ID,
+				Away:   renderer.Team{Name: s.AwayTeam},
+				Home:   renderer.Team{Name: s.HomeTeam},
+				Status: s.Status,
+			}, false))
+		}
+		l.quarters.queue(gameIDs...)
+	case favorites.MLB:
+		for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+			board, err := l.mlbDays.get(day.Format(time.RFC3339), func() (renderer.Board, error) {
+				return r.source.Board(ctx, sport, day, nil)
+			})
+			if errors.Is(err, rate_limit.ErrTooManyDates) {
+				return nil, err
+			}
+			if err != nil {
+				r.logger.Error().Err(err).Time("date", day).Msg("while getting MLB games")
+				return nil, unavailable(sport)
+			}
+			for _, g := range board.Games {
+				if team != "" && g.Away.Name != team && g.Home.Name != team {
+					continue
+				}
+				games = append(games, r.newGame(sport, g, true))
+			}
+		}
+	}
+	return games, nil
+}
+
+func (r *Resolver) newGame(sport favorites.Sport, game renderer.Game, scored bool) *gameResolver {
+	return &gameResolver{root: r, sport: sport, game: game, scored: scored}
+}
+
+// days parses the days from through to in loc, returning the start of from and the start of the day after to.
+func days(from string, to *string, loc *time.Location) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(dateLayout, from, loc)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("from %q is not YYYY-MM-DD", from)
+	}
+	last := start
+	if to != nil {
+		if last, err = time.ParseInLocation(dateLayout, *to, loc); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("to %q is not YYYY-MM-DD", *to)
+		}
+	}
+	end := last.AddDate(0, 0, 1)
+	if !end.After(start) {
+		return time.Time{}, time.Time{}, errors.New("to is before from")
+	}
+	if end.After(start.AddDate(0, 0, maxDays)) {
+		return time.Time{}, time.Time{}, fmt.Errorf("games can be asked for at most %d days at a time", maxDays)
+	}
+	return start, end, nil
+}
+
+// toSport maps a Sport enum value, which the schema has checked, to its sport.
+func toSport(sport string) favorites.Sport {
+	return favorites.Sport(strings.ToLower(sport))
+}
+
+func unavailable(sport favorites.Sport) error {
+	return fmt.Errorf("%s scores are unavailable", strings.ToUpper(string(sport)))
+}
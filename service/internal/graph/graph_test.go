@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	fakeSource struct {
+		mu     sync.Mutex
+		boards []time.Time
+	}
+
+	fakeNFL struct {
+		mu       sync.Mutex
+		games    int
+		quarters [][]string
+	}
+
+	fakeTeams struct{}
+)
+
+var kickoff = time.Date(2023, 9, 8, 0, 20, 0, 0, time.UTC)
+
+func (f *fakeSource) Board(_ context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.boards = append(f.boards, date)
+	if sport != favorites.MLB || teams != nil {
+		return renderer.Board{}, errors.New("only unfiltered MLB boards are asked for")
+	}
+	if date.Day() == 10 {
+		return renderer.Board{}, rate_limit.ErrTooManyDates
+	}
+	pk := date.Format("0102")
+	return renderer.Board{Date: date, Games: []renderer.Game{
+		{
+			ID:          pk + "1",
+			TotalLabels: []string{"R", "H", "E"},
+			Away:        renderer.Team{Name: "NYY", Periods: []string{"0", "2"}, Totals: []string{"2", "5", "0"}},
+			Home:        renderer.Team{Name: "BOS", Periods: []string{"1"}, Totals: []string{"1", "3", "1"}},
+			Status:      renderer.Status{State: renderer.Live, Detail: "Bot 2nd", Start: date.Add(19 * time.Hour)},
+		},
+		{
+			ID:          pk + "2",
+			TotalLabels: []string{"R", "H", "E"},
+			Away:        renderer.Team{Name: "TB"},
+			Home:        renderer.Team{Name: "TOR"},
+			Status:      renderer.Status{State: renderer.Scheduled, Clock: "7:07 PM"},
+		},
+	}}, nil
+}
+
+func (f *fakeSource) Game(_ context.Context, sport favorites.Sport, id string, _ *time.Location) (renderer.Board, error) {
+	if sport == favorites.NFL && id == "1" {
+		return renderer.Board{Games: []renderer.Game{{
+			ID:     "1",
+			Away:   renderer.Team{Name: "DET", Periods: []string{"0", "7", "7", "7"}, Totals: []string{"21"}},
+			Home:   renderer.Team{Name: "KC", Periods: []string{"7", "0", "7", "6"}, Totals: []string{"20"}},
+			Status: renderer.Status{State: renderer.Final, Detail: "QF", Clock: "0:00", Start: kickoff},
+		}}}, nil
+	}
+	return renderer.Board{}, nflfacade.ErrNoGame
+}
+
+func (f *fakeNFL) GetTeams() ([]nflfacade.Team, error) {
+	return []nflfacade.Team{{Abbreviation: "DET", Name: "Detroit Lions"}, {Abbreviation: "KC", Name: "Kansas City Chiefs"}}, nil
+}
+
+func (f *fakeNFL) GetGames(start, end time.Time, _ *time.Location) ([]nflfacade.GameSummary, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.games++
+	var games []nflfacade.GameSummary
+	for i, id := range []string{"1", "2", "3"} {
+		if k := kickoff.AddDate(0, 0, 3*i); !k.Before(start) && k.Before(end) {
+			games = append(games, nflfacade.GameSummary{ID: id, AwayTeam: "DET", HomeTeam: "KC", Status: renderer.Status{Detail: "Q", Start: k}})
+		}
+	}
+	return games, nil
+}
+
+func (f *fakeNFL) GetQuarterScores(gameIDs []string) (map[string]nflfacade.QuarterScores, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.quarters = append(f.quarters, gameIDs)
+	scores := make(map[string]nflfacade.QuarterScores)
+	for _, id := range gameIDs {
+		if id == "1" {
+			scores[id] = nflfacade.QuarterScores{"DET": {0, 7, 7, 7}, "KC": {7, 0, 7, 6}}
+		}
+	}
+	return scores, nil
+}
+
+func (f fakeTeams) FetchTeams() ([]fetcher.TeamInfo, error) {
+	return []fetcher.TeamInfo{{Abbreviation: "NYY", Name: "New York Yankees"}, {Abbreviation: "BOS", Name: "Boston Red Sox"}}, nil
+}
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	eastern, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		query            string
+		expected         string
+		expectedGames    int
+		expectedQuarters [][]string
+		expectedBoards   []time.Time
+	}{
+		"should list teams ordered by abbreviation": {
+			query:    `{ teams(sport: MLB) { sport abbreviation name } }`,
+			expected: `{"data":{"teams":[{"sport":"MLB","abbreviation":"BOS","name":"Boston Red Sox"},{"sport":"MLB","abbreviation":"NYY","name":"New York Yankees"}]}}`,
+		},
+		"should load the quarter scores of a week of games at once": {
+			query: `{ games(sport: NFL, from: "2023-09-07", to: "2023-09-12") { id start away { team { name } score } home { score } periods { number away home } } }`,
+			expected: `{"data":{"games":[
+				{"id":"1","start":"2023-09-08T00:20:00Z","away":{"team":{"name":"Detroit Lions"},"score":21},"home":{"score":20},"periods":[
+					{"number":1,"away":0,"home":7},{"number":2,"away":7,"home":0},{"number":3,"away":7,"home":7},{"number":4,"away":7,"home":6}]},
+				{"id":"2","start":"2023-09-11T00:20:00Z","away":{"team":{"name":"Detroit Lions"},"score":0},"home":{"score":0},"periods":[]}
+			]}}`,
+			expectedGames:    1,
+			expectedQuarters: [][]string{{"1", "2"}},
+		},
+		"should list the games of teams for the same days once": {
+			query:         `{ teams(sport: NFL) { abbreviation games(from: "2023-09-07") { id } } }`,
+			expected:      `{"data":{"teams":[{"abbreviation":"DET","games":[{"id":"1"}]},{"abbreviation":"KC","games":[{"id":"1"}]}]}}`,
+			expectedGames: 1,
+		},
+		"should list the innings of the games of a team": {
+			query: `{ games(sport: MLB, from: "2023-09-07", to: "2023-09-08", team: "nyy") { id state detail away { score hits errors } home { score hits errors } periods { number away home } } }`,
+			expected: `{"data":{"games":[
+				{"id":"09071","state":"LIVE","detail":"Bot 2nd","away":{"score":2,"hits":5,"errors":0},"home":{"score":1,"hits":3,"errors":1},"periods":[{"number":1,"away":0,"home":1},{"number":2,"away":2,"home":null}]},
+				{"id":"09081","state":"LIVE","detail":"Bot 2nd","away":{"score":2,"hits":5,"errors":0},"home":{"score":1,"hits":3,"errors":1},"periods":[{"number":1,"away":0,"home":1},{"number":2,"away":2,"home":null}]}
+			]}}`,
+			expectedBoards: []time.Time{time.Date(2023, 9, 7, 0, 0, 0, 0, eastern), time.Date(2023, 9, 8, 0, 0, 0, 0, eastern)},
+		},
+		"should fail once the client has asked for too many past days": {
+			query:          `{ games(sport: MLB, from: "2023-09-09", to: "2023-09-12") { id } }`,
+			expected:       `{"errors":[{"message":"too many past dates asked for, try again later","path":["games"]}],"data":null}`,
+			expectedBoards: []time.Time{time.Date(2023, 9, 9, 0, 0, 0, 0, eastern), time.Date(2023, 9, 10, 0, 0, 0, 0, eastern)},
+		},
+		"should get a game with its line score": {
+			query:    `{ game(sport: NFL, id: "1") { state clock away { score hits } periods { number } } }`,
+			expected: `{"data":{"game":{"state":"FINAL","clock":"0:00","away":{"score":21,"hits":null},"periods":[{"number":1},{"number":2},{"number":3},{"number":4}]}}}`,
+		},
+		"should return null for unknown games": {
+			query:    `{ game(sport: NFL, id: "9") { id } }`,
+			expected: `{"data":{"game":null}}`,
+		},
+		"should not list more than a month of games": {
+			query:    `{ games(sport: NFL, from: "2023-09-01", to: "2023-12-01") { id } }`,
+			expected: `{"errors":[{"message":"games can be asked for at most 31 days at a time","path":["games"]}],"data":null}`,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			source := &fakeSource{}
+			nfl := &fakeNFL{}
+			h, err := NewHandler(zerolog.Nop(), source, nfl, fakeTeams{})
+			require.NoError(t, err)
+
+			body, err := json.Marshal(map[string]string{"query": tc.query})
+			require.NoError(t, err)
+			req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(string(body)))
+			req = req.WithContext(timezone.WithLocation(req.Context(), eastern))
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.JSONEq(t, tc.expected, rec.Body.String())
+			assert.Equal(t, tc.expectedGames, nfl.games)
+			assert.Equal(t, tc.expectedQuarters, nfl.quarters)
+			assert.Equal(t, tc.expectedBoards, source.boards)
+		})
+	}
+}
+
+func TestHandler_ServeHTTP_maxBody(t *testing.T) {
+	h, err := NewHandler(zerolog.Nop(), &fakeSource{}, &fakeNFL{}, fakeTeams{})
+	require.NoError(t, err)
+
+	body := `{"query": "{ teams { name } }", "operationName": "` + strings.Repeat("a", maxBody) + `"}`
+	req := httptest.NewRequest(http.MethodPost, "/graphql", strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestQuarterLoader_Load(t *testing.T) {
+	nfl := &fakeNFL{}
+	l := &quarterLoader{nfl: nfl}
+	l.queue("1", "2")
+	l.queue("2", "3")
+
+	var wg sync.WaitGroup
+	for _, id := range []string{"3", "1", "2"} {
+		id := id
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := l.load(id)
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+	scores, err := l.load("4")
+
+	require.NoError(t, err)
+	assert.Nil(t, scores)
+	assert.Equal(t, [][]string{{"1", "2", "3"}, {"4"}}, nfl.quarters)
+}
@@ -0,0 +1,123 @@
+package graph
+
+import (
+	"context"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"sync"
+)
+
+type (
+	// loaders load what a query asks for once per request, however many fields ask for it.
+	loaders struct {
+		teams    memo[favorites.Sport, []*teamResolver]
+		nflGames memo[string, []nflfacade.GameSummary]
+		mlbDays  memo[string, renderer.Board]
+		quarters *quarterLoader
+	}
+
+	// memo calls load once for each key. Callers asking for a key being loaded wait for it.
+	memo[K comparable, V any] struct {
+		lock  sync.Mutex
+		calls map[K]*call[V]
+	}
+
+	call[V any] struct {
+		done  chan struct{}
+		value V
+		err   error
+	}
+
+	// quarterLoader loads the quarter scores of NFL games. Games are queued as they are listed, and the first game
+	// whose scores are asked for loads the scores of every queued game with one query.
+	quarterLoader struct {
+		nfl     NFL
+		lock    sync.Mutex
+		queued  []string
+		batches map[string]*call[map[string]nflfacade.QuarterScores]
+	}
+
+	loadersKey struct{}
+)
+
+func withLoaders(ctx context.Context, nfl NFL) context.Context {
+	return context.WithValue(ctx, loadersKey{}, &loaders{quarters: &quarterLoader{nfl: nfl}})
+}
+
+// loadersFrom returns the loaders of the request on ctx, or new ones which load for a single field when there are
+// none.
+func loadersFrom(ctx context.Context, nfl NFL) *loaders {
+	if l, ok := ctx.Value(loadersKey{}).(*loaders); ok {
+		return l
+	}
+	return &loaders{quarters: &quarterLoader{nfl: nfl}}
+}
+
+func (m *memo[K, V]) get(key K, load func() (V, error)) (V, error) {
+	m.lock.Lock()
+	if m.calls == nil {
+		m.calls = make(map[K]*call[V])
+	}
+	c, ok := m.calls[key]
+	if !ok {
+		c = &call[V]{done: make(chan struct{})}
+		m.calls[key] = c
+	}
+	m.lock.Unlock()
+
+	if !ok {
+		c.value, c.err = load()
+		close(c.done)
+	}
+	<-c.done
+	return c.value, c.err
+}
+
+// queue adds games to the next batch unless they are loaded or queued already.
+func (q *quarterLoader) queue(gameIDs ...string) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	for _, id := range gameIDs {
+		if _, ok := q.batches[id]; !ok && !contains(q.queued, id) {
+			q.queued = append(q.queued, id)
+		}
+	}
+}
+
+// load returns the quarter scores of a game, loading them with the games queued so far unless they are loaded.
+func (q *quarterLoader) load(gameID string) (nflfacade.QuarterScores, error) {
+	q.lock.Lock()
+	if q.batches == nil {
+		q.batches = make(map[string]*call[map[string]nflfacade.QuarterScores])
+	}
+	batch, ok := q.batches[gameID]
+	var gameIDs []string
+	if !ok {
+		if !contains(q.queued, gameID) {
+			q.queued = append(q.queued, gameID)
+		}
+		batch = &call[map[string]nflfacade.QuarterScores]{done: make(chan struct{})}
+		for _, id := range q.queued {
+			q.batches[id] = batch
+		}
+		gameIDs, q.queued = q.queued, nil
+	}
+	q.lock.Unlock()
+
+	if !ok {
+		batch.value, batch.err = q.nfl.GetQuarterScores(gameIDs)
+		close(batch.done)
+	}
+	<-batch.done
+	return batch.value[gameID], batch.err
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,230 @@
+package graph
+
+import (
+	"context"
+	graphql "github.com/graph-gophers/graphql-go"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	teamResolver struct {
+		root         *Resolver
+		sport        favorites.Sport
+		abbreviation string
+		name         string
+	}
+
+	// gameResolver resolves a game of a board or game page. Games listed from the NFL database are not scored,
+	// their quarter scores are loaded when they are asked for.
+	gameResolver struct {
+		root   *Resolver
+		sport  favorites.Sport
+		game   renderer.Game
+		scored bool
+	}
+
+	gameTeamResolver struct {
+		root         *Resolver
+		sport        favorites.Sport
+		abbreviation string
+		line         line
+	}
+
+	periodResolver struct {
+		number     int32
+		away, home *int32
+	}
+
+	// line is the line score of a team, with nil periods the team has not played.
+	line struct {
+		periods      []*int32
+		score        int32
+		hits, errors *int32
+	}
+
+	teamGamesArgs struct {
+		From string
+		To   *string
+	}
+)
+
+func (t *teamResolver) Sport() string {
+	return strings.ToUpper(string(t.sport))
+}
+
+func (t *teamResolver) Abbreviation() string {
+	return t.abbreviation
+}
+
+func (t *teamResolver) Name() string {
+	return t.name
+}
+
+func (t *teamResolver) Games(ctx context.Context, args teamGamesArgs) ([]*gameResolver, error) {
+	return t.root.games(ctx, t.sport, args.From, args.To, t.abbreviation)
+}
+
+func (g *gameResolver) ID() graphql.ID {
+	return graphql.ID(g.game.ID)
+}
+
+func (g *gameResolver) Sport() string {
+	return strings.ToUpper(string(g.sport))
+}
+
+func (g *gameResolver) State() string {
+	return strings.ToUpper(g.game.Status.State.String())
+}
+
+func (g *gameResolver) Detail() string {
+	return g.game.Status.Detail
+}
+
+func (g *gameResolver) Clock() string {
+	return g.game.Status.Clock
+}
+
+func (g *gameResolver) Start() *string {
+	if g.game.Status.Start.IsZero() {
+		return nil
+	}
+	start := g.game.Status.Start.Format(time.RFC3339)
+	return &start
+}
+
+func (g *gameResolver) Away(ctx context.Context) (*gameTeamResolver, error) {
+	away, _, err := g.lines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gameTeamResolver{root: g.root, sport: g.sport, abbreviation: g.game.Away.Name, line: away}, nil
+}
+
+func (g *gameResolver) Home(ctx context.Context) (*gameTeamResolver, error) {
+	_, home, err := g.lines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &gameTeamResolver{root: g.root, sport: g.sport, abbreviation: g.game.Home.Name, line: home}, nil
+}
+
+// Periods returns the periods either team has played.
+func (g *gameResolver) Periods(ctx context.Context) ([]*periodResolver, error) {
+	away, home, err := g.lines(ctx)
+	if err != nil {
+		return nil, err
+	}
+	n := len(away.periods)
+	if len(home.periods) > n {
+		n = len(home.periods)
+	}
+	periods := make([]*periodResolver, 0, n)
+	for i := 0; i < n; i++ {
+		p := &periodResolver{number: int32(i + 1)}
+		if i < len(away.periods) {
+			p.away = away.periods[i]
+		}
+		if i < len(home.periods) {
+			p.home = home.periods[i]
+		}
+		periods = append(periods, p)
+	}
+	return periods, nil
+}
+
+// lines returns the line scores of the away and home team, loading the quarter scores of NFL games that are not
+// scored.
+func (g *gameResolver) lines(ctx context.Context) (line, line, error) {
+	if g.scored || g.sport != favorites.NFL {
+		return lineOf(g.sport, g.game.Away), lineOf(g.sport, g.game.Home), nil
+	}
+	scores, err := loadersFrom(ctx, g.root.nfl).quarters.load(g.game.ID)
+	if err != nil {
+		g.root.logger.Error().Err(err).Str("id", g.game.ID).Msg("while getting quarter scores")
+		return line{}, line{}, unavailable(g.sport)
+	}
+	return quarterLine(scores[g.game.Away.Name]), quarterLine(scores[g.game.Home.Name]), nil
+}
+
+func (t *gameTeamResolver) Team(ctx context.Context) (*teamResolver, error) {
+	teams, err := t.root.teams(ctx, t.sport)
+	if err != nil {
+		return nil, err
+	}
+	for _, team := range teams {
+		if team.abbreviation == t.abbreviation {
+			return team, nil
+		}
+	}
+	// teams no longer listed, like renamed teams in old games, are still shown
+	return &teamResolver{root: t.root, sport: t.sport, abbreviation: t.abbreviation, name: t.abbreviation}, nil
+}
+
+func (t *gameTeamResolver) Score() int32 {
+	return t.line.score
+}
+
+func (t *gameTeamResolver) Hits() *int32 {
+	return t.line.hits
+}
+
+func (t *gameTeamResolver) Errors() *int32 {
+	return t.line.errors
+}
+
+func (p *periodResolver) Number() int32 {
+	return p.number
+}
+
+func (p *periodResolver) Away() *int32 {
+	return p.away
+}
+
+func (p *periodResolver) Home() *int32 {
+	return p.home
+}
+
+// lineOf maps the line score of a board, runs, hits and errors for MLB and the total for NFL.
+func lineOf(sport favorites.Sport, team renderer.Team) line {
+	l := line{periods: make([]*int32, 0, len(team.Periods))}
+	for _, p := range team.Periods {
+		l.periods = append(l.periods, number(p))
+	}
+	if score := number(team.Total(0)); score != nil {
+		l.score = *score
+	}
+	if sport == favorites.MLB {
+		l.hits, l.errors = number(team.Total(1)), number(team.Total(2))
+		if l.hits == nil {
+			l.hits = new(int32)
+		}
+		if l.errors == nil {
+			l.errors = new(int32)
+		}
+	}
+	return l
+}
+
+func quarterLine(quarters []int) line {
+	l := line{periods: make([]*int32, 0, len(quarters))}
+	for _, q := range quarters {
+		score := int32(q)
+		l.periods = append(l.periods, &score)
+		l.score += score
+	}
+	return l
+}
+
+// number parses a score of a board, nil when it is blank or X.
+func number(s string) *int32 {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return nil
+	}
+	v := int32(n)
+	return &v
+}
@@ -0,0 +1,99 @@
+package httpclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+const (
+	closed breakerState = iota
+	open
+	halfOpen
+)
+
+type (
+	breakerState int
+
+	// breaker is a consecutive failure circuit breaker for a single host.
+	breaker struct {
+		lock      sync.Mutex
+		state     breakerState
+		failures  int
+		openedAt  time.Time
+		threshold int
+		openFor   time.Duration
+		now       func() time.Time
+	}
+
+	breakers struct {
+		lock      sync.Mutex
+		byHost    map[string]*breaker
+		threshold int
+		openFor   time.Duration
+		now       func() time.Time
+	}
+)
+
+func newBreakers(threshold int, openFor time.Duration) *breakers {
+	return &breakers{
+		byHost:    make(map[string]*breaker),
+		threshold: threshold,
+		openFor:   openFor,
+		now:       time.Now,
+	}
+}
+
+func (b *breakers) forHost(host string) *breaker {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	br, ok := b.byHost[host]
+	if !ok {
+		br = &breaker{threshold: b.threshold, openFor: b.openFor, now: b.now}
+		b.byHost[host] = br
+	}
+	return br
+}
+
+// allow reports if a request may be made. An open breaker lets a single trial request through once openFor has elapsed.
+func (b *breaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch b.state {
+	case open:
+		if b.now().Sub(b.openedAt) < b.openFor {
+			return false
+		}
+		b.state = halfOpen
+		return true
+	case halfOpen:
+		return false
+	default:
+		return true
+	}
+}
+
+func (b *breaker) success() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.state = closed
+	b.failures = 0
+}
+
+func (b *breaker) failure() {
+	if b.threshold <= 0 {
+		return
+	}
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.failures++
+	if b.state == halfOpen || b.failures >= b.threshold {
+		b.state = open
+		b.openedAt = b.now()
+	}
+}
@@ -0,0 +1,40 @@
+package httpclient
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Now()
+	b := &breaker{threshold: 2, openFor: time.Minute, now: func() time.Time { return now }}
+
+	assert.True(t, b.allow(), "closed breaker should allow")
+	b.failure()
+	assert.True(t, b.allow(), "breaker should stay closed under the threshold")
+	b.failure()
+	assert.False(t, b.allow(), "breaker should open at the threshold")
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow(), "breaker should allow a trial once open duration elapses")
+	assert.False(t, b.allow(), "breaker should allow only one trial while half open")
+
+	b.failure()
+	assert.False(t, b.allow(), "failed trial should reopen the breaker")
+
+	now = now.Add(time.Minute)
+	assert.True(t, b.allow())
+	b.success()
+	assert.True(t, b.allow(), "successful trial should close the breaker")
+	assert.True(t, b.allow())
+}
+
+func TestBreakers_ForHost(t *testing.T) {
+	b := newBreakers(1, time.Minute)
+
+	b.forHost("statsapi.mlb.com").failure()
+
+	assert.False(t, b.forHost("statsapi.mlb.com").allow())
+	assert.True(t, b.forHost("www.espn.com").allow(), "hosts should not share a breaker")
+}
@@ -0,0 +1,79 @@
+package httpclient
+
+import (
+	"os"
+	"time"
+)
+
+const (
+	DefaultUserAgent = "mini-score/1.0 (+https://github.com/rmarken5/mini-score)"
+	// UserAgentEnv names the environment variable that overrides DefaultUserAgent, so deployments can say who to
+	// contact about their requests.
+	UserAgentEnv = "MINI_SCORE_USER_AGENT"
+)
+
+type (
+	// Config describes how requests to a single upstream are made.
+	Config struct {
+		// Timeout bounds the whole call including retries and backoff.
+		Timeout time.Duration
+		// AttemptTimeout bounds a single attempt. Zero means only Timeout applies.
+		AttemptTimeout time.Duration
+		// MaxRetries is the number of retries after the first attempt for idempotent requests.
+		MaxRetries int
+		// BaseBackoff and MaxBackoff bound the exponential backoff between retries.
+		BaseBackoff time.Duration
+		MaxBackoff  time.Duration
+		// FailureThreshold is the number of consecutive failures that opens a host's circuit.
+		FailureThreshold int
+		// OpenDuration is how long an open circuit rejects requests before allowing a trial request.
+		OpenDuration time.Duration
+		// UserAgent is sent with requests that have none, the user agent of the environment when empty.
+		UserAgent string
+	}
+)
+
+// StatsAPIConfig is used for statsapi.mlb.com which is fast and serves small JSON documents.
+func StatsAPIConfig() Config {
+	return Config{
+		Timeout:          10 * time.Second,
+		AttemptTimeout:   3 * time.Second,
+		MaxRetries:       2,
+		BaseBackoff:      100 * time.Millisecond,
+		MaxBackoff:       1 * time.Second,
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+		UserAgent:        userAgent(),
+	}
+}
+
+// ESPNConfig is used for espn.com pages which are large HTML documents and slower to respond.
+func ESPNConfig() Config {
+	return Config{
+		Timeout:          30 * time.Second,
+		AttemptTimeout:   10 * time.Second,
+		MaxRetries:       3,
+		BaseBackoff:      250 * time.Millisecond,
+		MaxBackoff:       5 * time.Second,
+		FailureThreshold: 5,
+		OpenDuration:     time.Minute,
+		UserAgent:        userAgent(),
+	}
+}
+
+// WebhookConfig is used to deliver webhooks. Deliveries are retried and dead lettered by the webhook dispatcher,
+// so a single attempt is made and a failing receiver does not open a circuit.
+func WebhookConfig() Config {
+	return Config{
+		Timeout:   10 * time.Second,
+		UserAgent: userAgent(),
+	}
+}
+
+// userAgent returns the user agent in UserAgentEnv, or DefaultUserAgent when it is not set.
+func userAgent() string {
+	if ua := os.Getenv(UserAgentEnv); ua != "" {
+		return ua
+	}
+	return DefaultUserAgent
+}
@@ -0,0 +1,41 @@
+package httpclient
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+type (
+	// StatusError is returned by CheckResponse when the upstream responds with a non 2xx status.
+	StatusError struct {
+		URL        string
+		StatusCode int
+		Body       string
+	}
+)
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("unexpected status %d from %s: %s", e.StatusCode, e.URL, e.Body)
+}
+
+// CheckResponse returns a *StatusError and closes the body when resp is not successful.
+// Callers remain responsible for closing the body of a successful response.
+func CheckResponse(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	url := ""
+	if resp.Request != nil {
+		url = resp.Request.URL.String()
+	}
+	return &StatusError{
+		URL:        url,
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(body)),
+	}
+}
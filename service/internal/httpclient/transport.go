@@ -0,0 +1,159 @@
+package httpclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var _ http.RoundTripper = &Transport{}
+
+type (
+	// Transport adds user agent, per attempt timeouts, retries and per host circuit breaking to a http.RoundTripper.
+	Transport struct {
+		base     http.RoundTripper
+		config   Config
+		breakers *breakers
+		sleep    func(ctx context.Context, d time.Duration) error
+	}
+
+	// cancelOnClose releases the attempt context once the caller is done with the body.
+	cancelOnClose struct {
+		io.ReadCloser
+		cancel context.CancelFunc
+	}
+)
+
+// New creates a http.Client for a single upstream described by config.
+func New(config Config) *http.Client {
+	return &http.Client{
+		Timeout:   config.Timeout,
+		Transport: NewTransport(http.DefaultTransport, config),
+	}
+}
+
+func NewTransport(base http.RoundTripper, config Config) *Transport {
+	if config.UserAgent == "" {
+		config.UserAgent = userAgent()
+	}
+	return &Transport{
+		base:     base,
+		config:   config,
+		breakers: newBreakers(config.FailureThreshold, config.OpenDuration),
+		sleep:    sleepContext,
+	}
+}
+
+// RoundTrip makes req, retrying it when it is idempotent. The breaker of the host counts the request once however
+// many attempts it takes, so a single failing request does not open the circuit by itself.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	br := t.breakers.forHost(req.URL.Host)
+	if !br.allow() {
+		return nil, fmt.Errorf("%s %s: %w", req.Method, req.URL.Host, ErrCircuitOpen)
+	}
+
+	attempts := 1
+	if isIdempotent(req) {
+		attempts += t.config.MaxRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.attempt(req)
+		if err == nil && !isRetryableStatus(resp.StatusCode) {
+			br.success()
+			return resp, nil
+		}
+
+		if attempt+1 >= attempts || req.Context().Err() != nil {
+			br.failure()
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			drain(resp.Body)
+		}
+		if err := t.sleep(req.Context(), wait); err != nil {
+			br.failure()
+			return nil, err
+		}
+	}
+}
+
+func (t *Transport) attempt(req *http.Request) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.config.AttemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.config.AttemptTimeout)
+	}
+
+	r := req.Clone(ctx)
+	if r.Header.Get("User-Agent") == "" {
+		r.Header.Set("User-Agent", t.config.UserAgent)
+	}
+
+	resp, err := t.base.RoundTrip(r)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff returns an exponential backoff with full jitter, honouring Retry-After when the upstream sends one.
+func (t *Transport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return minDuration(time.Duration(seconds)*time.Second, t.config.MaxBackoff)
+		}
+	}
+	if t.config.BaseBackoff <= 0 {
+		return 0
+	}
+	ceiling := minDuration(t.config.BaseBackoff<<attempt, t.config.MaxBackoff)
+	return time.Duration(rand.Int63n(int64(ceiling) + 1))
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, "":
+		return req.Body == nil || req.Body == http.NoBody
+	}
+	return false
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+func drain(body io.ReadCloser) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(body, 4096))
+	_ = body.Close()
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if b > 0 && a > b {
+		return b
+	}
+	return a
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
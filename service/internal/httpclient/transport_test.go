@@ -0,0 +1,234 @@
+package httpclient
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func testConfig() Config {
+	return Config{
+		Timeout:          time.Second,
+		AttemptTimeout:   500 * time.Millisecond,
+		MaxRetries:       2,
+		BaseBackoff:      time.Millisecond,
+		MaxBackoff:       5 * time.Millisecond,
+		FailureThreshold: 3,
+		OpenDuration:     time.Minute,
+		UserAgent:        "mini-score-test",
+	}
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	testCases := map[string]struct {
+		method           string
+		statuses         []int
+		expectedStatus   int
+		expectedAttempts int32
+	}{
+		"should not retry a successful request": {
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusOK},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 1,
+		},
+		"should retry a GET until it succeeds": {
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusOK},
+			expectedStatus:   http.StatusOK,
+			expectedAttempts: 3,
+		},
+		"should return the last response when retries are exhausted": {
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			expectedStatus:   http.StatusInternalServerError,
+			expectedAttempts: 3,
+		},
+		"should not retry a client error": {
+			method:           http.MethodGet,
+			statuses:         []int{http.StatusNotFound},
+			expectedStatus:   http.StatusNotFound,
+			expectedAttempts: 1,
+		},
+		"should not retry a POST": {
+			method:           http.MethodPost,
+			statuses:         []int{http.StatusServiceUnavailable, http.StatusOK},
+			expectedStatus:   http.StatusServiceUnavailable,
+			expectedAttempts: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var attempts int32
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := atomic.AddInt32(&attempts, 1)
+				assert.Equal(t, "mini-score-test", r.UserAgent())
+				w.WriteHeader(tc.statuses[n-1])
+			}))
+			defer s.Close()
+
+			client := New(testConfig())
+			req, err := http.NewRequest(tc.method, s.URL, strings.NewReader("body"))
+			require.NoError(t, err)
+			if tc.method == http.MethodGet {
+				req.Body = http.NoBody
+			}
+
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tc.expectedStatus, resp.StatusCode)
+			assert.Equal(t, tc.expectedAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestTransport_RoundTrip_OpensCircuit(t *testing.T) {
+	var attempts int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	cfg := testConfig()
+	cfg.MaxRetries = 0
+	client := New(cfg)
+
+	for i := 0; i < cfg.FailureThreshold; i++ {
+		resp, err := client.Get(s.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	_, err := client.Get(s.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(cfg.FailureThreshold), atomic.LoadInt32(&attempts))
+}
+
+func TestTransport_RoundTrip_CountsRetriesOnce(t *testing.T) {
+	var attempts int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	cfg := testConfig()
+	client := New(cfg)
+
+	// each request is retried MaxRetries times but counts once toward FailureThreshold
+	for i := 0; i < cfg.FailureThreshold; i++ {
+		resp, err := client.Get(s.URL)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	_, err := client.Get(s.URL)
+	assert.ErrorIs(t, err, ErrCircuitOpen)
+	assert.Equal(t, int32(cfg.FailureThreshold*(cfg.MaxRetries+1)), atomic.LoadInt32(&attempts))
+}
+
+func TestTransport_RoundTrip_UserAgentEnv(t *testing.T) {
+	t.Setenv(UserAgentEnv, "mini-score-deployment (ops@example.com)")
+
+	var userAgent string
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+	}))
+	defer s.Close()
+
+	resp, err := New(StatsAPIConfig()).Get(s.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "mini-score-deployment (ops@example.com)", userAgent)
+}
+
+func TestTransport_RoundTrip_AttemptTimeout(t *testing.T) {
+	var attempts int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) == 1 {
+			<-r.Context().Done()
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer s.Close()
+
+	cfg := testConfig()
+	cfg.AttemptTimeout = 50 * time.Millisecond
+	client := New(cfg)
+
+	resp, err := client.Get(s.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&attempts))
+}
+
+func TestTransport_RoundTrip_ContextCancelled(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer s.Close()
+
+	cfg := testConfig()
+	cfg.BaseBackoff = time.Minute
+	cfg.MaxBackoff = time.Minute
+	transport := NewTransport(http.DefaultTransport, cfg)
+	transport.sleep = func(ctx context.Context, d time.Duration) error {
+		return context.Canceled
+	}
+
+	req, err := http.NewRequest(http.MethodGet, s.URL, nil)
+	require.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestCheckResponse(t *testing.T) {
+	testCases := map[string]struct {
+		status      int
+		expectedErr bool
+	}{
+		"should accept 200": {status: http.StatusOK},
+		"should accept 204": {status: http.StatusNoContent},
+		"should reject 404": {status: http.StatusNotFound, expectedErr: true},
+		"should reject 503": {status: http.StatusServiceUnavailable, expectedErr: true},
+		"should reject 301": {status: http.StatusMovedPermanently, expectedErr: true},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			rec.WriteHeader(tc.status)
+			_, _ = rec.WriteString("upstream says no")
+
+			err := CheckResponse(rec.Result())
+			if !tc.expectedErr {
+				assert.NoError(t, err)
+				return
+			}
+			var statusErr *StatusError
+			require.ErrorAs(t, err, &statusErr)
+			assert.Equal(t, tc.status, statusErr.StatusCode)
+			assert.Equal(t, "upstream says no", statusErr.Body)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package lastgood
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+const bannerTimeFormat = "15:04"
+
+type (
+	// Store keeps the most recent valid value for a key so it can be served when the upstream is unavailable. It
+	// holds at most capacity keys, the key stored longest ago is forgotten to make room for a new one.
+	Store[T any] struct {
+		lock     sync.RWMutex
+		entries  map[string]entry[T]
+		capacity int
+		now      func() time.Time
+	}
+
+	entry[T any] struct {
+		value    T
+		storedAt time.Time
+	}
+)
+
+func New[T any](capacity int) *Store[T] {
+	return &Store[T]{
+		entries:  make(map[string]entry[T]),
+		capacity: capacity,
+		now:      time.Now,
+	}
+}
+
+// Put records value as the last known good value for key.
+func (s *Store[T]) Put(key string, value T) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, ok := s.entries[key]; !ok && len(s.entries) >= s.capacity {
+		s.evict()
+	}
+	s.entries[key] = entry[T]{value: value, storedAt: s.now()}
+}
+
+// evict forgets the key stored longest ago.
+func (s *Store[T]) evict() {
+	oldest := ""
+	for key, e := range s.entries {
+		if oldest == "" || e.storedAt.Before(s.entries[oldest].storedAt) {
+			oldest = key
+		}
+	}
+	delete(s.entries, oldest)
+}
+
+// Get returns the last known good value for key and when it was stored.
+func (s *Store[T]) Get(key string) (T, time.Time, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	e, ok := s.entries[key]
+	return e.value, e.storedAt, ok
+}
+
+// Banner is the line printed above a board that contains stale data.
+func Banner(asOf time.Time) string {
+	return fmt.Sprintf("as of %s, updates delayed", asOf.Format(bannerTimeFormat))
+}
+
+// Oldest returns the earliest non-zero time, or the zero time when there is none.
+func Oldest(times ...time.Time) time.Time {
+	var oldest time.Time
+	for _, t := range times {
+		if t.IsZero() {
+			continue
+		}
+		if oldest.IsZero() || t.Before(oldest) {
+			oldest = t
+		}
+	}
+	return oldest
+}
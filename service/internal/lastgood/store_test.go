@@ -0,0 +1,76 @@
+package lastgood
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestStore_PutGet(t *testing.T) {
+	now := time.Date(2023, 9, 10, 13, 5, 0, 0, time.UTC)
+	s := New[string](2)
+	s.now = func() time.Time { return now }
+
+	_, _, ok := s.Get("717649")
+	assert.False(t, ok)
+
+	s.Put("717649", "first")
+	now = now.Add(time.Minute)
+	s.Put("717649", "second")
+
+	value, asOf, ok := s.Get("717649")
+	assert.True(t, ok)
+	assert.Equal(t, "second", value)
+	assert.Equal(t, now, asOf)
+}
+
+func TestStore_Capacity(t *testing.T) {
+	now := time.Date(2023, 9, 10, 13, 5, 0, 0, time.UTC)
+	s := New[string](2)
+	s.now = func() time.Time { return now }
+
+	s.Put("717649", "first")
+	now = now.Add(time.Minute)
+	s.Put("717650", "second")
+	now = now.Add(time.Minute)
+	s.Put("717649", "first again")
+	now = now.Add(time.Minute)
+	s.Put("717651", "third")
+
+	_, _, ok := s.Get("717650")
+	assert.False(t, ok, "the key stored longest ago should be forgotten")
+	value, _, ok := s.Get("717649")
+	assert.True(t, ok)
+	assert.Equal(t, "first again", value)
+	_, _, ok = s.Get("717651")
+	assert.True(t, ok)
+	assert.Len(t, s.entries, 2)
+}
+
+func TestBanner(t *testing.T) {
+	asOf := time.Date(2023, 9, 10, 13, 5, 0, 0, time.UTC)
+	assert.Equal(t, "as of 13:05, updates delayed", Banner(asOf))
+}
+
+func TestOldest(t *testing.T) {
+	first := time.Date(2023, 9, 10, 13, 5, 0, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	testCases := map[string]struct {
+		times    []time.Time
+		expected time.Time
+	}{
+		"should return zero time when empty":   {},
+		"should ignore zero times":             {times: []time.Time{{}, second}, expected: second},
+		"should return the earliest time":      {times: []time.Time{second, first}, expected: first},
+		"should return zero when all are zero": {times: []time.Time{{}, {}}},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, Oldest(tc.times...))
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package layout
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"strings"
+)
+
+type (
+	// Box is a score box drawn as lines of text.
+	Box []string
+
+	// Layout decides how boxes are placed on a line.
+	Layout struct {
+		// Width is the number of columns available. Zero means unknown and PerLine is used.
+		Width int
+		// PerLine is the number of boxes on a line when Width is unknown.
+		PerLine int
+		// Gap is written after every box.
+		Gap string
+	}
+)
+
+// BoxesPerLine returns the most boxes that fit on a line within Width, padding every box to the widest box in
+// its column. At least one box is placed on a line even when it does not fit.
+func (l Layout) BoxesPerLine(boxes []Box) int {
+	if l.Width <= 0 {
+		if l.PerLine < 1 {
+			return 1
+		}
+		return l.PerLine
+	}
+
+	for perLine := len(boxes); perLine > 1; perLine-- {
+		if l.lineWidth(columnWidths(boxes, perLine)) <= l.Width {
+			return perLine
+		}
+	}
+	return 1
+}
+
+// Render returns the lines of boxes laid out BoxesPerLine boxes to a row. Boxes are padded to the widest box
+// in their column and the tallest box in their row so columns line up, and lines are cut at Width.
+func (l Layout) Render(boxes []Box) []string {
+	perLine := l.BoxesPerLine(boxes)
+	widths := columnWidths(boxes, perLine)
+
+	lines := make([]string, 0)
+	for start := 0; start < len(boxes); start += perLine {
+		end := start + perLine
+		if end > len(boxes) {
+			end = len(boxes)
+		}
+		row := boxes[start:end]
+
+		height := 0
+		for _, box := range row {
+			if len(box) > height {
+				height = len(box)
+			}
+		}
+
+		for i := 0; i < height; i++ {
+			sb := strings.Builder{}
+			for col, box := range row {
+				line := ""
+				if i < len(box) {
+					line = box[i]
+				}
+				sb.WriteString(pad(line, widths[col]) + l.Gap)
+			}
+			lines = append(lines, truncate(sb.String(), l.Width))
+		}
+	}
+	return lines
+}
+
+func (l Layout) lineWidth(widths []int) int {
+	total := 0
+	for _, w := range widths {
+		total += w + Width(l.Gap)
+	}
+	return total
+}
+
+// columnWidths returns the width of the widest box in each column when boxes are placed perLine to a row.
+func columnWidths(boxes []Box, perLine int) []int {
+	widths := make([]int, perLine)
+	for i, box := range boxes {
+		col := i % perLine
+		for _, line := range box {
+			if w := Width(line); w > widths[col] {
+				widths[col] = w
+			}
+		}
+	}
+	return widths
+}
+
+// Width returns the number of columns s takes on a terminal. Color escape sequences take no columns.
+func Width(s string) int {
+	return ansi.Width(s)
+}
+
+func pad(s string, width int) string {
+	if w := Width(s); w < width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	return s
+}
+
+func truncate(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+	return ansi.Truncate(s, width)
+}
@@ -0,0 +1,101 @@
+package layout
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestLayout_BoxesPerLine(t *testing.T) {
+	boxes := []Box{{"*****"}, {"*******"}, {"*****"}, {"*****"}}
+
+	testCases := map[string]struct {
+		layout   Layout
+		expected int
+	}{
+		"should use per line without a width": {
+			layout:   Layout{PerLine: 3},
+			expected: 3,
+		},
+		"should place one box without a width or per line": {
+			layout:   Layout{},
+			expected: 1,
+		},
+		"should fit every box on a wide line": {
+			layout:   Layout{Width: 80, Gap: " "},
+			expected: 4,
+		},
+		"should count the widest box in each column": {
+			layout:   Layout{Width: 14, Gap: " "},
+			expected: 2,
+		},
+		"should place one box when none fit": {
+			layout:   Layout{Width: 3, Gap: " "},
+			expected: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.layout.BoxesPerLine(boxes))
+		})
+	}
+}
+
+func TestLayout_Render(t *testing.T) {
+	boxes := []Box{
+		{"* * *", "* A *", "* * *"},
+		{"* * * *", "* BB  *", "* * * *"},
+		{"* C *"},
+	}
+
+	testCases := map[string]struct {
+		layout   Layout
+		expected []string
+	}{
+		"should align columns across rows": {
+			layout: Layout{PerLine: 2, Gap: " "},
+			expected: []string{
+				"* * * * * * * ",
+				"* A * * BB  * ",
+				"* * * * * * * ",
+				"* C * ",
+			},
+		},
+		"should never exceed width": {
+			layout: Layout{Width: 4, Gap: " "},
+			expected: []string{
+				"* * ",
+				"* A ",
+				"* * ",
+				"* * ",
+				"* BB",
+				"* * ",
+				"* C ",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.layout.Render(boxes))
+		})
+	}
+}
+
+func TestLayout_Render_RowHeight(t *testing.T) {
+	l := Layout{PerLine: 2, Gap: " "}
+
+	assert.Equal(t, []string{"ab x ", "cd   "}, l.Render([]Box{{"ab", "cd"}, {"x"}}))
+}
+
+func TestLayout_Render_Colored(t *testing.T) {
+	l := Layout{Width: 12, Gap: " "}
+	boxes := []Box{{ansi.Bold("* A *")}, {"* B *"}}
+
+	assert.Equal(t, []string{ansi.Bold("* A *") + " * B * "}, l.Render(boxes))
+}
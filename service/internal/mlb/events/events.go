@@ -0,0 +1,96 @@
+package events
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+)
+
+const (
+	ScoringPlay Kind = iota + 1
+	HomeRun
+	PitchingChange
+)
+
+const (
+	homeRunEventType        = "home_run"
+	pitchingChangeEventType = "pitching_substitution"
+)
+
+type (
+	// Kind is the kind of a play event.
+	Kind int
+
+	// Event is a notable play in a game. Home runs are not also reported as scoring plays.
+	Event struct {
+		GamePk int
+		Kind   Kind
+		Inning int
+		// HalfInning is top or bottom.
+		HalfInning  string
+		Description string
+		// AwayScore and HomeScore are the score after the play.
+		AwayScore int
+		HomeScore int
+
+		// id identifies the event within its game so it is only published once.
+		id string
+	}
+)
+
+// Extract returns the scoring plays, home runs and pitching changes of a game in the order they happened.
+// Plate appearances in progress only report pitching changes.
+func Extract(gamePk int, plays fetcher.FetchPlaysResponse) []Event {
+	events := make([]Event, 0)
+	for _, play := range plays.AllPlays {
+		for _, playEvent := range play.PlayEvents {
+			if playEvent.Details.EventType != pitchingChangeEventType {
+				continue
+			}
+			events = append(events, Event{
+				GamePk:      gamePk,
+				Kind:        PitchingChange,
+				Inning:      play.About.Inning,
+				HalfInning:  play.About.HalfInning,
+				Description: playEvent.Details.Description,
+				AwayScore:   play.Result.AwayScore,
+				HomeScore:   play.Result.HomeScore,
+				id:          fmt.Sprintf("%d.%d", play.About.AtBatIndex, playEvent.Index),
+			})
+		}
+
+		if !play.About.IsComplete {
+			continue
+		}
+		kind := ScoringPlay
+		switch {
+		case play.Result.EventType == homeRunEventType:
+			kind = HomeRun
+		case !play.About.IsScoringPlay:
+			continue
+		}
+		events = append(events, Event{
+			GamePk:      gamePk,
+			Kind:        kind,
+			Inning:      play.About.Inning,
+			HalfInning:  play.About.HalfInning,
+			Description: play.Result.Description,
+			AwayScore:   play.Result.AwayScore,
+			HomeScore:   play.Result.HomeScore,
+			id:          fmt.Sprintf("%d", play.About.AtBatIndex),
+		})
+	}
+	return events
+}
+
+func (k Kind) String() string {
+	switch k {
+	case ScoringPlay:
+		return "scoring play"
+	case HomeRun:
+		return "home run"
+	case PitchingChange:
+		return "pitching change"
+	default:
+		return "unknown"
+	}
+}
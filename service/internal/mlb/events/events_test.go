@@ -0,0 +1,94 @@
+package events
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func testPlays() fetcher.FetchPlaysResponse {
+	return fetcher.FetchPlaysResponse{AllPlays: []fetcher.Play{
+		{
+			Result: fetcher.PlayResult{EventType: "strikeout", Description: "Ketel Marte strikes out swinging."},
+			About:  fetcher.PlayAbout{AtBatIndex: 0, HalfInning: "top", Inning: 1, IsComplete: true},
+		},
+		{
+			Result: fetcher.PlayResult{EventType: "home_run", Description: "Corbin Carroll homers (18).", AwayScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 1, HalfInning: "top", Inning: 1, IsComplete: true, IsScoringPlay: true},
+		},
+		{
+			Result: fetcher.PlayResult{EventType: "sac_fly", Description: "Lane Thomas scores.", AwayScore: 1, HomeScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 2, HalfInning: "bottom", Inning: 3, IsComplete: true, IsScoringPlay: true},
+			PlayEvents: []fetcher.PlayEvent{
+				{Index: 0, Type: "action", Details: fetcher.PlayEventDetails{EventType: "pitching_substitution", Description: "Pitching Change: Scott McGough replaces Zac Gallen."}},
+				{Index: 1, Type: "pitch"},
+			},
+		},
+		{
+			Result: fetcher.PlayResult{AwayScore: 1, HomeScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 3, HalfInning: "bottom", Inning: 3, IsScoringPlay: true},
+		},
+	}}
+}
+
+func TestExtract(t *testing.T) {
+	testCases := map[string]struct {
+		plays          fetcher.FetchPlaysResponse
+		expectedEvents []Event
+	}{
+		"should extract home runs, pitching changes and scoring plays in order": {
+			plays: testPlays(),
+			expectedEvents: []Event{
+				{GamePk: 717847, Kind: HomeRun, Inning: 1, HalfInning: "top", Description: "Corbin Carroll homers (18).", AwayScore: 1, id: "1"},
+				{GamePk: 717847, Kind: PitchingChange, Inning: 3, HalfInning: "bottom", Description: "Pitching Change: Scott McGough replaces Zac Gallen.", AwayScore: 1, HomeScore: 1, id: "2.0"},
+				{GamePk: 717847, Kind: ScoringPlay, Inning: 3, HalfInning: "bottom", Description: "Lane Thomas scores.", AwayScore: 1, HomeScore: 1, id: "2"},
+			},
+		},
+		"should return no events without plays": {
+			plays:          fetcher.FetchPlaysResponse{},
+			expectedEvents: []Event{},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedEvents, Extract(717847, tc.plays))
+		})
+	}
+}
+
+func TestStream_Publish(t *testing.T) {
+	stream := NewStream()
+	events, unsubscribe := stream.Subscribe()
+
+	extracted := Extract(717847, testPlays())
+	assert.Equal(t, extracted[:1], stream.Publish(717847, extracted[:1]))
+	assert.Equal(t, extracted[1:], stream.Publish(717847, extracted))
+
+	received := make([]Event, 0)
+	for i := 0; i < len(extracted); i++ {
+		received = append(received, <-events)
+	}
+	assert.Equal(t, extracted, received)
+	assert.Empty(t, events, "events should only be published once")
+	assert.True(t, stream.Watching(717847))
+
+	stream.Done(717847)
+	assert.False(t, stream.Watching(717847))
+	assert.Empty(t, stream.Publish(717847, extracted))
+	assert.Empty(t, events, "events of a game that is done should not be published")
+
+	stream.now = func() time.Time { return time.Now().Add(doneRetention + time.Minute) }
+	stream.Done(1)
+	assert.NotContains(t, stream.done, 717847, "games done long ago should be forgotten")
+	assert.NotContains(t, stream.published, 717847)
+
+	unsubscribe()
+	unsubscribe()
+	_, open := <-events
+	assert.False(t, open)
+	stream.Publish(717847, extracted)
+}
@@ -0,0 +1,114 @@
+package events
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// subscriberBuffer is how many events a subscriber can fall behind before it misses events.
+	subscriberBuffer = 64
+	// doneRetention is how long games are remembered as done, longer than a game is polled after it ends.
+	doneRetention = 48 * time.Hour
+)
+
+type (
+	// Stream publishes the events of games to subscribers, each event once.
+	Stream struct {
+		lock sync.Mutex
+		// published holds the events published for each game until the game is done.
+		published map[int]map[string]bool
+		// done holds when each game was done, for doneRetention.
+		done        map[int]time.Time
+		subscribers map[int]chan Event
+		next        int
+		now         func() time.Time
+	}
+)
+
+func NewStream() *Stream {
+	return &Stream{
+		published:   make(map[int]map[string]bool),
+		done:        make(map[int]time.Time),
+		subscribers: make(map[int]chan Event),
+		now:         time.Now,
+	}
+}
+
+// Subscribe returns a channel of events published from now on and a func that ends the subscription and closes
+// the channel. Subscribers that fall behind miss events rather than hold up publishing.
+func (s *Stream) Subscribe() (<-chan Event, func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	id := s.next
+	s.next++
+	events := make(chan Event, subscriberBuffer)
+	s.subscribers[id] = events
+
+	once := sync.Once{}
+	return events, func() {
+		once.Do(func() {
+			s.lock.Lock()
+			defer s.lock.Unlock()
+			delete(s.subscribers, id)
+			close(events)
+		})
+	}
+}
+
+// Publish sends the events of a game that have not been published before to every subscriber and returns them.
+// Events of games that are done are dropped.
+func (s *Stream) Publish(gamePk int, events []Event) []Event {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if _, done := s.done[gamePk]; done {
+		return nil
+	}
+	published, ok := s.published[gamePk]
+	if !ok {
+		published = make(map[string]bool)
+		s.published[gamePk] = published
+	}
+	var fresh []Event
+	for _, event := range events {
+		key := event.Kind.String() + event.id
+		if published[key] {
+			continue
+		}
+		published[key] = true
+		fresh = append(fresh, event)
+		for _, subscriber := range s.subscribers {
+			select {
+			case subscriber <- event:
+			default:
+			}
+		}
+	}
+	return fresh
+}
+
+// Watching reports if events of a game have been published and the game is not done.
+func (s *Stream) Watching(gamePk int) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, ok := s.published[gamePk]
+	return ok
+}
+
+// Done forgets the events of a game once it is over. Later events of the game are not published, games done more
+// than doneRetention ago are forgotten altogether.
+func (s *Stream) Done(gamePk int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	now := s.now()
+	delete(s.published, gamePk)
+	s.done[gamePk] = now
+	for pk, doneAt := range s.done {
+		if now.Sub(doneAt) > doneRetention {
+			delete(s.done, pk)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/digest"
+	"github.com/rmarken5/mini-score/service/internal/mlb/writer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"time"
+)
+
+func ProcessFinals(facade ScoreFacade, date time.Time) ([]digest.Final, error) {
+	return facade.processFinals(date)
+}
+
+// processFinals returns the finals played on date, the MLB source of the digest, ties and games called early
+// included. Postponed and cancelled games are left out.
+func (sf *ScoreFacadeImpl) processFinals(date time.Time) ([]digest.Final, error) {
+	scores, _, err := sf.scores(date)
+	if err != nil {
+		return nil, err
+	}
+
+	finals := make([]digest.Final, 0, len(scores))
+	for _, score := range scores {
+		if !score.GameData.Status.GameOver() {
+			continue
+		}
+		entry := writer.NewFinalEntry(score, time.Time{})
+		finals = append(finals, digest.Final{
+			Sport:     favorites.MLB,
+			AwayTeam:  score.GameData.Teams.Away.Abbreviation,
+			HomeTeam:  score.GameData.Teams.Home.Abbreviation,
+			Title:     entry.Title,
+			LineScore: entry.Content,
+		})
+	}
+	return finals, nil
+}
@@ -0,0 +1,50 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestScoreFacadeImpl_ProcessFinals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	games := []fetcher.Game{{GamePk: 1, Link: "/1"}, {GamePk: 2, Link: "/2"}, {GamePk: 3, Link: "/3"}}
+
+	final := testScore(1, "AZ", "WSH")
+	final.GamePk = 1
+	final.GameData.Status = fetcher.GameStatus{CodedGameState: "F", StatusCode: "F", AbstractGameState: "Final", DetailedState: "Final"}
+	final.LiveData.Linescore.Teams.Away.Runs = 3
+	postponed := testScore(2, "NYY", "BOS")
+	postponed.GamePk = 2
+	postponed.GameData.Status = fetcher.GameStatus{CodedGameState: "D", StatusCode: "DR", AbstractGameState: "Final", DetailedState: "Postponed"}
+	tied := testScore(3, "CHC", "STL")
+	tied.GamePk = 3
+	tied.GameData.Status = fetcher.GameStatus{CodedGameState: "F", StatusCode: "FT", AbstractGameState: "Final", DetailedState: "Final: Tied"}
+	tied.LiveData.Linescore.Teams.Away.Runs = 2
+	tied.LiveData.Linescore.Teams.Home.Runs = 2
+
+	gf := fetcher.NewMockGameFetcher(ctrl)
+	gf.EXPECT().FetchGames(gomock.Any()).Return(games, nil)
+	sf := fetcher.NewMockScoreFetcher(ctrl)
+	sf.EXPECT().FetchScore(games[0]).Return(final, nil)
+	sf.EXPECT().FetchScore(games[1]).Return(postponed, nil)
+	sf.EXPECT().FetchScore(games[2]).Return(tied, nil)
+
+	facade := NewScoreFacadeImpl(zerolog.Nop(), gf, sf, fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+
+	finals, err := ProcessFinals(facade, time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.Len(t, finals, 2)
+	assert.Equal(t, favorites.MLB, finals[0].Sport)
+	assert.Equal(t, "AZ", finals[0].AwayTeam)
+	assert.Equal(t, "WSH", finals[0].HomeTeam)
+	assert.Equal(t, "Final: AZ 3 @ WSH 0", finals[0].Title)
+	assert.NotEmpty(t, finals[0].LineScore)
+	assert.Equal(t, "Final: Tied: CHC 2 @ STL 2", finals[1].Title, "tied finals should be in the digest")
+}
@@ -0,0 +1,97 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/feed"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/mlb/writer"
+	"strings"
+	"sync"
+	"time"
+)
+
+// finalsCapacity is how many of the most recent finals the feed lists.
+const finalsCapacity = 50
+
+type (
+	// FinalStore keeps the feed entries of finals by game pk, so the feed outlives the server.
+	FinalStore interface {
+		SaveMLBFinal(gamePk int, entry feed.Entry) error
+		GetMLBFinals(limit int) ([]feed.Entry, error)
+	}
+
+	// finals holds the feed entries of games seen becoming final, in store when there is one and newest first in
+	// entries when there is not. Games are watched from the first time they are seen before the end, so games that
+	// ended while nothing was fetching their score are not listed. PollLiveGames fetches the scores of yesterday and
+	// today, so games ending after midnight are seen.
+	finals struct {
+		lock     sync.Mutex
+		store    FinalStore
+		watching map[int]bool
+		entries  []feed.Entry
+	}
+)
+
+func newFinals(store FinalStore) *finals {
+	return &finals{store: store, watching: make(map[int]bool)}
+}
+
+// observe publishes an entry at now when a watched game has become final. A game whose entry can not be stored is
+// watched on, so it is stored the next time it is seen.
+func (f *finals) observe(score *fetcher.FetchScoreResponse, now time.Time) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	switch {
+	case score.GameData.Status.GameOver():
+		if !f.watching[score.GamePk] {
+			return nil
+		}
+		entry := writer.NewFinalEntry(score, now)
+		if f.store != nil {
+			if err := f.store.SaveMLBFinal(score.GamePk, entry); err != nil {
+				return err
+			}
+		} else {
+			f.entries = append([]feed.Entry{entry}, f.entries...)
+			if len(f.entries) > finalsCapacity {
+				f.entries = f.entries[:finalsCapacity]
+			}
+		}
+		delete(f.watching, score.GamePk)
+	case isFinal(score):
+		// postponed, suspended and cancelled games are over without a final score
+		delete(f.watching, score.GamePk)
+	default:
+		f.watching[score.GamePk] = true
+	}
+	return nil
+}
+
+func (f *finals) list() ([]feed.Entry, error) {
+	if f.store != nil {
+		return f.store.GetMLBFinals(finalsCapacity)
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]feed.Entry(nil), f.entries...), nil
+}
+
+func ProcessFeed(facade ScoreFacade, link, self string) (string, error) {
+	return facade.processFeed(link, self)
+}
+
+// processFeed writes the feed of the most recent finals, which are recorded as PollLiveGames sees games end.
+func (sf *ScoreFacadeImpl) processFeed(link, self string) (string, error) {
+	entries, err := sf.finals.list()
+	if err != nil {
+		return "", err
+	}
+
+	f := feed.Feed{Title: "MLB Final Scores", ID: "urn:mini-score:mlb", Link: link, Self: self, Entries: entries}
+	sb := strings.Builder{}
+	if err := f.Write(&sb, time.Now()); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
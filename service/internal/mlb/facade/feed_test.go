@@ -0,0 +1,181 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/feed"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFinals_Observe(t *testing.T) {
+	live := fetcher.GameStatus{StatusCode: "I", AbstractGameState: "Live"}
+	final := fetcher.GameStatus{CodedGameState: "F", StatusCode: "F", AbstractGameState: "Final", DetailedState: "Final"}
+	tied := fetcher.GameStatus{CodedGameState: "F", StatusCode: "FT", AbstractGameState: "Final", DetailedState: "Final: Tied"}
+	shortened := fetcher.GameStatus{CodedGameState: "F", StatusCode: "FR", AbstractGameState: "Final", DetailedState: "Final"}
+	postponed := fetcher.GameStatus{CodedGameState: "D", StatusCode: "DR", AbstractGameState: "Final", DetailedState: "Postponed"}
+
+	testCases := map[string]struct {
+		statuses        []fetcher.GameStatus
+		expectedEntries int
+	}{
+		"should publish games that become final":         {statuses: []fetcher.GameStatus{live, final}, expectedEntries: 1},
+		"should publish a final once":                    {statuses: []fetcher.GameStatus{live, final, final}, expectedEntries: 1},
+		"should publish tied finals":                     {statuses: []fetcher.GameStatus{live, tied}, expectedEntries: 1},
+		"should publish finals called early":             {statuses: []fetcher.GameStatus{live, shortened}, expectedEntries: 1},
+		"should not publish games first seen final":      {statuses: []fetcher.GameStatus{final}},
+		"should not publish postponed games":             {statuses: []fetcher.GameStatus{live, postponed, final}},
+		"should not publish games that have not started": {statuses: []fetcher.GameStatus{{StatusCode: "S"}, live}},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			f := newFinals(nil)
+			for _, status := range tc.statuses {
+				score := testScore(1, "AZ", "WSH")
+				score.GamePk = 1
+				score.GameData.Status = status
+				require.NoError(t, f.observe(&score, time.Now()))
+			}
+
+			entries, err := f.list()
+			require.NoError(t, err)
+			assert.Len(t, entries, tc.expectedEntries)
+		})
+	}
+}
+
+func TestFinals_Capacity(t *testing.T) {
+	f := newFinals(nil)
+	for gamePk := 1; gamePk <= finalsCapacity+1; gamePk++ {
+		score := testScore(gamePk, "AZ", "WSH")
+		score.GamePk = gamePk
+		score.GameData.Status = fetcher.GameStatus{StatusCode: "I"}
+		require.NoError(t, f.observe(&score, time.Now()))
+		score.GameData.Status = fetcher.GameStatus{CodedGameState: "F", StatusCode: "F", DetailedState: "Final"}
+		require.NoError(t, f.observe(&score, time.Now()))
+	}
+
+	entries, err := f.list()
+	require.NoError(t, err)
+	require.Len(t, entries, finalsCapacity)
+	assert.Equal(t, "urn:mini-score:mlb:51", entries[0].ID, "newest final should be first")
+	assert.Equal(t, "urn:mini-score:mlb:2", entries[finalsCapacity-1].ID, "oldest final should be dropped")
+}
+
+func TestFinals_Store(t *testing.T) {
+	store := &fakeFinalStore{failures: 1}
+	f := newFinals(store)
+	score := testScore(1, "AZ", "WSH")
+	score.GamePk = 1
+	score.GameData.Status = fetcher.GameStatus{StatusCode: "I"}
+	require.NoError(t, f.observe(&score, time.Now()))
+
+	score.GameData.Status = fetcher.GameStatus{CodedGameState: "F", StatusCode: "F", DetailedState: "Final"}
+	assert.ErrorIs(t, f.observe(&score, time.Now()), errUpstream)
+	require.NoError(t, f.observe(&score, time.Now()), "a final that could not be stored should be stored when seen again")
+	require.NoError(t, f.observe(&score, time.Now()))
+
+	entries, err := f.list()
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "urn:mini-score:mlb:1", entries[0].ID)
+	assert.Empty(t, f.entries, "stored finals should not be kept in memory")
+}
+
+func TestScoreFacadeImpl_ProcessFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	// the game ends after midnight, while yesterday's games are polled
+	now := time.Date(2023, 6, 23, 0, 30, 0, 0, time.Local)
+	yesterday := time.Date(2023, 6, 22, 0, 0, 0, 0, time.Local)
+	games := []fetcher.Game{{GamePk: 1, Link: "/1"}}
+
+	live := testScore(1, "AZ", "SF")
+	live.GamePk = 1
+	live.GameData.Status = fetcher.GameStatus{StatusCode: "I", AbstractGameState: "Live"}
+	final := live
+	final.GameData.Status = fetcher.GameStatus{CodedGameState: "F", StatusCode: "F", AbstractGameState: "Final", DetailedState: "Final"}
+	final.LiveData.Linescore.Teams.Away.Runs = 3
+
+	gf := fetcher.NewMockGameFetcher(ctrl)
+	// past dates are kept in the history once their games are final
+	gf.EXPECT().FetchGames(yesterday).Return(games, nil).Times(2)
+	gf.EXPECT().FetchGames(yesterday.AddDate(0, 0, 1)).Return(nil, nil)
+	sf := fetcher.NewMockScoreFetcher(ctrl)
+	gomock.InOrder(
+		sf.EXPECT().FetchScore(games[0]).Return(live, nil),
+		sf.EXPECT().FetchScore(games[0]).Return(final, nil),
+	)
+	pf := fetcher.NewMockPlayFetcher(ctrl)
+	// the game is fetched once more after it ends
+	pf.EXPECT().FetchPlays(fetcher.NewGame(1)).Return(fetcher.FetchPlaysResponse{}, nil).Times(2)
+	store := &fakeFinalStore{}
+
+	facade := NewScoreFacadeImpl(zerolog.Nop(), gf, sf, pf, fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), store, nil)
+
+	facade.pollLiveGames(now)
+	atom, err := ProcessFeed(facade, "http://localhost/mlb", "http://localhost/mlb/feed.atom")
+	require.NoError(t, err)
+	assert.Contains(t, atom, "<title>MLB Final Scores</title>")
+	assert.Contains(t, atom, `<link rel="self" href="http://localhost/mlb/feed.atom"></link>`)
+	assert.NotContains(t, atom, "<entry>")
+
+	facade.pollLiveGames(now.Add(DefaultPollInterval))
+	atom, err = ProcessFeed(facade, "http://localhost/mlb", "http://localhost/mlb/feed.atom")
+	require.NoError(t, err)
+	assert.Contains(t, atom, "<id>urn:mini-score:mlb:1</id>")
+	assert.Contains(t, atom, "<title>Final: AZ 3 @ SF 0</title>")
+	assert.Contains(t, store.entries, 1, "finals should be stored")
+}
+
+func TestScoreFacadeImpl_ProcessFeed_storeFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), fetcher.NewMockScoreFetcher(ctrl), fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), &fakeFinalStore{failures: 1}, nil)
+
+	_, err := ProcessFeed(facade, "http://localhost/mlb", "http://localhost/mlb/feed.atom")
+	assert.ErrorIs(t, err, errUpstream)
+}
+
+// fakeFinalStore keeps finals by game pk, failing the first failures calls.
+type fakeFinalStore struct {
+	lock     sync.Mutex
+	failures int
+	entries  map[int]feed.Entry
+}
+
+func (f *fakeFinalStore) SaveMLBFinal(gamePk int, entry feed.Entry) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return errUpstream
+	}
+	if f.entries == nil {
+		f.entries = make(map[int]feed.Entry)
+	}
+	f.entries[gamePk] = entry
+	return nil
+}
+
+func (f *fakeFinalStore) GetMLBFinals(limit int) ([]feed.Entry, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		return nil, errUpstream
+	}
+	var entries []feed.Entry
+	for _, entry := range f.entries {
+		entries = append(entries, entry)
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
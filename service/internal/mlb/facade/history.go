@@ -0,0 +1,83 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"sync"
+	"time"
+)
+
+// historyCapacity bounds how many past dates are kept, roughly two seasons of game days.
+const historyCapacity = 400
+
+type (
+	// history holds the final scores of past dates. Past dates do not change once every game is final
+	// so they are served without going back to statsapi.
+	history struct {
+		lock   sync.RWMutex
+		scores map[string][]fetcher.FetchScoreResponse
+		order  []string
+	}
+)
+
+func newHistory() *history {
+	return &history{scores: make(map[string][]fetcher.FetchScoreResponse)}
+}
+
+func (h *history) get(date time.Time) ([]*fetcher.FetchScoreResponse, bool) {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	scores, ok := h.scores[date.Format(gameDateLayout)]
+	if !ok {
+		return nil, false
+	}
+	copied := make([]*fetcher.FetchScoreResponse, len(scores))
+	for i := range scores {
+		score := scores[i]
+		copied[i] = &score
+	}
+	return copied, true
+}
+
+func (h *history) contains(date time.Time) bool {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+	_, ok := h.scores[date.Format(gameDateLayout)]
+	return ok
+}
+
+func (h *history) put(date time.Time, scores []*fetcher.FetchScoreResponse) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	key := date.Format(gameDateLayout)
+	if _, ok := h.scores[key]; !ok {
+		h.order = append(h.order, key)
+	}
+	if len(h.order) > historyCapacity {
+		delete(h.scores, h.order[0])
+		h.order = h.order[1:]
+	}
+
+	values := make([]fetcher.FetchScoreResponse, len(scores))
+	for i, score := range scores {
+		values[i] = *score
+	}
+	h.scores[key] = values
+}
+
+// isPast reports if date is before today in date's location.
+func isPast(date time.Time) bool {
+	return date.Format(gameDateLayout) < time.Now().In(date.Location()).Format(gameDateLayout)
+}
+
+func allFinal(scores []*fetcher.FetchScoreResponse) bool {
+	for _, score := range scores {
+		if !isFinal(score) {
+			return false
+		}
+	}
+	return true
+}
+
+func isFinal(score *fetcher.FetchScoreResponse) bool {
+	return score.GameData.Status.AbstractGameState == "Final" || score.GameData.Status.StatusCode == "F"
+}
@@ -0,0 +1,111 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/events"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"sync"
+	"time"
+)
+
+// DefaultPollInterval is how often games in progress are polled for their plays.
+const DefaultPollInterval = 15 * time.Second
+
+// PollLiveGames polls the games of yesterday and today every interval until loopExiter receives, so the plays of
+// games in progress are fetched and published once for everyone watching them and games are seen becoming final
+// for the feed. Yesterday is polled for games that go on past midnight.
+func (sf *ScoreFacadeImpl) PollLiveGames(loopExiter <-chan bool, interval time.Duration) {
+	logger := sf.logger.With().Str("method", "PollLiveGames").Logger()
+	logger.Info().Msgf("polling live games every %s", interval)
+
+	sf.pollLiveGames(time.Now())
+	for {
+		select {
+		case <-loopExiter:
+			return
+		case <-time.After(interval):
+			sf.pollLiveGames(time.Now())
+		}
+	}
+}
+
+// pollLiveGames fetches the plays of the games in progress yesterday and today at now and keeps their last plays
+// for the boards. The last plays of the previous poll are kept for games whose plays could not be fetched.
+func (sf *ScoreFacadeImpl) pollLiveGames(now time.Time) {
+	logger := sf.logger.With().Str("method", "pollLiveGames").Logger()
+
+	now = now.In(time.Local)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	var scores []*fetcher.FetchScoreResponse
+	for _, date := range []time.Time{today.AddDate(0, 0, -1), today} {
+		dateScores, _, err := sf.scores(date)
+		if err != nil {
+			logger.Error().Err(err).Msgf("while fetching scores for %s", date.Format(gameDateLayout))
+			continue
+		}
+		scores = append(scores, dateScores...)
+	}
+
+	lastPlays := sf.fetchLastPlays(scores)
+
+	sf.playsLock.Lock()
+	defer sf.playsLock.Unlock()
+	for _, score := range scores {
+		if _, ok := lastPlays[score.GamePk]; !ok && inProgress(score) {
+			if lastPlay, ok := sf.plays[score.GamePk]; ok {
+				lastPlays[score.GamePk] = lastPlay
+			}
+		}
+	}
+	sf.plays = lastPlays
+}
+
+// lastPlays returns the last play of each of scores in progress by game pk, as they were last polled.
+func (sf *ScoreFacadeImpl) lastPlays(scores []*fetcher.FetchScoreResponse) map[int]string {
+	sf.playsLock.RLock()
+	defer sf.playsLock.RUnlock()
+
+	lastPlays := make(map[int]string)
+	for _, score := range scores {
+		if lastPlay, ok := sf.plays[score.GamePk]; ok && inProgress(score) {
+			lastPlays[score.GamePk] = lastPlay
+		}
+	}
+	return lastPlays
+}
+
+// fetchLastPlays fetches the plays of games in progress, publishes their events, sends their webhooks and returns
+// the last play of each game by game pk. Games that have just ended are fetched once more so their final plays are
+// published.
+func (sf *ScoreFacadeImpl) fetchLastPlays(scores []*fetcher.FetchScoreResponse) map[int]string {
+	logger := sf.logger.With().Str("method", "fetchLastPlays").Logger()
+
+	lastPlays := make(map[int]string)
+	var wg = sync.WaitGroup{}
+	mutex := sync.Mutex{}
+	for _, score := range scores {
+		final := isFinal(score)
+		if !inProgress(score) && !(final && sf.events.Watching(score.GamePk)) {
+			continue
+		}
+		wg.Add(1)
+		go func(score *fetcher.FetchScoreResponse, final bool) {
+			defer wg.Done()
+			gamePk := score.GamePk
+			plays, err := sf.playFetcher.FetchPlays(fetcher.NewGame(gamePk))
+			if err != nil {
+				logger.Error().Err(err).Msgf("while fetching plays for game %d", gamePk)
+				return
+			}
+			sf.notify(score, sf.events.Publish(gamePk, events.Extract(gamePk, plays)), final)
+			if final {
+				sf.events.Done(gamePk)
+				return
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			lastPlays[gamePk] = plays.LastPlay()
+		}(score, final)
+	}
+	wg.Wait()
+	return lastPlays
+}
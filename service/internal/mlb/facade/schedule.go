@@ -0,0 +1,122 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/mlb/writer"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/format"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxCalendarTeams is the most teams a calendar may follow, each a schedule fetched from statsapi.
+const maxCalendarTeams = 10
+
+var (
+	// ErrNoTeam is returned when there is no active team for an abbreviation.
+	ErrNoTeam = errors.New("no team")
+	// ErrTooManyTeams is returned when a calendar is asked for more than maxCalendarTeams teams.
+	ErrTooManyTeams = fmt.Errorf("a calendar may follow at most %d teams", maxCalendarTeams)
+)
+
+func ProcessTeamSchedule(facade ScoreFacade, ctx context.Context, abbreviation string, season int) (string, error) {
+	return facade.processTeamSchedule(ctx, abbreviation, season)
+}
+
+func (sf *ScoreFacadeImpl) processTeamSchedule(ctx context.Context, abbreviation string, season int) (string, error) {
+	r, ok := format.Renderer(ctx).(renderer.TableRenderer)
+	if !ok {
+		return "", ErrNoTables
+	}
+
+	teams, err := sf.teams([]string{abbreviation})
+	if err != nil {
+		return "", err
+	}
+
+	games, err := sf.scheduleFetcher.FetchTeamSchedule(teams[0].ID, season)
+	if err != nil {
+		sf.logger.Error().Err(err).Msgf("while fetching schedule of %s", abbreviation)
+		return "", err
+	}
+
+	sb := strings.Builder{}
+	if err := r.RenderTables(&sb, writer.NewSchedule(timezone.Location(ctx), teams[0], season, games)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// ProcessCalendar returns the games of the teams with abbreviations in season as an iCalendar feed. The schedules
+// of the teams are fetched at once.
+func ProcessCalendar(facade ScoreFacade, abbreviations []string, season int) (string, error) {
+	return facade.processCalendar(abbreviations, season)
+}
+
+func (sf *ScoreFacadeImpl) processCalendar(abbreviations []string, season int) (string, error) {
+	if len(abbreviations) > maxCalendarTeams {
+		return "", ErrTooManyTeams
+	}
+	teams, err := sf.teams(abbreviations)
+	if err != nil {
+		return "", err
+	}
+
+	schedules := make([][]fetcher.ScheduledGame, len(teams))
+	errs := make([]error, len(teams))
+	var wg = sync.WaitGroup{}
+	for i, team := range teams {
+		wg.Add(1)
+		go func(i int, team fetcher.TeamInfo) {
+			defer wg.Done()
+			schedules[i], errs[i] = sf.scheduleFetcher.FetchTeamSchedule(team.ID, season)
+			if errs[i] != nil {
+				sf.logger.Error().Err(errs[i]).Msgf("while fetching schedule of %s", team.Abbreviation)
+			}
+		}(i, team)
+	}
+	wg.Wait()
+
+	games := make([]fetcher.ScheduledGame, 0)
+	for i := range teams {
+		if errs[i] != nil {
+			return "", errs[i]
+		}
+		games = append(games, schedules[i]...)
+	}
+
+	sb := strings.Builder{}
+	cal := writer.NewCalendar(fmt.Sprintf("%s MLB Schedule", strings.Join(abbreviations, ", ")), games)
+	if err := cal.Write(&sb, time.Now()); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// teams returns the active teams with abbreviations, in the same order.
+func (sf *ScoreFacadeImpl) teams(abbreviations []string) ([]fetcher.TeamInfo, error) {
+	active, err := sf.teamFetcher.FetchTeams()
+	if err != nil {
+		sf.logger.Error().Err(err).Msg("while fetching teams")
+		return nil, err
+	}
+	byAbbreviation := make(map[string]fetcher.TeamInfo, len(active))
+	for _, team := range active {
+		byAbbreviation[team.Abbreviation] = team
+	}
+
+	teams := make([]fetcher.TeamInfo, 0, len(abbreviations))
+	for _, abbreviation := range abbreviations {
+		team, ok := byAbbreviation[abbreviation]
+		if !ok {
+			return nil, ErrNoTeam
+		}
+		teams = append(teams, team)
+	}
+	return teams, nil
+}
@@ -0,0 +1,173 @@
+package facade
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestScoreFacadeImpl_ProcessTeamSchedule(t *testing.T) {
+	teams := []fetcher.TeamInfo{
+		{ID: 147, Name: "New York Yankees", Abbreviation: "NYY"},
+		{ID: 111, Name: "Boston Red Sox", Abbreviation: "BOS"},
+	}
+	games := []fetcher.ScheduledGame{
+		{
+			GameType: "R",
+			GameDate: time.Date(2023, 3, 30, 17, 5, 0, 0, time.UTC),
+			Status:   fetcher.GameStatus{AbstractGameState: "Final", CodedGameState: "F", StatusCode: "F"},
+			Teams: fetcher.ScheduledTeams{
+				Away: fetcher.ScheduledTeam{Team: fetcher.TeamInfo{ID: 137, Abbreviation: "SF"}, Score: 0},
+				Home: fetcher.ScheduledTeam{Team: teams[0], Score: 5},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		abbreviation     string
+		mockFetchers     func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher)
+		expectedContains []string
+		expectedErr      error
+	}{
+		"should print the schedule of the team": {
+			abbreviation: "NYY",
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				tf := fetcher.NewMockTeamFetcher(ctrl)
+				tf.EXPECT().FetchTeams().Return(teams, nil)
+				sf := fetcher.NewMockScheduleFetcher(ctrl)
+				sf.EXPECT().FetchTeamSchedule(147, 2023).Return(games, nil)
+				return tf, sf
+			},
+			expectedContains: []string{"2023 New York Yankees Schedule", "Regular Season", "vs SF", "W 5-0", "1-0"},
+		},
+		"should return ErrNoTeam for unknown teams": {
+			abbreviation: "XYZ",
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				tf := fetcher.NewMockTeamFetcher(ctrl)
+				tf.EXPECT().FetchTeams().Return(teams, nil)
+				return tf, fetcher.NewMockScheduleFetcher(ctrl)
+			},
+			expectedErr: ErrNoTeam,
+		},
+		"should return error when the schedule fails": {
+			abbreviation: "NYY",
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				tf := fetcher.NewMockTeamFetcher(ctrl)
+				tf.EXPECT().FetchTeams().Return(teams, nil)
+				sf := fetcher.NewMockScheduleFetcher(ctrl)
+				sf.EXPECT().FetchTeamSchedule(147, 2023).Return(nil, errUpstream)
+				return tf, sf
+			},
+			expectedErr: errUpstream,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tf, sf := tc.mockFetchers(ctrl)
+			facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), fetcher.NewMockScoreFetcher(ctrl),
+				fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), tf, sf, nil, nil)
+
+			page, err := ProcessTeamSchedule(facade, context.Background(), tc.abbreviation, 2023)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, page, s)
+			}
+		})
+	}
+}
+
+func TestScoreFacadeImpl_ProcessCalendar(t *testing.T) {
+	teams := []fetcher.TeamInfo{
+		{ID: 147, Name: "New York Yankees", Abbreviation: "NYY"},
+		{ID: 111, Name: "Boston Red Sox", Abbreviation: "BOS"},
+	}
+	game := fetcher.ScheduledGame{
+		GamePk:   718781,
+		GameType: "R",
+		GameDate: time.Date(2023, 6, 16, 23, 5, 0, 0, time.UTC),
+		Status:   fetcher.GameStatus{AbstractGameState: "Preview", StatusCode: "S"},
+		Teams: fetcher.ScheduledTeams{
+			Away: fetcher.ScheduledTeam{Team: teams[1]},
+			Home: fetcher.ScheduledTeam{Team: teams[0]},
+		},
+	}
+
+	testCases := map[string]struct {
+		abbreviations    []string
+		mockFetchers     func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher)
+		expectedContains []string
+		expectedEvents   int
+		expectedErr      error
+	}{
+		"should list games of both teams once": {
+			abbreviations: []string{"NYY", "BOS"},
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				tf := fetcher.NewMockTeamFetcher(ctrl)
+				tf.EXPECT().FetchTeams().Return(teams, nil)
+				sf := fetcher.NewMockScheduleFetcher(ctrl)
+				sf.EXPECT().FetchTeamSchedule(147, 2023).Return([]fetcher.ScheduledGame{game}, nil)
+				sf.EXPECT().FetchTeamSchedule(111, 2023).Return([]fetcher.ScheduledGame{game}, nil)
+				return tf, sf
+			},
+			expectedContains: []string{"X-WR-CALNAME:NYY\\, BOS MLB Schedule", "UID:mlb-718781@mini-score", "SUMMARY:BOS @ NYY"},
+			expectedEvents:   1,
+		},
+		"should return error when a schedule fails": {
+			abbreviations: []string{"NYY", "BOS"},
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				tf := fetcher.NewMockTeamFetcher(ctrl)
+				tf.EXPECT().FetchTeams().Return(teams, nil)
+				sf := fetcher.NewMockScheduleFetcher(ctrl)
+				sf.EXPECT().FetchTeamSchedule(147, 2023).Return([]fetcher.ScheduledGame{game}, nil)
+				sf.EXPECT().FetchTeamSchedule(111, 2023).Return(nil, errUpstream)
+				return tf, sf
+			},
+			expectedErr: errUpstream,
+		},
+		"should return ErrTooManyTeams past the most teams": {
+			abbreviations: strings.Split(strings.Repeat("NYY,", maxCalendarTeams)+"BOS", ","),
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				return fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl)
+			},
+			expectedErr: ErrTooManyTeams,
+		},
+		"should return ErrNoTeam when any team is unknown": {
+			abbreviations: []string{"NYY", "XYZ"},
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockTeamFetcher, *fetcher.MockScheduleFetcher) {
+				tf := fetcher.NewMockTeamFetcher(ctrl)
+				tf.EXPECT().FetchTeams().Return(teams, nil)
+				return tf, fetcher.NewMockScheduleFetcher(ctrl)
+			},
+			expectedErr: ErrNoTeam,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			tf, sf := tc.mockFetchers(ctrl)
+			facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), fetcher.NewMockScoreFetcher(ctrl),
+				fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), tf, sf, nil, nil)
+
+			ics, err := ProcessCalendar(facade, tc.abbreviations, 2023)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, ics, s)
+			}
+			assert.Equal(t, tc.expectedEvents, strings.Count(ics, "BEGIN:VEVENT"))
+		})
+	}
+}
@@ -2,11 +2,20 @@ package facade
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/digest"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
+	"github.com/rmarken5/mini-score/service/internal/mlb/events"
 	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
 	"github.com/rmarken5/mini-score/service/internal/mlb/writer"
-	"github.com/rmarken5/mini-score/service/internal/rest/user-agent"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rest/format"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"github.com/rs/zerolog"
 	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 )
@@ -14,18 +23,83 @@ import (
 type (
 	ScoreFacade interface {
 		processScores(ctx context.Context, date time.Time) (string, error)
+		processBoard(ctx context.Context, date time.Time) (renderer.Board, error)
+		processGame(ctx context.Context, gamePk int) (string, error)
+		processGamePage(ctx context.Context, gamePk int) (renderer.Board, error)
+		processStandings(ctx context.Context, season int) (string, error)
+		processTeamSchedule(ctx context.Context, abbreviation string, season int) (string, error)
+		processCalendar(abbreviations []string, season int) (string, error)
+		processFeed(link, self string) (string, error)
+		processFinals(date time.Time) ([]digest.Final, error)
+		// IsCached reports if the scores for date can be served without requests to statsapi.
+		IsCached(date time.Time) bool
+		// IsGameCached reports if the last score of a game is kept, because it was on a board or its page was seen.
+		IsGameCached(gamePk int) bool
 	}
 
 	ScoreFacadeImpl struct {
-		gameFetcher  fetcher.GameFetcher
-		scoreFetcher fetcher.ScoreFetcher
+		logger           zerolog.Logger
+		gameFetcher      fetcher.GameFetcher
+		scoreFetcher     fetcher.ScoreFetcher
+		playFetcher      fetcher.PlayFetcher
+		standingsFetcher fetcher.StandingsFetcher
+		teamFetcher      fetcher.TeamFetcher
+		scheduleFetcher  fetcher.ScheduleFetcher
+		lastGames        *lastgood.Store[[]fetcher.Game]
+		lastScores       *lastgood.Store[fetcher.FetchScoreResponse]
+		lastStandings    *lastgood.Store[fetcher.FetchStandingsResponse]
+		history          *history
+		events           *events.Stream
+		finals           *finals
+		dispatcher       Dispatcher
+		webhookQueues    *gameQueues
+		// lastGamePages are the last scores of game pages, apart from lastScores so pages of any game do not push
+		// out the games of the boards.
+		lastGamePages *lastgood.Store[fetcher.FetchScoreResponse]
+		// plays are the last plays of games in progress by game pk, as PollLiveGames last saw them.
+		plays     map[int]string
+		playsLock sync.RWMutex
+		now       func() time.Time
 	}
 )
 
-func NewScoreFacadeImpl(gameFetcher fetcher.GameFetcher, scoreFetcher fetcher.ScoreFetcher) *ScoreFacadeImpl {
+const (
+	gameDateLayout = "2006-01-02"
+	// lastGamesCapacity bounds the dates whose last schedules are kept.
+	lastGamesCapacity = 64
+	// lastScoresCapacity bounds the games whose last scores are kept, several days of games.
+	lastScoresCapacity = 512
+	// lastGamePagesCapacity bounds the games whose last pages are kept.
+	lastGamePagesCapacity = 64
+	// lastStandingsCapacity bounds the seasons whose last standings are kept.
+	lastStandingsCapacity = 16
+)
+
+// ErrNoGame is returned when there is no score for a game.
+var ErrNoGame = errors.New("no score for game")
+
+// NewScoreFacadeImpl returns a facade over statsapi. finalStore keeps the finals of the feed, they are kept in memory
+// when it is nil. dispatcher sends webhooks for the games PollLiveGames follows, none are sent when it is nil.
+func NewScoreFacadeImpl(logger zerolog.Logger, gameFetcher fetcher.GameFetcher, scoreFetcher fetcher.ScoreFetcher, playFetcher fetcher.PlayFetcher, standingsFetcher fetcher.StandingsFetcher, teamFetcher fetcher.TeamFetcher, scheduleFetcher fetcher.ScheduleFetcher, finalStore FinalStore, dispatcher Dispatcher) *ScoreFacadeImpl {
 	return &ScoreFacadeImpl{
-		gameFetcher:  gameFetcher,
-		scoreFetcher: scoreFetcher,
+		logger:           logger.With().Str("service", "ScoreFacade").Logger(),
+		gameFetcher:      gameFetcher,
+		scoreFetcher:     scoreFetcher,
+		playFetcher:      playFetcher,
+		standingsFetcher: standingsFetcher,
+		teamFetcher:      teamFetcher,
+		scheduleFetcher:  scheduleFetcher,
+		lastGames:        lastgood.New[[]fetcher.Game](lastGamesCapacity),
+		lastScores:       lastgood.New[fetcher.FetchScoreResponse](lastScoresCapacity),
+		lastGamePages:    lastgood.New[fetcher.FetchScoreResponse](lastGamePagesCapacity),
+		lastStandings:    lastgood.New[fetcher.FetchStandingsResponse](lastStandingsCapacity),
+		history:          newHistory(),
+		events:           events.NewStream(),
+		finals:           newFinals(finalStore),
+		dispatcher:       dispatcher,
+		webhookQueues:    newGameQueues(),
+		plays:            make(map[int]string),
+		now:              time.Now,
 	}
 }
 
@@ -34,39 +108,205 @@ func ProcessScores(facade ScoreFacade, ctx context.Context, date time.Time) (str
 }
 
 func (sf *ScoreFacadeImpl) processScores(ctx context.Context, date time.Time) (string, error) {
-	gamesPerLine := 1
-	if !user_agent.IsMobile(ctx) {
-		gamesPerLine = 3
+	board, err := sf.processBoard(ctx, date)
+	if err != nil {
+		return "", err
 	}
 
-	games, err := sf.gameFetcher.FetchGames(date)
+	sb := strings.Builder{}
+	if err := format.Renderer(ctx).Render(&sb, board); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+func ProcessBoard(facade ScoreFacade, ctx context.Context, date time.Time) (renderer.Board, error) {
+	return facade.processBoard(ctx, date)
+}
+
+// processBoard returns the board of date with the teams selected on ctx first, for clients that draw it
+// themselves.
+func (sf *ScoreFacadeImpl) processBoard(ctx context.Context, date time.Time) (renderer.Board, error) {
+	scores, asOf, err := sf.scores(date)
 	if err != nil {
+		return renderer.Board{}, err
+	}
+
+	lastPlays := sf.lastPlays(scores)
+	return writer.NewBoard(date, asOf, orderScores(scores, favorites.SelectionFor(ctx, favorites.MLB)), lastPlays), nil
+}
+
+func ProcessGame(facade ScoreFacade, ctx context.Context, gamePk int) (string, error) {
+	return facade.processGame(ctx, gamePk)
+}
+
+func (sf *ScoreFacadeImpl) processGame(ctx context.Context, gamePk int) (string, error) {
+	page, err := sf.processGamePage(ctx, gamePk)
+	if err != nil {
+		return "", err
+	}
+
+	sb := strings.Builder{}
+	if err := format.Renderer(ctx).Render(&sb, page); err != nil {
 		return "", err
 	}
 
-	var scores []*fetcher.FetchScoreResponse
+	return sb.String(), nil
+}
+
+func ProcessGamePage(facade ScoreFacade, ctx context.Context, gamePk int) (renderer.Board, error) {
+	return facade.processGamePage(ctx, gamePk)
+}
+
+// processGamePage returns the page of a game with its details, for clients that draw it themselves.
+func (sf *ScoreFacadeImpl) processGamePage(ctx context.Context, gamePk int) (renderer.Board, error) {
+	score, asOf, ok := sf.fetchGamePage(gamePk)
+	if !ok {
+		return renderer.Board{}, ErrNoGame
+	}
+
+	lastPlays := sf.lastPlays([]*fetcher.FetchScoreResponse{&score})
+	return writer.NewGamePage(timezone.Location(ctx), asOf, &score, lastPlays[score.GamePk]), nil
+}
+
+func (sf *ScoreFacadeImpl) IsCached(date time.Time) bool {
+	return sf.history.contains(date)
+}
+
+func (sf *ScoreFacadeImpl) IsGameCached(gamePk int) bool {
+	key := strconv.Itoa(gamePk)
+	_, _, onBoard := sf.lastScores.Get(key)
+	_, _, seen := sf.lastGamePages.Get(key)
+	return onBoard || seen
+}
+
+// Events returns the scoring plays, home runs and pitching changes of games. Plays are fetched while
+// PollLiveGames runs.
+func (sf *ScoreFacadeImpl) Events() *events.Stream {
+	return sf.events
+}
+
+// scores returns the scores for date sorted by game time. asOf is set when any of the scores are stale.
+func (sf *ScoreFacadeImpl) scores(date time.Time) (scores []*fetcher.FetchScoreResponse, asOf time.Time, err error) {
+	if scores, ok := sf.history.get(date); ok {
+		return scores, time.Time{}, nil
+	}
+
+	games, gamesAsOf, err := sf.fetchGames(date)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var staleTimes []time.Time
 	var wg = sync.WaitGroup{}
 	mutex := sync.Mutex{}
 	for _, game := range games {
 		wg.Add(1)
 		go func(game fetcher.Game) {
-			score, err := sf.scoreFetcher.FetchScore(game)
-			if err != nil {
-				fmt.Println(err)
+			defer wg.Done()
+			score, asOf, ok := sf.fetchScore(game)
+			if !ok {
+				return
 			}
 			mutex.Lock()
-			wg.Done()
 			defer mutex.Unlock()
 			scores = append(scores, &score)
+			staleTimes = append(staleTimes, asOf)
 		}(game)
 	}
 	wg.Wait()
 	sort.Sort(fetcher.ByGameTime(scores))
-	w := writer.NewPainter(gamesPerLine, date)
-	s, err := w.Write(scores)
-	if err != nil {
-		return "", err
+
+	asOf = lastgood.Oldest(append(staleTimes, gamesAsOf)...)
+	if asOf.IsZero() && len(scores) == len(games) && isPast(date) && allFinal(scores) {
+		sf.history.put(date, scores)
 	}
+	return scores, asOf, nil
+}
+
+// fetchGames returns the games for date. When statsapi fails the last known schedule for date is returned
+// along with the time it was fetched.
+func (sf *ScoreFacadeImpl) fetchGames(date time.Time) ([]fetcher.Game, time.Time, error) {
+	logger := sf.logger.With().Str("method", "fetchGames").Logger()
+	key := date.Format(gameDateLayout)
+
+	games, err := sf.gameFetcher.FetchGames(date)
+	if err == nil {
+		sf.lastGames.Put(key, games)
+		return games, time.Time{}, nil
+	}
+
+	logger.Error().Err(err).Msgf("while fetching games for %s", key)
+	lastGames, asOf, ok := sf.lastGames.Get(key)
+	if !ok {
+		return nil, time.Time{}, err
+	}
+	return lastGames, asOf, nil
+}
+
+// fetchScore returns the score for game, adding it to the feed when it has become final. When statsapi fails or
+// returns an unusable score the last known good score is returned along with the time it was fetched. ok is false
+// when there is nothing to show for the game.
+func (sf *ScoreFacadeImpl) fetchScore(game fetcher.Game) (score fetcher.FetchScoreResponse, asOf time.Time, ok bool) {
+	logger := sf.logger.With().Str("method", "fetchScore").Logger()
+	key := strconv.Itoa(game.GamePk)
+
+	score, err := sf.scoreFetcher.FetchScore(game)
+	if err == nil && isValidScore(score) {
+		sf.lastScores.Put(key, score)
+		if err := sf.finals.observe(&score, time.Now()); err != nil {
+			logger.Error().Err(err).Msgf("while recording final of game %s", key)
+		}
+		return score, time.Time{}, true
+	}
+
+	logger.Error().Err(err).Msgf("unable to get a valid score for game %s", key)
+	return sf.lastScores.Get(key)
+}
+
+// fetchGamePage returns the score for the page of the game with gamePk, keeping it with the game pages. When
+// statsapi fails or returns an unusable score the last known good score of the game, from its board or its page,
+// is returned along with the time it was fetched. ok is false when there is nothing to show for the game.
+func (sf *ScoreFacadeImpl) fetchGamePage(gamePk int) (score fetcher.FetchScoreResponse, asOf time.Time, ok bool) {
+	logger := sf.logger.With().Str("method", "fetchGamePage").Logger()
+	key := strconv.Itoa(gamePk)
+
+	score, err := sf.scoreFetcher.FetchScore(fetcher.NewGame(gamePk))
+	if err == nil && isValidScore(score) {
+		sf.lastGamePages.Put(key, score)
+		return score, time.Time{}, true
+	}
+
+	logger.Error().Err(err).Msgf("unable to get a valid score for game %s", key)
+	score, asOf, ok = sf.lastScores.Get(key)
+	if page, pageAsOf, seen := sf.lastGamePages.Get(key); seen && (!ok || pageAsOf.After(asOf)) {
+		return page, pageAsOf, true
+	}
+	return score, asOf, ok
+}
+
+// orderScores puts the games of selected teams first and drops other games when the selection hides them.
+func orderScores(scores []*fetcher.FetchScoreResponse, sel favorites.Selection) []*fetcher.FetchScoreResponse {
+	order := sel.Order(len(scores), func(i int) []string {
+		return []string{scores[i].GameData.Teams.Away.Abbreviation, scores[i].GameData.Teams.Home.Abbreviation}
+	})
+	ordered := make([]*fetcher.FetchScoreResponse, 0, len(order))
+	for _, i := range order {
+		ordered = append(ordered, scores[i])
+	}
+	return ordered
+}
+
+// inProgress reports if a game has started and is not final.
+func inProgress(score *fetcher.FetchScoreResponse) bool {
+	switch score.GameData.Status.StatusCode {
+	case "P", "S":
+		return false
+	}
+	return !isFinal(score)
+}
 
-	return s, nil
+func isValidScore(score fetcher.FetchScoreResponse) bool {
+	return score.GameData.Teams.Away.Abbreviation != "" && score.GameData.Teams.Home.Abbreviation != ""
 }
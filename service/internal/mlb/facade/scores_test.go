@@ -0,0 +1,344 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/mlb/events"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"strings"
+	"testing"
+	"time"
+)
+
+var errUpstream = errors.New("statsapi unavailable")
+
+func testScore(gamePk int, away, home string) fetcher.FetchScoreResponse {
+	return fetcher.FetchScoreResponse{
+		GameData: fetcher.GameData{
+			Status: fetcher.GameStatus{StatusCode: "F", DetailedState: "Final"},
+			Teams: fetcher.Teams{
+				Away: fetcher.TeamData{Abbreviation: away},
+				Home: fetcher.TeamData{Abbreviation: home},
+			},
+			DateTime: fetcher.DateTime{DateTime: time.Date(2023, 6, 22, 17, gamePk, 0, 0, time.UTC)},
+		},
+	}
+}
+
+func TestScoreFacadeImpl_ProcessScores(t *testing.T) {
+	date := time.Date(2023, 6, 22, 0, 0, 0, 0, time.UTC)
+	games := []fetcher.Game{{GamePk: 1, Link: "/1"}, {GamePk: 2, Link: "/2"}}
+
+	testCases := map[string]struct {
+		prime          func(sf *ScoreFacadeImpl)
+		mockFetchers   func(ctrl *gomock.Controller) (*fetcher.MockGameFetcher, *fetcher.MockScoreFetcher)
+		expectedTeams  []string
+		missingTeams   []string
+		expectedBanner bool
+		expectedErr    error
+	}{
+		"should print current scores": {
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockGameFetcher, *fetcher.MockScoreFetcher) {
+				gf := fetcher.NewMockGameFetcher(ctrl)
+				gf.EXPECT().FetchGames(date).Return(games, nil)
+				sf := fetcher.NewMockScoreFetcher(ctrl)
+				sf.EXPECT().FetchScore(games[0]).Return(testScore(1, "AZ", "WSH"), nil)
+				sf.EXPECT().FetchScore(games[1]).Return(testScore(2, "NYY", "BOS"), nil)
+				return gf, sf
+			},
+			expectedTeams: []string{"AZ", "WSH", "NYY", "BOS"},
+		},
+		"should print last good score with banner when a score fails": {
+			prime: func(sf *ScoreFacadeImpl) {
+				sf.lastScores.Put("2", testScore(2, "NYY", "BOS"))
+			},
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockGameFetcher, *fetcher.MockScoreFetcher) {
+				gf := fetcher.NewMockGameFetcher(ctrl)
+				gf.EXPECT().FetchGames(date).Return(games, nil)
+				sf := fetcher.NewMockScoreFetcher(ctrl)
+				sf.EXPECT().FetchScore(games[0]).Return(testScore(1, "AZ", "WSH"), nil)
+				sf.EXPECT().FetchScore(games[1]).Return(fetcher.FetchScoreResponse{}, errUpstream)
+				return gf, sf
+			},
+			expectedTeams:  []string{"AZ", "WSH", "NYY", "BOS"},
+			expectedBanner: true,
+		},
+		"should skip a game that failed and was never fetched": {
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockGameFetcher, *fetcher.MockScoreFetcher) {
+				gf := fetcher.NewMockGameFetcher(ctrl)
+				gf.EXPECT().FetchGames(date).Return(games, nil)
+				sf := fetcher.NewMockScoreFetcher(ctrl)
+				sf.EXPECT().FetchScore(games[0]).Return(testScore(1, "AZ", "WSH"), nil)
+				sf.EXPECT().FetchScore(games[1]).Return(fetcher.FetchScoreResponse{}, errUpstream)
+				return gf, sf
+			},
+			expectedTeams: []string{"AZ", "WSH"},
+			missingTeams:  []string{"NYY", "BOS"},
+		},
+		"should use last good schedule when games fail": {
+			prime: func(sf *ScoreFacadeImpl) {
+				sf.lastGames.Put("2023-06-22", games[:1])
+				sf.lastScores.Put("1", testScore(1, "AZ", "WSH"))
+			},
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockGameFetcher, *fetcher.MockScoreFetcher) {
+				gf := fetcher.NewMockGameFetcher(ctrl)
+				gf.EXPECT().FetchGames(date).Return(nil, errUpstream)
+				sf := fetcher.NewMockScoreFetcher(ctrl)
+				sf.EXPECT().FetchScore(games[0]).Return(fetcher.FetchScoreResponse{}, errUpstream)
+				return gf, sf
+			},
+			expectedTeams:  []string{"AZ", "WSH"},
+			expectedBanner: true,
+		},
+		"should return error when games fail and nothing was fetched": {
+			mockFetchers: func(ctrl *gomock.Controller) (*fetcher.MockGameFetcher, *fetcher.MockScoreFetcher) {
+				gf := fetcher.NewMockGameFetcher(ctrl)
+				gf.EXPECT().FetchGames(date).Return(nil, errUpstream)
+				return gf, fetcher.NewMockScoreFetcher(ctrl)
+			},
+			expectedErr: errUpstream,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			gf, sf := tc.mockFetchers(ctrl)
+			facade := NewScoreFacadeImpl(zerolog.Nop(), gf, sf, fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+			if tc.prime != nil {
+				tc.prime(facade)
+			}
+
+			board, err := ProcessScores(facade, context.Background(), date)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			for _, team := range tc.expectedTeams {
+				assert.Contains(t, board, " "+team+" ")
+			}
+			for _, team := range tc.missingTeams {
+				assert.NotContains(t, board, " "+team+" ")
+			}
+			assert.Equal(t, tc.expectedBanner, strings.Contains(board, "updates delayed"))
+		})
+	}
+}
+
+func TestScoreFacadeImpl_ProcessScores_History(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	date := time.Date(2023, 6, 22, 0, 0, 0, 0, time.UTC)
+	games := []fetcher.Game{{GamePk: 1, Link: "/1"}}
+
+	gf := fetcher.NewMockGameFetcher(ctrl)
+	gf.EXPECT().FetchGames(date).Return(games, nil).Times(1)
+	sf := fetcher.NewMockScoreFetcher(ctrl)
+	sf.EXPECT().FetchScore(games[0]).Return(testScore(1, "AZ", "WSH"), nil).Times(1)
+
+	facade := NewScoreFacadeImpl(zerolog.Nop(), gf, sf, fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+	assert.False(t, facade.IsCached(date))
+
+	first, err := ProcessScores(facade, context.Background(), date)
+	require.NoError(t, err)
+	assert.True(t, facade.IsCached(date), "final scores of a past date should be cached")
+
+	second, err := ProcessScores(facade, context.Background(), date)
+	require.NoError(t, err)
+	assert.Equal(t, first, second)
+}
+
+func TestScoreFacadeImpl_PollLiveGames(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	now := time.Date(2023, 6, 22, 20, 0, 0, 0, time.Local)
+	date := time.Date(2023, 6, 22, 0, 0, 0, 0, time.Local)
+	games := []fetcher.Game{{GamePk: 1, Link: "/1"}, {GamePk: 2, Link: "/2"}}
+
+	live := testScore(1, "AZ", "WSH")
+	live.GamePk = 1
+	live.GameData.Status = fetcher.GameStatus{StatusCode: "I", AbstractGameState: "Live"}
+	final := live
+	final.GameData.Status = fetcher.GameStatus{StatusCode: "F", AbstractGameState: "Final"}
+	plays := fetcher.FetchPlaysResponse{AllPlays: []fetcher.Play{{
+		Result: fetcher.PlayResult{EventType: "home_run", Description: "Carroll homers.", AwayScore: 1},
+		About:  fetcher.PlayAbout{AtBatIndex: 0, HalfInning: "top", Inning: 1, IsComplete: true, IsScoringPlay: true},
+	}}}
+
+	gf := fetcher.NewMockGameFetcher(ctrl)
+	gf.EXPECT().FetchGames(date.AddDate(0, 0, -1)).Return(nil, nil)
+	// the date is kept in the history once every game is final
+	gf.EXPECT().FetchGames(date).Return(games, nil).Times(5)
+	sf := fetcher.NewMockScoreFetcher(ctrl)
+	gomock.InOrder(
+		sf.EXPECT().FetchScore(games[0]).Return(live, nil).Times(4),
+		sf.EXPECT().FetchScore(games[0]).Return(final, nil),
+	)
+	sf.EXPECT().FetchScore(games[1]).Return(testScore(2, "NYY", "BOS"), nil).Times(5)
+	pf := fetcher.NewMockPlayFetcher(ctrl)
+	gomock.InOrder(
+		pf.EXPECT().FetchPlays(fetcher.NewGame(1)).Return(plays, nil),
+		pf.EXPECT().FetchPlays(fetcher.NewGame(1)).Return(fetcher.FetchPlaysResponse{}, errUpstream),
+		pf.EXPECT().FetchPlays(fetcher.NewGame(1)).Return(plays, nil),
+	)
+
+	facade := NewScoreFacadeImpl(zerolog.Nop(), gf, sf, pf, fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+	subscription, unsubscribe := facade.Events().Subscribe()
+	defer unsubscribe()
+
+	facade.pollLiveGames(now)
+	board, err := ProcessScores(facade, context.Background(), date)
+	require.NoError(t, err)
+	assert.Contains(t, board, "Carroll homers.", "boards should show the polled plays without fetching them")
+	event := <-subscription
+	assert.Equal(t, events.HomeRun, event.Kind)
+	assert.Equal(t, "Carroll homers.", event.Description)
+
+	facade.pollLiveGames(now)
+	board, err = ProcessScores(facade, context.Background(), date)
+	require.NoError(t, err)
+	assert.Contains(t, board, "Carroll homers.", "the last play should be kept when the plays fail")
+
+	// the game is fetched once more after it ends and then no longer watched
+	facade.pollLiveGames(now)
+	board, err = ProcessScores(facade, context.Background(), date)
+	require.NoError(t, err)
+	assert.NotContains(t, board, "Carroll homers.")
+	assert.False(t, facade.Events().Watching(1))
+	assert.Empty(t, subscription, "events should be published once")
+}
+
+func TestScoreFacadeImpl_ProcessGame(t *testing.T) {
+	game := fetcher.NewGame(1)
+
+	testCases := map[string]struct {
+		prime          func(sf *ScoreFacadeImpl)
+		scoreErr       error
+		expectedBanner bool
+		expectedErr    error
+	}{
+		"should print the game": {},
+		"should print last good score with banner when the score fails": {
+			prime: func(sf *ScoreFacadeImpl) {
+				sf.lastScores.Put("1", testScore(1, "AZ", "WSH"))
+			},
+			scoreErr:       errUpstream,
+			expectedBanner: true,
+		},
+		"should print last page with banner when the score fails": {
+			prime: func(sf *ScoreFacadeImpl) {
+				sf.lastGamePages.Put("1", testScore(1, "AZ", "WSH"))
+			},
+			scoreErr:       errUpstream,
+			expectedBanner: true,
+		},
+		"should return ErrNoGame when there is no score for the game": {
+			scoreErr:    errUpstream,
+			expectedErr: ErrNoGame,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			sf := fetcher.NewMockScoreFetcher(ctrl)
+			score := testScore(1, "AZ", "WSH")
+			if tc.scoreErr != nil {
+				score = fetcher.FetchScoreResponse{}
+			}
+			sf.EXPECT().FetchScore(game).Return(score, tc.scoreErr)
+
+			facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), sf, fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+			if tc.prime != nil {
+				tc.prime(facade)
+			}
+
+			page, err := ProcessGame(facade, context.Background(), 1)
+			if tc.expectedErr != nil {
+				assert.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Contains(t, page, " AZ ")
+			assert.Contains(t, page, " WSH ")
+			assert.Equal(t, tc.expectedBanner, strings.Contains(page, "updates delayed"))
+			assert.True(t, facade.IsGameCached(1))
+		})
+	}
+}
+
+func TestScoreFacadeImpl_ProcessGame_KeepsBoards(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	sf := fetcher.NewMockScoreFetcher(ctrl)
+	for gamePk := 1; gamePk <= lastGamePagesCapacity+1; gamePk++ {
+		sf.EXPECT().FetchScore(fetcher.NewGame(gamePk)).Return(testScore(gamePk, "AZ", "WSH"), nil)
+	}
+	facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), sf, fetcher.NewMockPlayFetcher(ctrl), fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+	facade.lastScores.Put("1000", testScore(1000, "NYY", "BOS"))
+
+	for gamePk := 1; gamePk <= lastGamePagesCapacity+1; gamePk++ {
+		_, err := ProcessGame(facade, context.Background(), gamePk)
+		require.NoError(t, err)
+	}
+
+	assert.True(t, facade.IsGameCached(1000), "game pages should not push out the games of the boards")
+	_, _, ok := facade.lastScores.Get("1")
+	assert.False(t, ok, "game pages should be kept apart from the games of the boards")
+	assert.True(t, facade.IsGameCached(lastGamePagesCapacity+1))
+	assert.False(t, facade.IsGameCached(2000))
+}
+
+func TestHistory_Put(t *testing.T) {
+	h := newHistory()
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	score := testScore(1, "AZ", "WSH")
+
+	for i := 0; i <= historyCapacity; i++ {
+		h.put(start.AddDate(0, 0, i), []*fetcher.FetchScoreResponse{&score})
+	}
+
+	assert.False(t, h.contains(start), "oldest date should be evicted at capacity")
+	assert.True(t, h.contains(start.AddDate(0, 0, historyCapacity)))
+	assert.Len(t, h.order, historyCapacity)
+}
+
+func TestOrderScores(t *testing.T) {
+	azWsh := testScore(1, "AZ", "WSH")
+	nyyBos := testScore(2, "NYY", "BOS")
+	scores := []*fetcher.FetchScoreResponse{&azWsh, &nyyBos}
+
+	testCases := map[string]struct {
+		sel      favorites.Selection
+		expected []*fetcher.FetchScoreResponse
+	}{
+		"should keep order without favorites": {
+			expected: []*fetcher.FetchScoreResponse{&azWsh, &nyyBos},
+		},
+		"should put favorites first": {
+			sel:      favorites.Selection{Teams: []string{"BOS"}},
+			expected: []*fetcher.FetchScoreResponse{&nyyBos, &azWsh},
+		},
+		"should only show selected teams": {
+			sel:      favorites.Selection{Teams: []string{"NYY"}, HideOthers: true},
+			expected: []*fetcher.FetchScoreResponse{&nyyBos},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, orderScores(scores, tc.sel))
+		})
+	}
+}
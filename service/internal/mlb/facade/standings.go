@@ -0,0 +1,67 @@
+package facade
+
+import (
+	"context"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/mlb/standings"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/format"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// standingsMaxAge is how long standings are served without going back to statsapi.
+const standingsMaxAge = 5 * time.Minute
+
+// ErrNoTables is returned when standings are asked for in a format that has no tables.
+var ErrNoTables = errors.New("standings are not available in this format")
+
+func ProcessStandings(facade ScoreFacade, ctx context.Context, season int) (string, error) {
+	return facade.processStandings(ctx, season)
+}
+
+func (sf *ScoreFacadeImpl) processStandings(ctx context.Context, season int) (string, error) {
+	r, ok := format.Renderer(ctx).(renderer.TableRenderer)
+	if !ok {
+		return "", ErrNoTables
+	}
+
+	resp, asOf, err := sf.fetchStandings(season)
+	if err != nil {
+		return "", err
+	}
+
+	tables := standings.New(season, resp).Tables()
+	tables.AsOf = asOf
+	tables.Location = timezone.Location(ctx)
+	sb := strings.Builder{}
+	if err := r.RenderTables(&sb, tables); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// fetchStandings returns the standings of season, from the last standings fetched when they are recent. When
+// statsapi fails the last standings of season are returned along with the time they were fetched.
+func (sf *ScoreFacadeImpl) fetchStandings(season int) (fetcher.FetchStandingsResponse, time.Time, error) {
+	key := strconv.Itoa(season)
+	last, storedAt, ok := sf.lastStandings.Get(key)
+	if ok && sf.now().Sub(storedAt) < standingsMaxAge {
+		return last, time.Time{}, nil
+	}
+
+	resp, err := sf.standingsFetcher.FetchStandings(season)
+	if err == nil {
+		sf.lastStandings.Put(key, resp)
+		return resp, time.Time{}, nil
+	}
+
+	sf.logger.Error().Err(err).Msgf("while fetching standings of %d", season)
+	if !ok {
+		return fetcher.FetchStandingsResponse{}, time.Time{}, err
+	}
+	return last, storedAt, nil
+}
@@ -0,0 +1,98 @@
+package facade
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestScoreFacadeImpl_ProcessStandings(t *testing.T) {
+	resp := fetcher.FetchStandingsResponse{Records: []fetcher.StandingsRecord{
+		{
+			League:   fetcher.IDRef{ID: 103},
+			Division: fetcher.IDRef{ID: 201},
+			TeamRecords: []fetcher.TeamRecord{
+				{Team: fetcher.TeamInfo{Abbreviation: "TB"}, Wins: 99, Losses: 63},
+				{Team: fetcher.TeamInfo{Abbreviation: "BAL"}, Wins: 101, Losses: 61},
+			},
+		},
+	}}
+
+	testCases := map[string]struct {
+		mockFetcher      func(ctrl *gomock.Controller) *fetcher.MockStandingsFetcher
+		expectedContains []string
+		expectedErr      error
+	}{
+		"should print division and wildcard tables": {
+			mockFetcher: func(ctrl *gomock.Controller) *fetcher.MockStandingsFetcher {
+				sf := fetcher.NewMockStandingsFetcher(ctrl)
+				sf.EXPECT().FetchStandings(2023).Return(resp, nil)
+				return sf
+			},
+			expectedContains: []string{"2023 MLB Standings", "AL East", "AL Wild Card", "BAL      101  61  .623"},
+		},
+		"should return error when standings fail": {
+			mockFetcher: func(ctrl *gomock.Controller) *fetcher.MockStandingsFetcher {
+				sf := fetcher.NewMockStandingsFetcher(ctrl)
+				sf.EXPECT().FetchStandings(2023).Return(fetcher.FetchStandingsResponse{}, errUpstream)
+				return sf
+			},
+			expectedErr: errUpstream,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), fetcher.NewMockScoreFetcher(ctrl),
+				fetcher.NewMockPlayFetcher(ctrl), tc.mockFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+
+			page, err := ProcessStandings(facade, context.Background(), 2023)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			for _, s := range tc.expectedContains {
+				assert.Contains(t, page, s)
+			}
+		})
+	}
+}
+
+func TestScoreFacadeImpl_ProcessStandingsLastGood(t *testing.T) {
+	resp := fetcher.FetchStandingsResponse{Records: []fetcher.StandingsRecord{
+		{
+			League:      fetcher.IDRef{ID: 103},
+			Division:    fetcher.IDRef{ID: 201},
+			TeamRecords: []fetcher.TeamRecord{{Team: fetcher.TeamInfo{Abbreviation: "BAL"}, Wins: 101, Losses: 61}},
+		},
+	}}
+	ctrl := gomock.NewController(t)
+	sf := fetcher.NewMockStandingsFetcher(ctrl)
+	facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), fetcher.NewMockScoreFetcher(ctrl),
+		fetcher.NewMockPlayFetcher(ctrl), sf, fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, nil)
+	now := time.Now()
+	facade.now = func() time.Time { return now }
+
+	sf.EXPECT().FetchStandings(2023).Return(resp, nil)
+	page, err := ProcessStandings(facade, context.Background(), 2023)
+	require.NoError(t, err)
+	assert.Contains(t, page, "BAL      101  61  .623")
+
+	// recent standings are served without statsapi
+	page, err = ProcessStandings(facade, context.Background(), 2023)
+	require.NoError(t, err)
+	assert.NotContains(t, page, "updates delayed")
+
+	now = now.Add(standingsMaxAge + time.Second)
+	sf.EXPECT().FetchStandings(2023).Return(fetcher.FetchStandingsResponse{}, errUpstream)
+	page, err = ProcessStandings(facade, context.Background(), 2023)
+	require.NoError(t, err)
+	assert.Contains(t, page, "BAL      101  61  .623")
+	assert.Contains(t, page, "updates delayed")
+}
@@ -0,0 +1,109 @@
+package facade
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/mlb/events"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type (
+	// Dispatcher sends webhook events to the webhooks they match.
+	Dispatcher interface {
+		Dispatch(event webhook.Event)
+	}
+
+	// gameQueues run the work of each game one at a time in the order it is queued, on one goroutine per game that
+	// exits when the game's queue is empty. Work of different games runs concurrently.
+	gameQueues struct {
+		lock   sync.Mutex
+		queues map[int][]func()
+	}
+)
+
+// notify sends webhooks for the plays of a game that changed its score and, once the game is final, for the final.
+// Only games seen in progress are sent, as PollLiveGames fetches their plays, so games already final when the server
+// started are not sent again. The events are sent on the game's webhook queue so a slow receiver holds up neither
+// polling nor later events of the game.
+func (sf *ScoreFacadeImpl) notify(score *fetcher.FetchScoreResponse, plays []events.Event, final bool) {
+	if sf.dispatcher == nil {
+		return
+	}
+
+	var webhookEvents []webhook.Event
+	for _, play := range plays {
+		if play.Kind != events.ScoringPlay && play.Kind != events.HomeRun {
+			continue
+		}
+		webhookEvents = append(webhookEvents, gameEvent(score, webhook.ScoreChange,
+			fmt.Sprintf("score-%d-%d", play.AwayScore, play.HomeScore), play.AwayScore, play.HomeScore, play.Inning))
+	}
+	if final {
+		teams := score.LiveData.Linescore.Teams
+		webhookEvents = append(webhookEvents, gameEvent(score, webhook.Final, "final",
+			teams.Away.Runs, teams.Home.Runs, score.LiveData.Linescore.CurrentInning))
+	}
+	if len(webhookEvents) == 0 {
+		return
+	}
+
+	sf.webhookQueues.run(score.GamePk, func() {
+		for _, event := range webhookEvents {
+			sf.dispatcher.Dispatch(event)
+		}
+	})
+}
+
+// gameEvent is the webhook event of a game. The id is made from the game and what changed so the same change always
+// has the same id, runs are never taken back so each score is reached once.
+func gameEvent(score *fetcher.FetchScoreResponse, eventType webhook.EventType, change string, awayScore, homeScore, inning int) webhook.Event {
+	gameID := strconv.Itoa(score.GamePk)
+	return webhook.Event{
+		ID:         gameID + "-" + change,
+		Sport:      webhook.SportMLB,
+		Type:       eventType,
+		GameID:     gameID,
+		AwayTeam:   score.GameData.Teams.Away.Abbreviation,
+		HomeTeam:   score.GameData.Teams.Home.Abbreviation,
+		AwayScore:  awayScore,
+		HomeScore:  homeScore,
+		Period:     inning,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+func newGameQueues() *gameQueues {
+	return &gameQueues{queues: make(map[int][]func())}
+}
+
+// run queues work for gamePk, starting the game's goroutine unless it is running.
+func (q *gameQueues) run(gamePk int, work func()) {
+	q.lock.Lock()
+	queue, running := q.queues[gamePk]
+	q.queues[gamePk] = append(queue, work)
+	q.lock.Unlock()
+
+	if !running {
+		go q.drain(gamePk)
+	}
+}
+
+func (q *gameQueues) drain(gamePk int) {
+	for {
+		q.lock.Lock()
+		queue := q.queues[gamePk]
+		if len(queue) == 0 {
+			delete(q.queues, gamePk)
+			q.lock.Unlock()
+			return
+		}
+		work := queue[0]
+		q.queues[gamePk] = queue[1:]
+		q.lock.Unlock()
+
+		work()
+	}
+}
@@ -0,0 +1,98 @@
+package facade
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingDispatcher struct {
+	lock   sync.Mutex
+	events []webhook.Event
+}
+
+func (r *recordingDispatcher) Dispatch(event webhook.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recordingDispatcher) ids() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	ids := make([]string, 0, len(r.events))
+	for _, event := range r.events {
+		ids = append(ids, event.ID)
+	}
+	return ids
+}
+
+func TestScoreFacadeImpl_Notify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	live := testScore(1, "AZ", "WSH")
+	live.GamePk = 717847
+	live.GameData.Status = fetcher.GameStatus{StatusCode: "I", AbstractGameState: "Live"}
+	final := live
+	final.GameData.Status = fetcher.GameStatus{StatusCode: "F", AbstractGameState: "Final"}
+	final.LiveData.Linescore = fetcher.Linescore{CurrentInning: 9, Teams: fetcher.TeamStats{Away: fetcher.TeamStat{Runs: 2}, Home: fetcher.TeamStat{Runs: 1}}}
+	finalPlays := fetcher.FetchPlaysResponse{AllPlays: []fetcher.Play{
+		{
+			Result: fetcher.PlayResult{EventType: "home_run", Description: "Carroll homers.", AwayScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 0, HalfInning: "top", Inning: 1, IsComplete: true, IsScoringPlay: true},
+		},
+		{
+			Result: fetcher.PlayResult{EventType: "strikeout", Description: "Marte strikes out.", AwayScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 1, HalfInning: "top", Inning: 1, IsComplete: true},
+			PlayEvents: []fetcher.PlayEvent{
+				{Index: 0, Type: "action", Details: fetcher.PlayEventDetails{EventType: "pitching_substitution", Description: "Pitching Change."}},
+			},
+		},
+		{
+			Result: fetcher.PlayResult{EventType: "sac_fly", Description: "Thomas scores.", AwayScore: 1, HomeScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 2, HalfInning: "bottom", Inning: 3, IsComplete: true, IsScoringPlay: true},
+		},
+		{
+			Result: fetcher.PlayResult{EventType: "single", Description: "Perdomo singles.", AwayScore: 2, HomeScore: 1},
+			About:  fetcher.PlayAbout{AtBatIndex: 3, HalfInning: "top", Inning: 9, IsComplete: true, IsScoringPlay: true},
+		},
+	}}
+	livePlays := fetcher.FetchPlaysResponse{AllPlays: finalPlays.AllPlays[:1]}
+
+	pf := fetcher.NewMockPlayFetcher(ctrl)
+	gomock.InOrder(
+		pf.EXPECT().FetchPlays(fetcher.NewGame(717847)).Return(livePlays, nil),
+		pf.EXPECT().FetchPlays(fetcher.NewGame(717847)).Return(finalPlays, nil),
+	)
+	dispatcher := &recordingDispatcher{}
+	facade := NewScoreFacadeImpl(zerolog.Nop(), fetcher.NewMockGameFetcher(ctrl), fetcher.NewMockScoreFetcher(ctrl), pf, fetcher.NewMockStandingsFetcher(ctrl), fetcher.NewMockTeamFetcher(ctrl), fetcher.NewMockScheduleFetcher(ctrl), nil, dispatcher)
+
+	facade.fetchLastPlays([]*fetcher.FetchScoreResponse{&live})
+	facade.fetchLastPlays([]*fetcher.FetchScoreResponse{&final})
+	// the game is no longer watched, so it is not sent again
+	facade.fetchLastPlays([]*fetcher.FetchScoreResponse{&final})
+
+	expectedIDs := []string{"717847-score-1-0", "717847-score-1-1", "717847-score-2-1", "717847-final"}
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual(expectedIDs, dispatcher.ids())
+	}, time.Second, time.Millisecond, "score changes and the final should be sent in order, pitching changes should not")
+
+	dispatcher.lock.Lock()
+	defer dispatcher.lock.Unlock()
+	for _, event := range dispatcher.events {
+		assert.Equal(t, webhook.SportMLB, event.Sport)
+		assert.Equal(t, "717847", event.GameID)
+		assert.Equal(t, "AZ", event.AwayTeam)
+		assert.Equal(t, "WSH", event.HomeTeam)
+	}
+	assert.Equal(t, webhook.Event{
+		ID: "717847-final", Sport: webhook.SportMLB, Type: webhook.Final, GameID: "717847", AwayTeam: "AZ", HomeTeam: "WSH",
+		AwayScore: 2, HomeScore: 1, Period: 9, OccurredAt: dispatcher.events[3].OccurredAt,
+	}, dispatcher.events[3])
+	assert.Equal(t, webhook.ScoreChange, dispatcher.events[2].Type)
+	assert.Equal(t, 9, dispatcher.events[2].Period)
+}
@@ -0,0 +1,244 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/rmarken5/mini-score/service/internal/mlb/fetcher (interfaces: GameFetcher,ScoreFetcher,TeamFetcher,PlayFetcher,StandingsFetcher,ScheduleFetcher)
+//
+// Generated by this command:
+//
+//	mockgen -destination ./fetcher_mock.go -package fetcher . GameFetcher,ScoreFetcher,TeamFetcher,PlayFetcher,StandingsFetcher,ScheduleFetcher
+//
+// Package fetcher is a generated GoMock package.
+package fetcher
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGameFetcher is a mock of GameFetcher interface.
+type MockGameFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockGameFetcherMockRecorder
+}
+
+// MockGameFetcherMockRecorder is the mock recorder for MockGameFetcher.
+type MockGameFetcherMockRecorder struct {
+	mock *MockGameFetcher
+}
+
+// NewMockGameFetcher creates a new mock instance.
+func NewMockGameFetcher(ctrl *gomock.Controller) *MockGameFetcher {
+	mock := &MockGameFetcher{ctrl: ctrl}
+	mock.recorder = &MockGameFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGameFetcher) EXPECT() *MockGameFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchGames mocks base method.
+func (m *MockGameFetcher) FetchGames(arg0 time.Time) ([]Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchGames", arg0)
+	ret0, _ := ret[0].([]Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchGames indicates an expected call of FetchGames.
+func (mr *MockGameFetcherMockRecorder) FetchGames(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchGames", reflect.TypeOf((*MockGameFetcher)(nil).FetchGames), arg0)
+}
+
+// MockScoreFetcher is a mock of ScoreFetcher interface.
+type MockScoreFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockScoreFetcherMockRecorder
+}
+
+// MockScoreFetcherMockRecorder is the mock recorder for MockScoreFetcher.
+type MockScoreFetcherMockRecorder struct {
+	mock *MockScoreFetcher
+}
+
+// NewMockScoreFetcher creates a new mock instance.
+func NewMockScoreFetcher(ctrl *gomock.Controller) *MockScoreFetcher {
+	mock := &MockScoreFetcher{ctrl: ctrl}
+	mock.recorder = &MockScoreFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScoreFetcher) EXPECT() *MockScoreFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchScore mocks base method.
+func (m *MockScoreFetcher) FetchScore(arg0 Game) (FetchScoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchScore", arg0)
+	ret0, _ := ret[0].(FetchScoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchScore indicates an expected call of FetchScore.
+func (mr *MockScoreFetcherMockRecorder) FetchScore(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchScore", reflect.TypeOf((*MockScoreFetcher)(nil).FetchScore), arg0)
+}
+
+// MockTeamFetcher is a mock of TeamFetcher interface.
+type MockTeamFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockTeamFetcherMockRecorder
+}
+
+// MockTeamFetcherMockRecorder is the mock recorder for MockTeamFetcher.
+type MockTeamFetcherMockRecorder struct {
+	mock *MockTeamFetcher
+}
+
+// NewMockTeamFetcher creates a new mock instance.
+func NewMockTeamFetcher(ctrl *gomock.Controller) *MockTeamFetcher {
+	mock := &MockTeamFetcher{ctrl: ctrl}
+	mock.recorder = &MockTeamFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTeamFetcher) EXPECT() *MockTeamFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchTeams mocks base method.
+func (m *MockTeamFetcher) FetchTeams() ([]TeamInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTeams")
+	ret0, _ := ret[0].([]TeamInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTeams indicates an expected call of FetchTeams.
+func (mr *MockTeamFetcherMockRecorder) FetchTeams() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTeams", reflect.TypeOf((*MockTeamFetcher)(nil).FetchTeams))
+}
+
+// MockPlayFetcher is a mock of PlayFetcher interface.
+type MockPlayFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlayFetcherMockRecorder
+}
+
+// MockPlayFetcherMockRecorder is the mock recorder for MockPlayFetcher.
+type MockPlayFetcherMockRecorder struct {
+	mock *MockPlayFetcher
+}
+
+// NewMockPlayFetcher creates a new mock instance.
+func NewMockPlayFetcher(ctrl *gomock.Controller) *MockPlayFetcher {
+	mock := &MockPlayFetcher{ctrl: ctrl}
+	mock.recorder = &MockPlayFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlayFetcher) EXPECT() *MockPlayFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchPlays mocks base method.
+func (m *MockPlayFetcher) FetchPlays(arg0 Game) (FetchPlaysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchPlays", arg0)
+	ret0, _ := ret[0].(FetchPlaysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchPlays indicates an expected call of FetchPlays.
+func (mr *MockPlayFetcherMockRecorder) FetchPlays(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchPlays", reflect.TypeOf((*MockPlayFetcher)(nil).FetchPlays), arg0)
+}
+
+// MockStandingsFetcher is a mock of StandingsFetcher interface.
+type MockStandingsFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockStandingsFetcherMockRecorder
+}
+
+// MockStandingsFetcherMockRecorder is the mock recorder for MockStandingsFetcher.
+type MockStandingsFetcherMockRecorder struct {
+	mock *MockStandingsFetcher
+}
+
+// NewMockStandingsFetcher creates a new mock instance.
+func NewMockStandingsFetcher(ctrl *gomock.Controller) *MockStandingsFetcher {
+	mock := &MockStandingsFetcher{ctrl: ctrl}
+	mock.recorder = &MockStandingsFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStandingsFetcher) EXPECT() *MockStandingsFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchStandings mocks base method.
+func (m *MockStandingsFetcher) FetchStandings(arg0 int) (FetchStandingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchStandings", arg0)
+	ret0, _ := ret[0].(FetchStandingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchStandings indicates an expected call of FetchStandings.
+func (mr *MockStandingsFetcherMockRecorder) FetchStandings(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchStandings", reflect.TypeOf((*MockStandingsFetcher)(nil).FetchStandings), arg0)
+}
+
+// MockScheduleFetcher is a mock of ScheduleFetcher interface.
+type MockScheduleFetcher struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduleFetcherMockRecorder
+}
+
+// MockScheduleFetcherMockRecorder is the mock recorder for MockScheduleFetcher.
+type MockScheduleFetcherMockRecorder struct {
+	mock *MockScheduleFetcher
+}
+
+// NewMockScheduleFetcher creates a new mock instance.
+func NewMockScheduleFetcher(ctrl *gomock.Controller) *MockScheduleFetcher {
+	mock := &MockScheduleFetcher{ctrl: ctrl}
+	mock.recorder = &MockScheduleFetcherMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduleFetcher) EXPECT() *MockScheduleFetcherMockRecorder {
+	return m.recorder
+}
+
+// FetchTeamSchedule mocks base method.
+func (m *MockScheduleFetcher) FetchTeamSchedule(arg0, arg1 int) ([]ScheduledGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchTeamSchedule", arg0, arg1)
+	ret0, _ := ret[0].([]ScheduledGame)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchTeamSchedule indicates an expected call of FetchTeamSchedule.
+func (mr *MockScheduleFetcherMockRecorder) FetchTeamSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchTeamSchedule", reflect.TypeOf((*MockScheduleFetcher)(nil).FetchTeamSchedule), arg0, arg1)
+}
@@ -18,10 +18,28 @@ type Game struct {
 	Link   string `json:"link"`
 }
 
+// NewGame returns the game for gamePk with the link to its live feed.
+func NewGame(gamePk int) Game {
+	return Game{GamePk: gamePk, Link: fmt.Sprintf("/api/v1.1/game/%d/feed/live", gamePk)}
+}
+
 type GameResult struct {
 }
 
+type FetchTeamsResponse struct {
+	Teams []TeamInfo `json:"teams"`
+}
+
+type TeamInfo struct {
+	ID           int    `json:"id"`
+	Name         string `json:"name"`
+	Abbreviation string `json:"abbreviation"`
+	TeamName     string `json:"teamName"`
+	Active       bool   `json:"active"`
+}
+
 type FetchScoreResponse struct {
+	GamePk   int      `json:"gamePk"`
 	LiveData LiveData `json:"liveData"`
 	GameData GameData `json:"gameData"`
 }
@@ -83,6 +101,12 @@ type GameStatus struct {
 	AbstractGameCode  string `json:"abstractGameCode"`
 }
 
+// GameOver reports if the coded state of a game is final or game over, which comes before the abstract state turns
+// final. Ties and games called early are coded final as well, while postponed and cancelled games are not.
+func (s GameStatus) GameOver() bool {
+	return s.CodedGameState == "F" || s.CodedGameState == "O"
+}
+
 type TeamData struct {
 	Name          string `json:"name"`
 	Abbreviation  string `json:"abbreviation"`
@@ -109,6 +133,26 @@ type Linescore struct {
 	Strikes              int       `json:"strikes"`
 	Outs                 int       `json:"outs"`
 	Innings              Innings   `json:"innings"`
+	Offense              Offense   `json:"offense"`
+	Defense              Defense   `json:"defense"`
+}
+
+// Offense is the team at bat. A base is nil when no runner is on it.
+type Offense struct {
+	Batter Person  `json:"batter"`
+	First  *Person `json:"first,omitempty"`
+	Second *Person `json:"second,omitempty"`
+	Third  *Person `json:"third,omitempty"`
+}
+
+// Defense is the team in the field.
+type Defense struct {
+	Pitcher Person `json:"pitcher"`
+}
+
+type Person struct {
+	ID       int    `json:"id"`
+	FullName string `json:"fullName"`
 }
 type Innings []Inning
 type Inning struct {
@@ -165,3 +209,120 @@ type TeamStats struct {
 func (ts *TeamStat) String() string {
 	return fmt.Sprintf("%2d %2d %2d", ts.Runs, ts.Hits, ts.Errors)
 }
+
+// FetchPlaysResponse is the play-by-play of a game.
+type FetchPlaysResponse struct {
+	AllPlays []Play `json:"allPlays"`
+}
+
+// Play is a plate appearance. PlayEvents are the pitches and actions during it, like a pitching change.
+type Play struct {
+	Result     PlayResult  `json:"result"`
+	About      PlayAbout   `json:"about"`
+	PlayEvents []PlayEvent `json:"playEvents"`
+}
+
+type PlayResult struct {
+	Type        string `json:"type"`
+	Event       string `json:"event"`
+	EventType   string `json:"eventType"`
+	Description string `json:"description"`
+	RBI         int    `json:"rbi"`
+	AwayScore   int    `json:"awayScore"`
+	HomeScore   int    `json:"homeScore"`
+}
+
+type PlayAbout struct {
+	AtBatIndex    int    `json:"atBatIndex"`
+	HalfInning    string `json:"halfInning"`
+	Inning        int    `json:"inning"`
+	IsComplete    bool   `json:"isComplete"`
+	IsScoringPlay bool   `json:"isScoringPlay"`
+}
+
+type PlayEvent struct {
+	Index   int              `json:"index"`
+	Type    string           `json:"type"`
+	Details PlayEventDetails `json:"details"`
+}
+
+type PlayEventDetails struct {
+	EventType   string `json:"eventType"`
+	Description string `json:"description"`
+}
+
+// LastPlay returns the description of the last completed play, empty before the first play is complete.
+func (fpr FetchPlaysResponse) LastPlay() string {
+	for i := len(fpr.AllPlays) - 1; i >= 0; i-- {
+		if fpr.AllPlays[i].About.IsComplete && fpr.AllPlays[i].Result.Description != "" {
+			return fpr.AllPlays[i].Result.Description
+		}
+	}
+	return ""
+}
+
+// FetchStandingsResponse is the standings of a season with a record for each division.
+type FetchStandingsResponse struct {
+	Records []StandingsRecord `json:"records"`
+}
+
+type StandingsRecord struct {
+	League      IDRef        `json:"league"`
+	Division    IDRef        `json:"division"`
+	TeamRecords []TeamRecord `json:"teamRecords"`
+}
+
+// IDRef refers to a league or division by id.
+type IDRef struct {
+	ID int `json:"id"`
+}
+
+type TeamRecord struct {
+	Team            TeamInfo `json:"team"`
+	Wins            int      `json:"wins"`
+	Losses          int      `json:"losses"`
+	GamesPlayed     int      `json:"gamesPlayed"`
+	RunsScored      int      `json:"runsScored"`
+	RunsAllowed     int      `json:"runsAllowed"`
+	RunDifferential int      `json:"runDifferential"`
+	Streak          Streak   `json:"streak"`
+}
+
+type Streak struct {
+	StreakCode string `json:"streakCode"`
+}
+
+// FetchScheduleResponse is the schedule of a team with a date for each day it plays.
+type FetchScheduleResponse struct {
+	Dates []ScheduleDate `json:"dates"`
+}
+
+type ScheduleDate struct {
+	Games []ScheduledGame `json:"games"`
+}
+
+// ScheduledGame is a game of a schedule. GameType is R for the regular season and F, D, L or W for the rounds of
+// the postseason.
+type ScheduledGame struct {
+	GamePk    int            `json:"gamePk"`
+	GameType  string         `json:"gameType"`
+	GameDate  time.Time      `json:"gameDate"`
+	Status    GameStatus     `json:"status"`
+	Teams     ScheduledTeams `json:"teams"`
+	Linescore Linescore      `json:"linescore"`
+	Venue     Venue          `json:"venue"`
+}
+
+type Venue struct {
+	Name string `json:"name"`
+}
+
+type ScheduledTeams struct {
+	Away ScheduledTeam `json:"away"`
+	Home ScheduledTeam `json:"home"`
+}
+
+type ScheduledTeam struct {
+	Team  TeamInfo `json:"team"`
+	Score int      `json:"score"`
+}
@@ -3,11 +3,13 @@ package fetcher
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
 	"io"
 	"net/http"
 	"time"
 )
 
+//go:generate mockgen -destination ./fetcher_mock.go -package fetcher . GameFetcher,ScoreFetcher,TeamFetcher,PlayFetcher,StandingsFetcher,ScheduleFetcher
 type (
 	GameFetcher interface {
 		FetchGames(time time.Time) ([]Game, error)
@@ -15,6 +17,21 @@ type (
 	ScoreFetcher interface {
 		FetchScore(game Game) (FetchScoreResponse, error)
 	}
+	TeamFetcher interface {
+		FetchTeams() ([]TeamInfo, error)
+	}
+	// PlayFetcher fetches the play-by-play of a game.
+	PlayFetcher interface {
+		FetchPlays(game Game) (FetchPlaysResponse, error)
+	}
+	// StandingsFetcher fetches the regular season standings of both leagues.
+	StandingsFetcher interface {
+		FetchStandings(season int) (FetchStandingsResponse, error)
+	}
+	// ScheduleFetcher fetches the regular season and postseason games of a team.
+	ScheduleFetcher interface {
+		FetchTeamSchedule(teamID int, season int) ([]ScheduledGame, error)
+	}
 
 	Fetcher struct {
 		apiURL     string
@@ -25,6 +42,11 @@ type (
 const (
 	mlbAPIDomain = `https://statsapi.mlb.com`
 	fetchGame    = `%s/api/v1/schedule?sportId=1,51&date=%s&gameTypes=E,S,R,A,F,D,L,W`
+	fetchTeams   = `%s/api/v1/teams?sportId=1`
+	fetchPlays   = `%s/api/v1/game/%d/playByPlay`
+	// fetchStandings asks for the American (103) and National (104) leagues with team abbreviations.
+	fetchStandings = `%s/api/v1/standings?leagueId=103,104&season=%d&standingsTypes=regularSeason&hydrate=team`
+	fetchSchedule  = `%s/api/v1/schedule?sportId=1&teamId=%d&season=%d&gameTypes=R,F,D,L,W&hydrate=team,linescore`
 )
 
 func NewFetcher(httpClient *http.Client) *Fetcher {
@@ -43,12 +65,15 @@ func (f *Fetcher) FetchGames(time time.Time) ([]Game, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error getting games for %s:  %w ", date, err)
 	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("error getting games for %s:  %w ", date, err)
+	}
+	defer resp.Body.Close()
 
 	respBytes, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling response:  %w ", err)
 	}
-	defer resp.Body.Close()
 
 	gamesModel := &FetchGamesResponse{}
 	err = json.Unmarshal(respBytes, gamesModel)
@@ -77,12 +102,15 @@ func (f *Fetcher) FetchScore(game Game) (FetchScoreResponse, error) {
 	if err != nil {
 		return FetchScoreResponse{}, err
 	}
+	if err := httpclient.CheckResponse(response); err != nil {
+		return FetchScoreResponse{}, err
+	}
+	defer response.Body.Close()
 
 	responseBytes, err := io.ReadAll(response.Body)
 	if err != nil {
 		return FetchScoreResponse{}, err
 	}
-	defer response.Body.Close()
 
 	responseScore := &FetchScoreResponse{}
 	err = json.Unmarshal(responseBytes, responseScore)
@@ -93,3 +121,83 @@ func (f *Fetcher) FetchScore(game Game) (FetchScoreResponse, error) {
 	return *responseScore, nil
 
 }
+
+func (f *Fetcher) FetchTeams() ([]TeamInfo, error) {
+	resp, err := f.httpClient.Get(fmt.Sprintf(fetchTeams, f.apiURL))
+	if err != nil {
+		return nil, fmt.Errorf("error getting teams: %w", err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("error getting teams: %w", err)
+	}
+	defer resp.Body.Close()
+
+	teams := &FetchTeamsResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(teams); err != nil {
+		return nil, fmt.Errorf("error unmarshalling teams: %w", err)
+	}
+
+	active := make([]TeamInfo, 0, len(teams.Teams))
+	for _, team := range teams.Teams {
+		if team.Active {
+			active = append(active, team)
+		}
+	}
+	return active, nil
+}
+
+func (f *Fetcher) FetchPlays(game Game) (FetchPlaysResponse, error) {
+	resp, err := f.httpClient.Get(fmt.Sprintf(fetchPlays, f.apiURL, game.GamePk))
+	if err != nil {
+		return FetchPlaysResponse{}, fmt.Errorf("error getting plays for game %d: %w", game.GamePk, err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return FetchPlaysResponse{}, fmt.Errorf("error getting plays for game %d: %w", game.GamePk, err)
+	}
+	defer resp.Body.Close()
+
+	plays := FetchPlaysResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&plays); err != nil {
+		return FetchPlaysResponse{}, fmt.Errorf("error unmarshalling plays for game %d: %w", game.GamePk, err)
+	}
+	return plays, nil
+}
+
+func (f *Fetcher) FetchStandings(season int) (FetchStandingsResponse, error) {
+	resp, err := f.httpClient.Get(fmt.Sprintf(fetchStandings, f.apiURL, season))
+	if err != nil {
+		return FetchStandingsResponse{}, fmt.Errorf("error getting standings for %d: %w", season, err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return FetchStandingsResponse{}, fmt.Errorf("error getting standings for %d: %w", season, err)
+	}
+	defer resp.Body.Close()
+
+	standings := FetchStandingsResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&standings); err != nil {
+		return FetchStandingsResponse{}, fmt.Errorf("error unmarshalling standings for %d: %w", season, err)
+	}
+	return standings, nil
+}
+
+func (f *Fetcher) FetchTeamSchedule(teamID int, season int) ([]ScheduledGame, error) {
+	resp, err := f.httpClient.Get(fmt.Sprintf(fetchSchedule, f.apiURL, teamID, season))
+	if err != nil {
+		return nil, fmt.Errorf("error getting schedule of team %d for %d: %w", teamID, season, err)
+	}
+	if err := httpclient.CheckResponse(resp); err != nil {
+		return nil, fmt.Errorf("error getting schedule of team %d for %d: %w", teamID, season, err)
+	}
+	defer resp.Body.Close()
+
+	schedule := FetchScheduleResponse{}
+	if err := json.NewDecoder(resp.Body).Decode(&schedule); err != nil {
+		return nil, fmt.Errorf("error unmarshalling schedule of team %d for %d: %w", teamID, season, err)
+	}
+
+	games := make([]ScheduledGame, 0)
+	for _, date := range schedule.Dates {
+		games = append(games, date.Games...)
+	}
+	return games, nil
+}
@@ -16,6 +16,18 @@ var gameResp []byte
 //go:embed test-data/scores.json
 var scoresResp []byte
 
+//go:embed test-data/teams.json
+var teamsResp []byte
+
+//go:embed test-data/plays.json
+var playsResp []byte
+
+//go:embed test-data/standings.json
+var standingsResp []byte
+
+//go:embed test-data/schedule.json
+var scheduleResp []byte
+
 func TestFetcher_FetchGames(t *testing.T) {
 	testCases := map[string]struct {
 		mockHttpClient   func() *httptest.Server
@@ -90,6 +102,7 @@ func TestFetcher_FetchScore(t *testing.T) {
 				return mockServer
 			},
 			expectedResponse: FetchScoreResponse{
+				GamePk: 717847,
 				LiveData: LiveData{
 					Linescore: Linescore{
 						CurrentInning:        9,
@@ -261,6 +274,8 @@ func TestFetcher_FetchScore(t *testing.T) {
 								},
 							},
 						},
+						Offense: Offense{Batter: Person{ID: 682928, FullName: "CJ Abrams"}},
+						Defense: Defense{Pitcher: Person{ID: 543518, FullName: "Scott McGough"}},
 					},
 				},
 				GameData: GameData{
@@ -322,3 +337,197 @@ func TestFetcher_FetchScore(t *testing.T) {
 		})
 	}
 }
+
+func TestFetcher_FetchTeams(t *testing.T) {
+	testCases := map[string]struct {
+		status           int
+		expectedResponse []TeamInfo
+		expectErr        bool
+	}{
+		"should return active teams": {
+			status: http.StatusOK,
+			expectedResponse: []TeamInfo{
+				{ID: 147, Name: "New York Yankees", Abbreviation: "NYY", TeamName: "Yankees", Active: true},
+				{ID: 111, Name: "Boston Red Sox", Abbreviation: "BOS", TeamName: "Red Sox", Active: true},
+			},
+		},
+		"should return error on unsuccessful status": {
+			status:    http.StatusServiceUnavailable,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/teams", r.URL.Path)
+				w.WriteHeader(tc.status)
+				_, err := w.Write(teamsResp)
+				assert.NoError(t, err)
+			}))
+			defer s.Close()
+
+			fetcher := Fetcher{s.URL, s.Client()}
+			teams, err := fetcher.FetchTeams()
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.EqualValues(t, tc.expectedResponse, teams)
+		})
+	}
+}
+
+func TestFetcher_FetchPlays(t *testing.T) {
+	testCases := map[string]struct {
+		status           int
+		expectedLastPlay string
+		expectedPlays    int
+		expectErr        bool
+	}{
+		"should return plays of the game": {
+			status:           http.StatusOK,
+			expectedLastPlay: "Keibert Ruiz out on a sacrifice fly to center fielder Alek Thomas. Lane Thomas scores.",
+			expectedPlays:    4,
+		},
+		"should return error on unsuccessful status": {
+			status:    http.StatusServiceUnavailable,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/game/717847/playByPlay", r.URL.Path)
+				w.WriteHeader(tc.status)
+				_, err := w.Write(playsResp)
+				assert.NoError(t, err)
+			}))
+			defer s.Close()
+
+			fetcher := Fetcher{s.URL, s.Client()}
+			plays, err := fetcher.FetchPlays(NewGame(717847))
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, plays.AllPlays, tc.expectedPlays)
+			assert.Equal(t, tc.expectedLastPlay, plays.LastPlay())
+			assert.Equal(t, PlayAbout{AtBatIndex: 1, HalfInning: "top", Inning: 1, IsComplete: true, IsScoringPlay: true}, plays.AllPlays[1].About)
+			assert.Equal(t, "pitching_substitution", plays.AllPlays[2].PlayEvents[0].Details.EventType)
+		})
+	}
+}
+
+func TestFetcher_FetchStandings(t *testing.T) {
+	testCases := map[string]struct {
+		status    int
+		expectErr bool
+	}{
+		"should return standings of each division": {
+			status: http.StatusOK,
+		},
+		"should return error on unsuccessful status": {
+			status:    http.StatusServiceUnavailable,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/standings", r.URL.Path)
+				assert.Equal(t, "2023", r.URL.Query().Get("season"))
+				assert.Equal(t, "103,104", r.URL.Query().Get("leagueId"))
+				w.WriteHeader(tc.status)
+				_, err := w.Write(standingsResp)
+				assert.NoError(t, err)
+			}))
+			defer s.Close()
+
+			fetcher := Fetcher{s.URL, s.Client()}
+			standings, err := fetcher.FetchStandings(2023)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, standings.Records, 6)
+			assert.Equal(t, IDRef{ID: 103}, standings.Records[0].League)
+			assert.Equal(t, IDRef{ID: 201}, standings.Records[0].Division)
+			assert.Equal(t, TeamRecord{
+				Team:            TeamInfo{ID: 110, Name: "Baltimore Orioles", Abbreviation: "BAL", TeamName: "Orioles", Active: true},
+				Wins:            101,
+				Losses:          61,
+				GamesPlayed:     162,
+				RunsScored:      807,
+				RunsAllowed:     678,
+				RunDifferential: 129,
+				Streak:          Streak{StreakCode: "W1"},
+			}, standings.Records[0].TeamRecords[0])
+		})
+	}
+}
+
+func TestFetcher_FetchTeamSchedule(t *testing.T) {
+	testCases := map[string]struct {
+		status    int
+		expectErr bool
+	}{
+		"should return games of every date": {
+			status: http.StatusOK,
+		},
+		"should return error on unsuccessful status": {
+			status:    http.StatusServiceUnavailable,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/schedule", r.URL.Path)
+				assert.Equal(t, "110", r.URL.Query().Get("teamId"))
+				assert.Equal(t, "2023", r.URL.Query().Get("season"))
+				w.WriteHeader(tc.status)
+				_, err := w.Write(scheduleResp)
+				assert.NoError(t, err)
+			}))
+			defer s.Close()
+
+			fetcher := Fetcher{s.URL, s.Client()}
+			games, err := fetcher.FetchTeamSchedule(110, 2023)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, games, 4)
+			assert.Equal(t, 718781, games[0].GamePk)
+			assert.Equal(t, "R", games[0].GameType)
+			assert.Equal(t, time.Date(2023, 3, 30, 18, 10, 0, 0, time.UTC), games[0].GameDate)
+			assert.Equal(t, "BAL", games[0].Teams.Away.Team.Abbreviation)
+			assert.Equal(t, 10, games[0].Teams.Away.Score)
+			assert.Equal(t, 9, games[0].Teams.Home.Score)
+			assert.Equal(t, "Live", games[2].Status.AbstractGameState)
+			assert.Equal(t, "5th", games[2].Linescore.CurrentInningOrdinal)
+			assert.Equal(t, "Top", games[2].Linescore.InningHalf)
+			assert.Equal(t, Venue{Name: "Fenway Park"}, games[0].Venue)
+		})
+	}
+}
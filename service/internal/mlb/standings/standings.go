@@ -0,0 +1,223 @@
+package standings
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// seasonGames is the length of a full season, shortened seasons are not accounted for.
+	seasonGames = 162
+	// wildCards is the number of playoff spots in each league for teams that do not win their division.
+	wildCards = 3
+	// clinched is shown instead of a magic number once a team has clinched.
+	clinched = "X"
+	// eliminated is shown for a team that can no longer catch the team it is chasing.
+	eliminated = "E"
+)
+
+var (
+	leagueNames = map[int]string{103: "AL", 104: "NL"}
+	// divisionNames are the names of statsapi divisions within their league.
+	divisionNames = map[int]string{200: "West", 201: "East", 202: "Central", 203: "West", 204: "East", 205: "Central"}
+	// divisionOrder orders divisions East, Central, West the way MLB lists them.
+	divisionOrder = map[string]int{"East": 0, "Central": 1, "West": 2}
+
+	divisionColumns = []string{"W", "L", "PCT", "GB", "DIFF", "STRK", "MAGIC"}
+	wildCardColumns = []string{"W", "L", "PCT", "WCGB", "DIFF", "STRK", "MAGIC"}
+)
+
+type (
+	// Standings is the page of division standings and wildcard races of a season.
+	Standings struct {
+		Season    int
+		Divisions []Division
+		WildCards []Race
+	}
+
+	// Division is the standings of a division, leader first.
+	Division struct {
+		League string
+		Name   string
+		Teams  []Team
+	}
+
+	// Race is the wildcard race of a league, the teams holding a spot first.
+	Race struct {
+		League string
+		Teams  []Team
+	}
+
+	Team struct {
+		Abbreviation    string
+		Wins, Losses    int
+		RunDifferential int
+		Streak          string
+		// GamesBack is how far the team trails the leader or the last wildcard spot, negative when it holds a
+		// wildcard spot.
+		GamesBack float64
+		// Magic is the magic number of a leader or wildcard team, clinched or eliminated.
+		Magic string
+	}
+)
+
+// New builds the standings of season from statsapi records. Teams with the same record keep the order of
+// statsapi, which applies the MLB tie-breakers.
+func New(season int, resp fetcher.FetchStandingsResponse) Standings {
+	s := Standings{Season: season}
+
+	records := append([]fetcher.StandingsRecord(nil), resp.Records...)
+	sort.SliceStable(records, func(i, j int) bool {
+		if records[i].League.ID != records[j].League.ID {
+			return records[i].League.ID < records[j].League.ID
+		}
+		return divisionOrder[divisionNames[records[i].Division.ID]] < divisionOrder[divisionNames[records[j].Division.ID]]
+	})
+
+	chasers := make(map[string][]Team)
+	for _, record := range records {
+		league := leagueNames[record.League.ID]
+		teams := make([]Team, 0, len(record.TeamRecords))
+		for _, tr := range record.TeamRecords {
+			teams = append(teams, Team{
+				Abbreviation:    tr.Team.Abbreviation,
+				Wins:            tr.Wins,
+				Losses:          tr.Losses,
+				RunDifferential: tr.RunDifferential,
+				Streak:          tr.Streak.StreakCode,
+			})
+		}
+		sortByPct(teams)
+		race(teams, 1)
+		s.Divisions = append(s.Divisions, Division{League: league, Name: divisionNames[record.Division.ID], Teams: teams})
+		if len(teams) > 1 {
+			chasers[league] = append(chasers[league], teams[1:]...)
+		}
+	}
+
+	for _, league := range []string{"AL", "NL"} {
+		teams, ok := chasers[league]
+		if !ok {
+			continue
+		}
+		sortByPct(teams)
+		race(teams, wildCards)
+		s.WildCards = append(s.WildCards, Race{League: league, Teams: teams})
+	}
+	return s
+}
+
+// Season returns the season being played or last played at t. Seasons start at the end of March.
+func Season(t time.Time) int {
+	if t.Month() < time.April {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
+
+// Pct is the win percentage, 0 before the first game.
+func (t Team) Pct() float64 {
+	if t.Wins+t.Losses == 0 {
+		return 0
+	}
+	return float64(t.Wins) / float64(t.Wins+t.Losses)
+}
+
+func (t Team) played() int {
+	return t.Wins + t.Losses
+}
+
+// Tables returns a table for each division followed by the wildcard race of each league.
+func (s Standings) Tables() renderer.Tables {
+	tables := renderer.Tables{Heading: fmt.Sprintf("%d MLB Standings", s.Season)}
+	for _, d := range s.Divisions {
+		tables.Tables = append(tables.Tables, table(d.League+" "+d.Name, divisionColumns, d.Teams))
+	}
+	for _, r := range s.WildCards {
+		tables.Tables = append(tables.Tables, table(r.League+" Wild Card", wildCardColumns, r.Teams))
+	}
+	return tables
+}
+
+// race sets games back and magic numbers of teams ordered by win percentage where the first spots teams make
+// the playoffs. Teams holding one of several spots are shown games ahead of the first team out, a division leader
+// is not.
+func race(teams []Team, spots int) {
+	for i := range teams {
+		teams[i].GamesBack, teams[i].Magic = 0, ""
+	}
+	if len(teams) <= spots {
+		for i := range teams {
+			teams[i].Magic = clinched
+		}
+		return
+	}
+
+	last, firstOut := teams[spots-1], teams[spots]
+	for i := range teams {
+		if i < spots {
+			if spots > 1 {
+				teams[i].GamesBack = -gamesBack(teams[i], firstOut)
+			}
+			teams[i].Magic = magicNumber(teams[i], firstOut)
+			continue
+		}
+		teams[i].GamesBack = gamesBack(last, teams[i])
+		if magicNumber(last, teams[i]) == clinched {
+			teams[i].Magic = eliminated
+		}
+	}
+}
+
+// gamesBack is how many games behind leader trails by.
+func gamesBack(leader, trailer Team) float64 {
+	return float64((leader.Wins-trailer.Wins)+(trailer.Losses-leader.Losses)) / 2
+}
+
+// magicNumber is the number of leader wins and chaser losses that clinch the spot for leader. Teams that finish
+// tied have been ordered by the tie-breakers, so leader has clinched once both have played every game.
+func magicNumber(leader, chaser Team) string {
+	magic := seasonGames + 1 - leader.Wins - chaser.Losses
+	if magic <= 0 || leader.played() == seasonGames && chaser.played() == seasonGames {
+		return clinched
+	}
+	return strconv.Itoa(magic)
+}
+
+func sortByPct(teams []Team) {
+	sort.SliceStable(teams, func(i, j int) bool { return teams[i].Pct() > teams[j].Pct() })
+}
+
+func table(title string, columns []string, teams []Team) renderer.Table {
+	t := renderer.Table{Title: title, Columns: columns}
+	for _, team := range teams {
+		t.Rows = append(t.Rows, []string{
+			team.Abbreviation,
+			strconv.Itoa(team.Wins),
+			strconv.Itoa(team.Losses),
+			strings.TrimPrefix(fmt.Sprintf("%.3f", team.Pct()), "0"),
+			gamesBackString(team.GamesBack),
+			fmt.Sprintf("%+d", team.RunDifferential),
+			team.Streak,
+			team.Magic,
+		})
+	}
+	return t
+}
+
+// gamesBackString formats games back like standings do, with - for none and + for games ahead.
+func gamesBackString(gb float64) string {
+	if gb == 0 {
+		return "-"
+	}
+	s := strconv.FormatFloat(gb, 'f', -1, 64)
+	if gb < 0 {
+		return "+" + s[1:]
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package standings
+
+import (
+	"encoding/json"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"testing"
+	"time"
+)
+
+// finals2023 returns the final 2023 standings fetched from statsapi.
+func finals2023(t *testing.T) fetcher.FetchStandingsResponse {
+	b, err := os.ReadFile("../fetcher/test-data/standings.json")
+	require.NoError(t, err)
+	resp := fetcher.FetchStandingsResponse{}
+	require.NoError(t, json.Unmarshal(b, &resp))
+	return resp
+}
+
+func TestNew(t *testing.T) {
+	tables := New(2023, finals2023(t)).Tables()
+
+	assert.Equal(t, "2023 MLB Standings", tables.Heading)
+	titles := make([]string, 0, len(tables.Tables))
+	for _, table := range tables.Tables {
+		titles = append(titles, table.Title)
+	}
+	assert.Equal(t, []string{"AL East", "AL Central", "AL West", "NL East", "NL Central", "NL West", "AL Wild Card", "NL Wild Card"}, titles)
+
+	testCases := map[string]struct {
+		table        renderer.Table
+		expectedRows [][]string
+	}{
+		"should show games back and clinched or eliminated teams of a division": {
+			table: tables.Tables[0],
+			expectedRows: [][]string{
+				{"BAL", "101", "61", ".623", "-", "+129", "W1", "X"},
+				{"TB", "99", "63", ".611", "2", "+195", "L1", "E"},
+				{"TOR", "89", "73", ".549", "12", "+75", "L1", "E"},
+				{"NYY", "82", "80", ".506", "19", "-4", "W1", "E"},
+				{"BOS", "78", "84", ".481", "23", "-4", "W1", "E"},
+			},
+		},
+		"should keep the order of statsapi for teams with the same record": {
+			table: tables.Tables[2],
+			expectedRows: [][]string{
+				{"HOU", "90", "72", ".556", "-", "+129", "W1", "X"},
+				{"TEX", "90", "72", ".556", "-", "+165", "L1", "E"},
+				{"SEA", "88", "74", ".543", "2", "+99", "W1", "E"},
+				{"LAA", "73", "89", ".451", "17", "-90", "L1", "E"},
+				{"OAK", "50", "112", ".309", "40", "-339", "L2", "E"},
+			},
+		},
+		"should show games ahead of the first team out for wildcard teams": {
+			table: tables.Tables[6],
+			expectedRows: [][]string{
+				{"TB", "99", "63", ".611", "+11", "+195", "L1", "X"},
+				{"TEX", "90", "72", ".556", "+2", "+165", "L1", "X"},
+				{"TOR", "89", "73", ".549", "+1", "+75", "L1", "X"},
+				{"SEA", "88", "74", ".543", "1", "+99", "W1", "E"},
+				{"NYY", "82", "80", ".506", "7", "-4", "W1", "E"},
+				{"BOS", "78", "84", ".481", "11", "-4", "W1", "E"},
+				{"DET", "78", "84", ".481", "11", "-79", "W2", "E"},
+				{"CLE", "76", "86", ".469", "13", "-35", "L1", "E"},
+				{"LAA", "73", "89", ".451", "16", "-90", "L1", "E"},
+				{"CWS", "61", "101", ".377", "28", "-197", "L1", "E"},
+				{"KC", "56", "106", ".346", "33", "-183", "W1", "E"},
+				{"OAK", "50", "112", ".309", "39", "-339", "L2", "E"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expectedRows, tc.table.Rows)
+		})
+	}
+}
+
+func TestRace(t *testing.T) {
+	testCases := map[string]struct {
+		teams             []Team
+		spots             int
+		expectedGamesBack []float64
+		expectedMagic     []string
+	}{
+		"should count down the magic number of a division leader": {
+			teams:             []Team{{Wins: 80, Losses: 50}, {Wins: 75, Losses: 55}, {Wins: 60, Losses: 70}},
+			spots:             1,
+			expectedGamesBack: []float64{0, 5, 20},
+			expectedMagic:     []string{"28", "", ""},
+		},
+		"should eliminate teams that can no longer catch the leader": {
+			teams:             []Team{{Wins: 100, Losses: 50}, {Wins: 70, Losses: 80}},
+			spots:             1,
+			expectedGamesBack: []float64{0, 30},
+			expectedMagic:     []string{"X", "E"},
+		},
+		"should show half games": {
+			teams:             []Team{{Wins: 80, Losses: 50}, {Wins: 79, Losses: 50}, {Wins: 78, Losses: 53}},
+			spots:             2,
+			expectedGamesBack: []float64{-2.5, -2, 2},
+			expectedMagic:     []string{"30", "31", ""},
+		},
+		"should replace games back and magic numbers of the division in the wildcard race": {
+			teams:             []Team{{Wins: 79, Losses: 50, GamesBack: 1, Magic: "E"}, {Wins: 70, Losses: 60, GamesBack: 9.5, Magic: "E"}},
+			spots:             1,
+			expectedGamesBack: []float64{0, 9.5},
+			expectedMagic:     []string{"24", ""},
+		},
+		"should clinch every spot when there are no more teams than spots": {
+			teams:             []Team{{Wins: 10, Losses: 5}},
+			spots:             1,
+			expectedGamesBack: []float64{0},
+			expectedMagic:     []string{"X"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			race(tc.teams, tc.spots)
+
+			gamesBack := make([]float64, 0, len(tc.teams))
+			magic := make([]string, 0, len(tc.teams))
+			for _, team := range tc.teams {
+				gamesBack = append(gamesBack, team.GamesBack)
+				magic = append(magic, team.Magic)
+			}
+			assert.Equal(t, tc.expectedGamesBack, gamesBack)
+			assert.Equal(t, tc.expectedMagic, magic)
+		})
+	}
+}
+
+func TestSeason(t *testing.T) {
+	assert.Equal(t, 2022, Season(time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 2023, Season(time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)))
+}
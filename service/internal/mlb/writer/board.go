@@ -0,0 +1,121 @@
+package writer
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const gameTimeLayout = "3:04 PM"
+
+// NewBoard maps the scores to the board for date. asOf is when stale scores were current, zero when the scores
+// are current. lastPlays are the last plays of games in progress by game pk.
+func NewBoard(date, asOf time.Time, scores []*fetcher.FetchScoreResponse, lastPlays map[int]string) renderer.Board {
+	games := make([]renderer.Game, 0, len(scores))
+	for _, score := range scores {
+		g := game(date.Location(), score)
+		if g.Status.State == renderer.Live {
+			g.LastPlay = lastPlays[score.GamePk]
+		}
+		games = append(games, g)
+	}
+	return renderer.Board{Date: date, AsOf: asOf, Games: games}
+}
+
+// NewGamePage maps a score to the page of a single game dated by its start in loc. Games in progress list the
+// count, outs, runners and who is pitching and batting along with lastPlay.
+func NewGamePage(loc *time.Location, asOf time.Time, score *fetcher.FetchScoreResponse, lastPlay string) renderer.Board {
+	g := game(loc, score)
+	if g.Status.State == renderer.Live {
+		g.Details = details(score.LiveData.Linescore)
+		g.LastPlay = lastPlay
+	}
+
+	date := score.GameData.DateTime.DateTime.In(loc)
+	if score.GameData.DateTime.DateTime.IsZero() {
+		date = time.Now().In(loc)
+	}
+	return renderer.Board{Date: date, AsOf: asOf, Games: []renderer.Game{g}}
+}
+
+func details(linescore fetcher.Linescore) []renderer.Detail {
+	runners := make([]string, 0, 3)
+	for _, base := range []struct {
+		name   string
+		runner *fetcher.Person
+	}{
+		{"1st", linescore.Offense.First},
+		{"2nd", linescore.Offense.Second},
+		{"3rd", linescore.Offense.Third},
+	} {
+		if base.runner != nil {
+			runners = append(runners, base.name)
+		}
+	}
+	onBase := "Bases empty"
+	if len(runners) > 0 {
+		onBase = strings.Join(runners, ", ")
+	}
+
+	return []renderer.Detail{
+		{Label: "Count", Value: fmt.Sprintf("%d-%d", linescore.Balls, linescore.Strikes)},
+		{Label: "Outs", Value: strconv.Itoa(linescore.Outs)},
+		{Label: "Runners", Value: onBase},
+		{Label: "Pitcher", Value: linescore.Defense.Pitcher.FullName},
+		{Label: "Batter", Value: linescore.Offense.Batter.FullName},
+	}
+}
+
+func game(loc *time.Location, score *fetcher.FetchScoreResponse) renderer.Game {
+	linescore := score.LiveData.Linescore
+
+	innings := 9
+	if innings < len(linescore.Innings) {
+		innings = len(linescore.Innings)
+	}
+
+	status := renderer.Status{Start: score.GameData.DateTime.DateTime}
+	switch score.GameData.Status.StatusCode {
+	case "F":
+		status.State = renderer.Final
+		status.Detail = score.GameData.Status.DetailedState
+	case "P", "S":
+		status.State = renderer.Scheduled
+		status.Detail = gameTime(loc, score.GameData.DateTime)
+	default:
+		status.State = renderer.Live
+		status.Detail = fmt.Sprintf("%s %s", linescore.InningHalf, linescore.CurrentInningOrdinal)
+	}
+
+	away := renderer.Team{Name: score.GameData.Teams.Away.Abbreviation, Totals: totals(linescore.Teams.Away)}
+	home := renderer.Team{Name: score.GameData.Teams.Home.Abbreviation, Totals: totals(linescore.Teams.Home)}
+	for _, inning := range linescore.Innings {
+		away.Periods = append(away.Periods, strconv.Itoa(inning.Away.Runs))
+		home.Periods = append(home.Periods, strconv.Itoa(inning.Home.Runs))
+	}
+
+	return renderer.Game{
+		ID:          strconv.Itoa(score.GamePk),
+		Periods:     innings,
+		TotalLabels: []string{"R", "H", "E"},
+		Away:        away,
+		Home:        home,
+		Status:      status,
+	}
+}
+
+func totals(stat fetcher.TeamStat) []string {
+	return []string{strconv.Itoa(stat.Runs), strconv.Itoa(stat.Hits), strconv.Itoa(stat.Errors)}
+}
+
+// gameTime returns the start time of a game in loc. statsapi's time and ampm are used when the start time is
+// missing.
+func gameTime(loc *time.Location, dateTime fetcher.DateTime) string {
+	if dateTime.DateTime.IsZero() {
+		return fmt.Sprintf("%s %s", dateTime.Time, dateTime.AMPM)
+	}
+	return dateTime.DateTime.In(loc).Format(gameTimeLayout)
+}
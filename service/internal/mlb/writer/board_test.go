@@ -0,0 +1,166 @@
+package writer
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewBoard(t *testing.T) {
+	chicago, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	start := time.Date(2023, 6, 22, 23, 5, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		status   fetcher.GameStatus
+		dateTime fetcher.DateTime
+		innings  fetcher.Innings
+		expected renderer.Status
+		periods  int
+		lastPlay string
+	}{
+		"should show start time in board location before the game": {
+			status:   fetcher.GameStatus{StatusCode: "S"},
+			dateTime: fetcher.DateTime{DateTime: start},
+			expected: renderer.Status{State: renderer.Scheduled, Detail: "6:05 PM", Start: start},
+			periods:  9,
+		},
+		"should fall back to statsapi time when start time is missing": {
+			status:   fetcher.GameStatus{StatusCode: "P"},
+			dateTime: fetcher.DateTime{Time: "7:05", AMPM: "PM"},
+			expected: renderer.Status{State: renderer.Scheduled, Detail: "7:05 PM"},
+			periods:  9,
+		},
+		"should show inning during the game": {
+			status:   fetcher.GameStatus{StatusCode: "I"},
+			dateTime: fetcher.DateTime{DateTime: start},
+			innings:  fetcher.Innings{{Num: 1, Away: fetcher.Away{Runs: 2}}, {Num: 2, Home: fetcher.Home{Runs: 1}}},
+			expected: renderer.Status{State: renderer.Live, Detail: "Top 2nd", Start: start},
+			periods:  9,
+			lastPlay: "Aaron Judge singles on a line drive to left fielder.",
+		},
+		"should show extra innings of final game": {
+			status:   fetcher.GameStatus{StatusCode: "F", DetailedState: "Final"},
+			dateTime: fetcher.DateTime{DateTime: start},
+			innings:  make(fetcher.Innings, 11),
+			expected: renderer.Status{State: renderer.Final, Detail: "Final", Start: start},
+			periods:  11,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			score := &fetcher.FetchScoreResponse{
+				GamePk: 1,
+				GameData: fetcher.GameData{
+					Status:   tc.status,
+					DateTime: tc.dateTime,
+					Teams: fetcher.Teams{
+						Away: fetcher.TeamData{Abbreviation: "NYY"},
+						Home: fetcher.TeamData{Abbreviation: "BOS"},
+					},
+				},
+				LiveData: fetcher.LiveData{Linescore: fetcher.Linescore{
+					InningHalf:           "Top",
+					CurrentInningOrdinal: "2nd",
+					Innings:              tc.innings,
+					Teams: fetcher.TeamStats{
+						Away: fetcher.TeamStat{Runs: 2, Hits: 4},
+						Home: fetcher.TeamStat{Runs: 1, Hits: 3, Errors: 1},
+					},
+				}},
+			}
+			date := time.Date(2023, 6, 22, 0, 0, 0, 0, chicago)
+
+			lastPlays := map[int]string{1: "Aaron Judge singles on a line drive to left fielder."}
+			board := NewBoard(date, time.Time{}, []*fetcher.FetchScoreResponse{score}, lastPlays)
+
+			require.Len(t, board.Games, 1)
+			g := board.Games[0]
+			assert.Equal(t, "1", g.ID)
+			assert.Equal(t, tc.expected, g.Status)
+			assert.Equal(t, tc.periods, g.Periods)
+			assert.Equal(t, []string{"R", "H", "E"}, g.TotalLabels)
+			assert.Equal(t, "NYY", g.Away.Name)
+			assert.Equal(t, []string{"2", "4", "0"}, g.Away.Totals)
+			assert.Equal(t, []string{"1", "3", "1"}, g.Home.Totals)
+			assert.Len(t, g.Away.Periods, len(tc.innings))
+			assert.Equal(t, tc.lastPlay, g.LastPlay)
+		})
+	}
+}
+
+func TestNewGamePage(t *testing.T) {
+	start := time.Date(2023, 6, 22, 23, 5, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		status   fetcher.GameStatus
+		offense  fetcher.Offense
+		expected []renderer.Detail
+		lastPlay string
+	}{
+		"should list the count and runners of a game in progress": {
+			status: fetcher.GameStatus{StatusCode: "I"},
+			offense: fetcher.Offense{
+				Batter: fetcher.Person{ID: 1, FullName: "CJ Abrams"},
+				First:  &fetcher.Person{ID: 2, FullName: "Lane Thomas"},
+				Third:  &fetcher.Person{ID: 3, FullName: "Luis Garcia"},
+			},
+			expected: []renderer.Detail{
+				{Label: "Count", Value: "3-2"},
+				{Label: "Outs", Value: "2"},
+				{Label: "Runners", Value: "1st, 3rd"},
+				{Label: "Pitcher", Value: "Scott McGough"},
+				{Label: "Batter", Value: "CJ Abrams"},
+			},
+			lastPlay: "Lane Thomas walks.",
+		},
+		"should say when the bases are empty": {
+			status:  fetcher.GameStatus{StatusCode: "I"},
+			offense: fetcher.Offense{Batter: fetcher.Person{ID: 1, FullName: "CJ Abrams"}},
+			expected: []renderer.Detail{
+				{Label: "Count", Value: "3-2"},
+				{Label: "Outs", Value: "2"},
+				{Label: "Runners", Value: "Bases empty"},
+				{Label: "Pitcher", Value: "Scott McGough"},
+				{Label: "Batter", Value: "CJ Abrams"},
+			},
+			lastPlay: "Lane Thomas walks.",
+		},
+		"should not list details of a final game": {
+			status: fetcher.GameStatus{StatusCode: "F", DetailedState: "Final"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			score := &fetcher.FetchScoreResponse{
+				GamePk: 717847,
+				GameData: fetcher.GameData{
+					Status:   tc.status,
+					DateTime: fetcher.DateTime{DateTime: start},
+				},
+				LiveData: fetcher.LiveData{Linescore: fetcher.Linescore{
+					Balls:   3,
+					Strikes: 2,
+					Outs:    2,
+					Offense: tc.offense,
+					Defense: fetcher.Defense{Pitcher: fetcher.Person{ID: 4, FullName: "Scott McGough"}},
+				}},
+			}
+
+			board := NewGamePage(time.UTC, time.Time{}, score, "Lane Thomas walks.")
+
+			require.Len(t, board.Games, 1)
+			assert.Equal(t, start, board.Date)
+			assert.Equal(t, tc.expected, board.Games[0].Details)
+			assert.Equal(t, tc.lastPlay, board.Games[0].LastPlay)
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package writer
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/calendar"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"sort"
+	"time"
+)
+
+// gameDuration is how long a game is blocked out in calendars.
+const gameDuration = 3 * time.Hour
+
+// NewCalendar maps games to calendar events named name, each game once. Games that were postponed or cancelled
+// are left out, the game they are made up in has its own date. Finals carry the score. The sequence of an event
+// counts the times its game was rescheduled, and once more when it is over, so calendars pick up each change.
+func NewCalendar(name string, games []fetcher.ScheduledGame) calendar.Calendar {
+	// the schedule keeps an entry of the game at each date it was postponed or suspended from
+	rescheduled := make(map[int]map[int64]bool)
+	for _, g := range games {
+		if g.Status.AbstractGameState == "Final" && !g.Status.GameOver() {
+			if rescheduled[g.GamePk] == nil {
+				rescheduled[g.GamePk] = make(map[int64]bool)
+			}
+			rescheduled[g.GamePk][g.GameDate.Unix()] = true
+		}
+	}
+
+	cal := calendar.Calendar{Name: name}
+	listed := make(map[int]bool)
+	for _, g := range games {
+		if listed[g.GamePk] || g.Status.AbstractGameState == "Final" && !g.Status.GameOver() {
+			continue
+		}
+		listed[g.GamePk] = true
+
+		away, home := g.Teams.Away, g.Teams.Home
+		event := calendar.Event{
+			UID:      fmt.Sprintf("mlb-%d@mini-score", g.GamePk),
+			Start:    g.GameDate,
+			Duration: gameDuration,
+			Summary:  fmt.Sprintf("%s @ %s", away.Team.Abbreviation, home.Team.Abbreviation),
+			Location: g.Venue.Name,
+			Sequence: len(rescheduled[g.GamePk]),
+		}
+		if g.Status.GameOver() {
+			event.Description = fmt.Sprintf("Final: %s %d, %s %d", away.Team.Abbreviation, away.Score, home.Team.Abbreviation, home.Score)
+			event.Sequence++
+		}
+		cal.Events = append(cal.Events, event)
+	}
+
+	sort.SliceStable(cal.Events, func(i, j int) bool { return cal.Events[i].Start.Before(cal.Events[j].Start) })
+	return cal
+}
@@ -0,0 +1,50 @@
+package writer
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/calendar"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestNewCalendar(t *testing.T) {
+	bal := fetcher.TeamInfo{ID: 110, Abbreviation: "BAL"}
+	bos := fetcher.TeamInfo{ID: 111, Abbreviation: "BOS"}
+	start := time.Date(2023, 3, 30, 18, 10, 0, 0, time.UTC)
+	scheduled := func(gamePk int, date time.Time, status fetcher.GameStatus, awayScore, homeScore int) fetcher.ScheduledGame {
+		return fetcher.ScheduledGame{
+			GamePk:   gamePk,
+			GameDate: date,
+			Status:   status,
+			Teams: fetcher.ScheduledTeams{
+				Away: fetcher.ScheduledTeam{Team: bal, Score: awayScore},
+				Home: fetcher.ScheduledTeam{Team: bos, Score: homeScore},
+			},
+			Venue: fetcher.Venue{Name: "Fenway Park"},
+		}
+	}
+
+	final := fetcher.GameStatus{AbstractGameState: "Final", CodedGameState: "F", StatusCode: "F"}
+	postponed := fetcher.GameStatus{AbstractGameState: "Final", CodedGameState: "D", StatusCode: "DR", DetailedState: "Postponed"}
+
+	cal := NewCalendar("BAL MLB Schedule", []fetcher.ScheduledGame{
+		scheduled(3, start.AddDate(0, 0, 3), fetcher.GameStatus{AbstractGameState: "Preview", CodedGameState: "S", StatusCode: "S"}, 0, 0),
+		scheduled(1, start, final, 10, 9),
+		scheduled(2, start.AddDate(0, 0, 1), postponed, 0, 0),
+		scheduled(1, start, final, 10, 9),
+		scheduled(4, start.AddDate(0, 0, 4), postponed, 0, 0),
+		scheduled(4, start.AddDate(0, 0, 5), postponed, 0, 0),
+		scheduled(4, start.AddDate(0, 0, 5), postponed, 0, 0),
+		scheduled(4, start.AddDate(0, 0, 6), fetcher.GameStatus{AbstractGameState: "Live", CodedGameState: "O", StatusCode: "O"}, 2, 1),
+	})
+
+	assert.Equal(t, calendar.Calendar{
+		Name: "BAL MLB Schedule",
+		Events: []calendar.Event{
+			{UID: "mlb-1@mini-score", Start: start, Duration: gameDuration, Summary: "BAL @ BOS", Description: "Final: BAL 10, BOS 9", Location: "Fenway Park", Sequence: 1},
+			{UID: "mlb-3@mini-score", Start: start.AddDate(0, 0, 3), Duration: gameDuration, Summary: "BAL @ BOS", Location: "Fenway Park"},
+			{UID: "mlb-4@mini-score", Start: start.AddDate(0, 0, 6), Duration: gameDuration, Summary: "BAL @ BOS", Description: "Final: BAL 2, BOS 1", Location: "Fenway Park", Sequence: 3},
+		},
+	}, cal)
+}
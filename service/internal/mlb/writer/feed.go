@@ -0,0 +1,33 @@
+package writer
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/feed"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"strconv"
+	"time"
+)
+
+// regulationInnings is the length of a game, finals of other lengths are titled with the innings played.
+const regulationInnings = 9
+
+// NewFinalEntry maps a final score to the feed entry published at published, with the line score as it is on the
+// board.
+func NewFinalEntry(score *fetcher.FetchScoreResponse, published time.Time) feed.Entry {
+	g := game(time.UTC, score)
+
+	status := score.GameData.Status.DetailedState
+	if innings := len(score.LiveData.Linescore.Innings); innings > 0 && innings != regulationInnings {
+		status = fmt.Sprintf("%s/%d", status, innings)
+	}
+
+	return feed.Entry{
+		ID: "urn:mini-score:mlb:" + strconv.Itoa(score.GamePk),
+		Title: fmt.Sprintf("%s: %s %s @ %s %s", status, g.Away.Name, g.Away.Total(0), g.Home.Name,
+			g.Home.Total(0)),
+		Published: published,
+		Updated:   published,
+		Content:   renderer.Text{}.LineScore(g),
+	}
+}
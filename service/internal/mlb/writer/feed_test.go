@@ -0,0 +1,57 @@
+package writer
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewFinalEntry(t *testing.T) {
+	published := time.Date(2023, 6, 23, 2, 14, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		innings       int
+		expectedTitle string
+	}{
+		"should title regulation finals with the score": {innings: 9, expectedTitle: "Final: NYY 2 @ BOS 1"},
+		"should title extra innings with the innings":   {innings: 11, expectedTitle: "Final/11: NYY 2 @ BOS 1"},
+		"should title shortened games with the innings": {innings: 7, expectedTitle: "Final/7: NYY 2 @ BOS 1"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			score := &fetcher.FetchScoreResponse{
+				GamePk: 717465,
+				GameData: fetcher.GameData{
+					Status: fetcher.GameStatus{StatusCode: "F", DetailedState: "Final"},
+					Teams: fetcher.Teams{
+						Away: fetcher.TeamData{Abbreviation: "NYY"},
+						Home: fetcher.TeamData{Abbreviation: "BOS"},
+					},
+				},
+				LiveData: fetcher.LiveData{Linescore: fetcher.Linescore{
+					Innings: make(fetcher.Innings, tc.innings),
+					Teams: fetcher.TeamStats{
+						Away: fetcher.TeamStat{Runs: 2, Hits: 4},
+						Home: fetcher.TeamStat{Runs: 1, Hits: 3, Errors: 1},
+					},
+				}},
+			}
+
+			entry := NewFinalEntry(score, published)
+
+			assert.Equal(t, "urn:mini-score:mlb:717465", entry.ID)
+			assert.Equal(t, tc.expectedTitle, entry.Title)
+			assert.Equal(t, published, entry.Published)
+			assert.Equal(t, published, entry.Updated)
+			lines := strings.Split(entry.Content, "\n")
+			assert.Len(t, lines, 6)
+			assert.Contains(t, lines[2], "NYY")
+			assert.Contains(t, lines[4], "BOS")
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package writer
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"time"
+)
+
+const scheduleDateLayout = "Mon Jan 2"
+
+var scheduleColumns = []string{"OPP", "RESULT", "RECORD"}
+
+// NewSchedule maps the games of team in season to a table for the regular season and one for the postseason.
+// Start times are shown in loc and finals are followed by the record of team after the game.
+func NewSchedule(loc *time.Location, team fetcher.TeamInfo, season int, games []fetcher.ScheduledGame) renderer.Tables {
+	regular := renderer.Table{Title: "Regular Season", Columns: scheduleColumns}
+	post := renderer.Table{Title: "Postseason", Columns: scheduleColumns}
+	regularWins, regularLosses, postWins, postLosses := 0, 0, 0, 0
+
+	for _, g := range games {
+		if g.GameType == "R" {
+			regular.Rows = append(regular.Rows, scheduleRow(loc, team, g, &regularWins, &regularLosses))
+			continue
+		}
+		post.Rows = append(post.Rows, scheduleRow(loc, team, g, &postWins, &postLosses))
+	}
+
+	tables := renderer.Tables{Heading: fmt.Sprintf("%d %s Schedule", season, team.Name)}
+	for _, table := range []renderer.Table{regular, post} {
+		if len(table.Rows) > 0 {
+			tables.Tables = append(tables.Tables, table)
+		}
+	}
+	return tables
+}
+
+// scheduleRow describes a game from the side of team, counting finals in wins and losses. A tie, like a
+// suspended game called tied, is shown as one and left out of the record.
+func scheduleRow(loc *time.Location, team fetcher.TeamInfo, g fetcher.ScheduledGame, wins, losses *int) []string {
+	us, them, opponent := g.Teams.Home, g.Teams.Away, "vs "+g.Teams.Away.Team.Abbreviation
+	if g.Teams.Away.Team.ID == team.ID {
+		us, them, opponent = g.Teams.Away, g.Teams.Home, "@ "+g.Teams.Home.Team.Abbreviation
+	}
+	date := g.GameDate.In(loc).Format(scheduleDateLayout)
+
+	switch {
+	case g.Status.GameOver():
+		outcome := "T"
+		switch {
+		case us.Score > them.Score:
+			outcome = "W"
+			*wins++
+		case us.Score < them.Score:
+			outcome = "L"
+			*losses++
+		}
+		return []string{date, opponent, fmt.Sprintf("%s %d-%d", outcome, us.Score, them.Score), fmt.Sprintf("%d-%d", *wins, *losses)}
+	case g.Status.StatusCode == "P" || g.Status.StatusCode == "S":
+		return []string{date, opponent, g.GameDate.In(loc).Format(gameTimeLayout), ""}
+	case g.Status.AbstractGameState == "Final":
+		// postponed and cancelled games are over without a result
+		return []string{date, opponent, g.Status.DetailedState, ""}
+	default:
+		return []string{date, opponent, fmt.Sprintf("%s %s %d-%d", g.Linescore.InningHalf, g.Linescore.CurrentInningOrdinal, us.Score, them.Score), ""}
+	}
+}
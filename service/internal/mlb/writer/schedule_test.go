@@ -0,0 +1,110 @@
+package writer
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestNewSchedule(t *testing.T) {
+	eastern, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	bal := fetcher.TeamInfo{ID: 110, Name: "Baltimore Orioles", Abbreviation: "BAL"}
+	bos := fetcher.TeamInfo{ID: 111, Name: "Boston Red Sox", Abbreviation: "BOS"}
+	tex := fetcher.TeamInfo{ID: 140, Name: "Texas Rangers", Abbreviation: "TEX"}
+	scheduled := func(gameType string, date time.Time, status fetcher.GameStatus, away, home fetcher.TeamInfo, awayScore, homeScore int) fetcher.ScheduledGame {
+		return fetcher.ScheduledGame{
+			GameType: gameType,
+			GameDate: date,
+			Status:   status,
+			Teams: fetcher.ScheduledTeams{
+				Away: fetcher.ScheduledTeam{Team: away, Score: awayScore},
+				Home: fetcher.ScheduledTeam{Team: home, Score: homeScore},
+			},
+		}
+	}
+	final := fetcher.GameStatus{AbstractGameState: "Final", CodedGameState: "F", StatusCode: "F", DetailedState: "Final"}
+	gameOver := fetcher.GameStatus{AbstractGameState: "Live", CodedGameState: "O", StatusCode: "O", DetailedState: "Game Over"}
+	tied := fetcher.GameStatus{AbstractGameState: "Final", CodedGameState: "F", StatusCode: "FT", DetailedState: "Final: Tied"}
+	live := scheduled("R", time.Date(2023, 4, 2, 17, 35, 0, 0, time.UTC), fetcher.GameStatus{AbstractGameState: "Live", StatusCode: "I"}, bal, bos, 3, 2)
+	live.Linescore = fetcher.Linescore{InningHalf: "Top", CurrentInningOrdinal: "5th"}
+
+	testCases := map[string]struct {
+		games          []fetcher.ScheduledGame
+		expectedTables []renderer.Table
+	}{
+		"should show results, running record, games in progress and start times": {
+			games: []fetcher.ScheduledGame{
+				scheduled("R", time.Date(2023, 3, 30, 18, 10, 0, 0, time.UTC), final, bal, bos, 10, 9),
+				scheduled("R", time.Date(2023, 4, 1, 20, 10, 0, 0, time.UTC), final, bal, bos, 8, 9),
+				live,
+				scheduled("R", time.Date(2023, 4, 4, 0, 5, 0, 0, time.UTC), fetcher.GameStatus{AbstractGameState: "Preview", StatusCode: "S"}, tex, bal, 0, 0),
+				scheduled("R", time.Date(2023, 4, 5, 0, 5, 0, 0, time.UTC), fetcher.GameStatus{AbstractGameState: "Final", CodedGameState: "D", StatusCode: "DR", DetailedState: "Postponed"}, tex, bal, 0, 0),
+			},
+			expectedTables: []renderer.Table{
+				{
+					Title:   "Regular Season",
+					Columns: scheduleColumns,
+					Rows: [][]string{
+						{"Thu Mar 30", "@ BOS", "W 10-9", "1-0"},
+						{"Sat Apr 1", "@ BOS", "L 8-9", "1-1"},
+						{"Sun Apr 2", "@ BOS", "Top 5th 3-2", ""},
+						{"Mon Apr 3", "vs TEX", "8:05 PM", ""},
+						{"Tue Apr 4", "vs TEX", "Postponed", ""},
+					},
+				},
+			},
+		},
+		"should show games over before they are final and ties": {
+			games: []fetcher.ScheduledGame{
+				scheduled("R", time.Date(2023, 4, 6, 17, 5, 0, 0, time.UTC), gameOver, tex, bal, 1, 4),
+				scheduled("R", time.Date(2023, 4, 7, 17, 5, 0, 0, time.UTC), tied, tex, bal, 3, 3),
+				scheduled("R", time.Date(2023, 4, 8, 17, 5, 0, 0, time.UTC), final, tex, bal, 5, 2),
+			},
+			expectedTables: []renderer.Table{
+				{
+					Title:   "Regular Season",
+					Columns: scheduleColumns,
+					Rows: [][]string{
+						{"Thu Apr 6", "vs TEX", "W 4-1", "1-0"},
+						{"Fri Apr 7", "vs TEX", "T 3-3", "1-0"},
+						{"Sat Apr 8", "vs TEX", "L 2-5", "1-1"},
+					},
+				},
+			},
+		},
+		"should keep the record of the postseason apart": {
+			games: []fetcher.ScheduledGame{
+				scheduled("R", time.Date(2023, 10, 1, 17, 5, 0, 0, time.UTC), final, bal, bos, 5, 3),
+				scheduled("D", time.Date(2023, 10, 7, 17, 3, 0, 0, time.UTC), final, tex, bal, 3, 2),
+			},
+			expectedTables: []renderer.Table{
+				{
+					Title:   "Regular Season",
+					Columns: scheduleColumns,
+					Rows:    [][]string{{"Sun Oct 1", "@ BOS", "W 5-3", "1-0"}},
+				},
+				{
+					Title:   "Postseason",
+					Columns: scheduleColumns,
+					Rows:    [][]string{{"Sat Oct 7", "vs TEX", "L 2-3", "0-1"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tables := NewSchedule(eastern, bal, 2023, tc.games)
+
+			assert.Equal(t, "2023 Baltimore Orioles Schedule", tables.Heading)
+			assert.Equal(t, tc.expectedTables, tables.Tables)
+		})
+	}
+}
@@ -0,0 +1,2 @@
+ALTER TABLE TEAM DROP COLUMN IF EXISTS ALT_COLOR;
+ALTER TABLE TEAM DROP COLUMN IF EXISTS COLOR;
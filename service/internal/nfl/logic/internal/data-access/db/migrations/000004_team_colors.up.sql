@@ -0,0 +1,2 @@
+ALTER TABLE TEAM ADD COLUMN COLOR TEXT NOT NULL DEFAULT '';
+ALTER TABLE TEAM ADD COLUMN ALT_COLOR TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,6 @@
+ALTER TABLE GAME DROP COLUMN IF EXISTS HOME_RECORD;
+ALTER TABLE GAME DROP COLUMN IF EXISTS AWAY_RECORD;
+ALTER TABLE GAME DROP COLUMN IF EXISTS HOME_TIMEOUTS;
+ALTER TABLE GAME DROP COLUMN IF EXISTS AWAY_TIMEOUTS;
+ALTER TABLE GAME DROP COLUMN IF EXISTS DOWN_DISTANCE;
+ALTER TABLE GAME DROP COLUMN IF EXISTS POSSESSION;
@@ -0,0 +1,6 @@
+ALTER TABLE GAME ADD COLUMN POSSESSION TEXT NOT NULL DEFAULT '';
+ALTER TABLE GAME ADD COLUMN DOWN_DISTANCE TEXT NOT NULL DEFAULT '';
+ALTER TABLE GAME ADD COLUMN AWAY_TIMEOUTS INT NOT NULL DEFAULT 0;
+ALTER TABLE GAME ADD COLUMN HOME_TIMEOUTS INT NOT NULL DEFAULT 0;
+ALTER TABLE GAME ADD COLUMN AWAY_RECORD TEXT NOT NULL DEFAULT '';
+ALTER TABLE GAME ADD COLUMN HOME_RECORD TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,24 @@
+CREATE TABLE SCORING_PLAY
+(
+    ID          TEXT PRIMARY KEY         NOT NULL,
+    GAME_ID     TEXT                     NOT NULL,
+    TEAM_ID     UUID                     NOT NULL,
+    SEQUENCE    INT                      NOT NULL,
+    QUARTER     TEXT                     NOT NULL,
+    CLOCK       TEXT                     NOT NULL,
+    TYPE        TEXT                     NOT NULL,
+    DESCRIPTION TEXT                     NOT NULL,
+    AWAY_SCORE  INT                      NOT NULL DEFAULT 0,
+    HOME_SCORE  INT                      NOT NULL DEFAULT 0,
+    CREATED_AT  timestamp with time zone NOT NULL DEFAULT NOW(),
+    UPDATED_AT  timestamp with time zone NOT NULL DEFAULT NOW(),
+    DELETED_AT  timestamp with time zone,
+
+    FOREIGN KEY (GAME_ID) REFERENCES GAME (ID),
+    FOREIGN KEY (TEAM_ID) REFERENCES TEAM (ID)
+);
+
+CREATE INDEX SCORING_PLAY_GAME_ID ON SCORING_PLAY (GAME_ID);
+
+CREATE TRIGGER update_scoring_play_created_at BEFORE INSERT ON scoring_play FOR EACH ROW EXECUTE PROCEDURE  insert_created_at_column();
+CREATE TRIGGER update_scoring_play_updated_at BEFORE UPDATE ON scoring_play FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
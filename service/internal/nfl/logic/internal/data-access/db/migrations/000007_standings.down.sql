@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS GAME_SEASON;
+ALTER TABLE GAME DROP COLUMN IF EXISTS SEASON_TYPE;
+ALTER TABLE GAME DROP COLUMN IF EXISTS SEASON;
+ALTER TABLE TEAM DROP COLUMN IF EXISTS DIVISION;
+ALTER TABLE TEAM DROP COLUMN IF EXISTS CONFERENCE;
@@ -0,0 +1,16 @@
+ALTER TABLE TEAM ADD COLUMN CONFERENCE TEXT NOT NULL DEFAULT '';
+ALTER TABLE TEAM ADD COLUMN DIVISION TEXT NOT NULL DEFAULT '';
+
+UPDATE TEAM SET CONFERENCE = 'AFC', DIVISION = 'East' WHERE ABBREVIATION IN ('BUF', 'MIA', 'NE', 'NYJ');
+UPDATE TEAM SET CONFERENCE = 'AFC', DIVISION = 'North' WHERE ABBREVIATION IN ('BAL', 'CIN', 'CLE', 'PIT');
+UPDATE TEAM SET CONFERENCE = 'AFC', DIVISION = 'South' WHERE ABBREVIATION IN ('HOU', 'IND', 'JAX', 'TEN');
+UPDATE TEAM SET CONFERENCE = 'AFC', DIVISION = 'West' WHERE ABBREVIATION IN ('DEN', 'KC', 'LAC', 'LV');
+UPDATE TEAM SET CONFERENCE = 'NFC', DIVISION = 'East' WHERE ABBREVIATION IN ('DAL', 'NYG', 'PHI', 'WSH');
+UPDATE TEAM SET CONFERENCE = 'NFC', DIVISION = 'North' WHERE ABBREVIATION IN ('CHI', 'DET', 'GB', 'MIN');
+UPDATE TEAM SET CONFERENCE = 'NFC', DIVISION = 'South' WHERE ABBREVIATION IN ('ATL', 'CAR', 'NO', 'TB');
+UPDATE TEAM SET CONFERENCE = 'NFC', DIVISION = 'West' WHERE ABBREVIATION IN ('ARI', 'LAR', 'SEA', 'SF');
+
+ALTER TABLE GAME ADD COLUMN SEASON INT NOT NULL DEFAULT 0;
+ALTER TABLE GAME ADD COLUMN SEASON_TYPE INT NOT NULL DEFAULT 0;
+
+CREATE INDEX GAME_SEASON ON GAME (SEASON, SEASON_TYPE);
@@ -0,0 +1 @@
+ALTER TABLE GAME DROP COLUMN IF EXISTS SCHEDULE_SEQUENCE;
@@ -0,0 +1,2 @@
+-- SCHEDULE_SEQUENCE counts kickoff time changes so calendar events can be revised.
+ALTER TABLE GAME ADD COLUMN SCHEDULE_SEQUENCE INT NOT NULL DEFAULT 0;
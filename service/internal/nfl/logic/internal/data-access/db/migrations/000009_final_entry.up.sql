@@ -0,0 +1,16 @@
+-- FINAL_ENTRY holds an entry of the final score feed for each game, written when the game is finalized.
+CREATE TABLE FINAL_ENTRY
+(
+    GAME_ID    TEXT PRIMARY KEY         NOT NULL,
+    TITLE      TEXT                     NOT NULL,
+    LINE_SCORE TEXT                     NOT NULL,
+    CREATED_AT timestamp with time zone NOT NULL DEFAULT NOW(),
+    UPDATED_AT timestamp with time zone NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (GAME_ID) REFERENCES GAME (ID)
+);
+
+CREATE INDEX FINAL_ENTRY_CREATED_AT ON FINAL_ENTRY (CREATED_AT);
+
+CREATE TRIGGER update_final_entry_created_at BEFORE INSERT ON final_entry FOR EACH ROW EXECUTE PROCEDURE  insert_created_at_column();
+CREATE TRIGGER update_final_entry_updated_at BEFORE UPDATE ON final_entry FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
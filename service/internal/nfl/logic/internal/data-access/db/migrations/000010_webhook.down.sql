@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS WEBHOOK_DEAD_LETTER;
+DROP TABLE IF EXISTS WEBHOOK;
@@ -0,0 +1,33 @@
+-- WEBHOOK holds the registered webhooks. Empty filters match every sport, team or event type.
+CREATE TABLE WEBHOOK
+(
+    ID          UUID                              DEFAULT uuid_generate_v4() PRIMARY KEY,
+    URL         TEXT                     NOT NULL,
+    SECRET      TEXT                     NOT NULL,
+    SPORTS      TEXT[]                   NOT NULL DEFAULT '{}',
+    TEAMS       TEXT[]                   NOT NULL DEFAULT '{}',
+    EVENT_TYPES TEXT[]                   NOT NULL DEFAULT '{}',
+    CREATED_AT  timestamp with time zone NOT NULL DEFAULT NOW(),
+    UPDATED_AT  timestamp with time zone NOT NULL DEFAULT NOW(),
+    DELETED_AT  timestamp with time zone
+);
+
+-- WEBHOOK_DEAD_LETTER holds deliveries that failed every attempt so they can be looked into and sent again.
+CREATE TABLE WEBHOOK_DEAD_LETTER
+(
+    ID         UUID                              DEFAULT uuid_generate_v4() PRIMARY KEY,
+    WEBHOOK_ID UUID                     NOT NULL,
+    EVENT_ID   TEXT                     NOT NULL,
+    EVENT_TYPE TEXT                     NOT NULL,
+    PAYLOAD    JSONB                    NOT NULL,
+    ATTEMPTS   INT                      NOT NULL,
+    LAST_ERROR TEXT                     NOT NULL,
+    CREATED_AT timestamp with time zone NOT NULL DEFAULT NOW(),
+
+    FOREIGN KEY (WEBHOOK_ID) REFERENCES WEBHOOK (ID)
+);
+
+CREATE INDEX WEBHOOK_DEAD_LETTER_WEBHOOK_ID ON WEBHOOK_DEAD_LETTER (WEBHOOK_ID);
+
+CREATE TRIGGER update_webhook_created_at BEFORE INSERT ON webhook FOR EACH ROW EXECUTE PROCEDURE  insert_created_at_column();
+CREATE TRIGGER update_webhook_updated_at BEFORE UPDATE ON webhook FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
@@ -0,0 +1,16 @@
+-- DIGEST_SUBSCRIPTION holds who is emailed the finals of their teams each morning. UNSUBSCRIBE_TOKEN is the
+-- secret in the unsubscribe link of every digest.
+CREATE TABLE DIGEST_SUBSCRIPTION
+(
+    ID                UUID                              DEFAULT uuid_generate_v4() PRIMARY KEY,
+    EMAIL             TEXT                     NOT NULL,
+    NFL_TEAMS         TEXT[]                   NOT NULL DEFAULT '{}',
+    MLB_TEAMS         TEXT[]                   NOT NULL DEFAULT '{}',
+    UNSUBSCRIBE_TOKEN TEXT                     NOT NULL UNIQUE,
+    CREATED_AT        timestamp with time zone NOT NULL DEFAULT NOW(),
+    UPDATED_AT        timestamp with time zone NOT NULL DEFAULT NOW(),
+    DELETED_AT        timestamp with time zone
+);
+
+CREATE TRIGGER update_digest_subscription_created_at BEFORE INSERT ON digest_subscription FOR EACH ROW EXECUTE PROCEDURE  insert_created_at_column();
+CREATE TRIGGER update_digest_subscription_updated_at BEFORE UPDATE ON digest_subscription FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
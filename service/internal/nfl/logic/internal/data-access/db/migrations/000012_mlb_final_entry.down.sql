@@ -0,0 +1 @@
+DROP TABLE IF EXISTS MLB_FINAL_ENTRY;
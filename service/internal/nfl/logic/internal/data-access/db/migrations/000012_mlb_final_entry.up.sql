@@ -0,0 +1,15 @@
+-- MLB_FINAL_ENTRY holds an entry of the MLB final score feed for each game, written when the server sees the game
+-- become final. MLB games are not stored, so entries are keyed by game pk.
+CREATE TABLE MLB_FINAL_ENTRY
+(
+    GAME_PK    INTEGER PRIMARY KEY      NOT NULL,
+    TITLE      TEXT                     NOT NULL,
+    LINE_SCORE TEXT                     NOT NULL,
+    CREATED_AT timestamp with time zone NOT NULL DEFAULT NOW(),
+    UPDATED_AT timestamp with time zone NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX MLB_FINAL_ENTRY_CREATED_AT ON MLB_FINAL_ENTRY (CREATED_AT);
+
+CREATE TRIGGER update_mlb_final_entry_created_at BEFORE INSERT ON mlb_final_entry FOR EACH ROW EXECUTE PROCEDURE  insert_created_at_column();
+CREATE TRIGGER update_mlb_final_entry_updated_at BEFORE UPDATE ON mlb_final_entry FOR EACH ROW EXECUTE PROCEDURE  update_updated_at_column();
@@ -0,0 +1,7 @@
+-- SYNC_STATUS holds when the scheduler last synchronized each kind of data, so the server can tell its scores are
+-- no longer being updated.
+CREATE TABLE SYNC_STATUS
+(
+    NAME      TEXT PRIMARY KEY         NOT NULL,
+    SYNCED_AT timestamp with time zone NOT NULL DEFAULT NOW()
+);
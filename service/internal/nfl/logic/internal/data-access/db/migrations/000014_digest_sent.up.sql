@@ -0,0 +1,9 @@
+-- DIGEST_SENT logs which subscriptions were emailed the digest of each day, so a rerun of the digest after some
+-- could not be sent only emails the rest.
+CREATE TABLE DIGEST_SENT
+(
+    SUBSCRIPTION_ID UUID                     NOT NULL REFERENCES DIGEST_SUBSCRIPTION (ID),
+    DIGEST_DATE     DATE                     NOT NULL,
+    SENT_AT         timestamp with time zone NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (SUBSCRIPTION_ID, DIGEST_DATE)
+);
@@ -0,0 +1 @@
+-- The backfilled seasons are kept, they are the seasons the scheduler stores for new games.
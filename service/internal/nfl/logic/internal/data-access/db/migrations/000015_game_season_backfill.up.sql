@@ -0,0 +1,29 @@
+-- Games stored before 000007 have no season. Their season is the year of the opener they follow, the Thursday
+-- after Labor Day, and their type is counted from it: preseason before it and postseason after the last week of
+-- the regular season, 17 weeks until 2021 and 18 since.
+WITH GAME_DAY AS (
+    SELECT ID,
+           (GAME_TIME AT TIME ZONE 'America/New_York')::DATE AS DAY
+    FROM GAME
+    WHERE SEASON = 0 AND GAME_TIME IS NOT NULL
+), GAME_SEASON AS (
+    SELECT ID,
+           DAY,
+           (EXTRACT(YEAR FROM DAY) - CASE WHEN EXTRACT(MONTH FROM DAY) < 3 THEN 1 ELSE 0 END)::INT AS SEASON
+    FROM GAME_DAY
+), GAME_OPENER AS (
+    SELECT ID,
+           DAY,
+           SEASON,
+           MAKE_DATE(SEASON, 9, 1) + (8 - EXTRACT(ISODOW FROM MAKE_DATE(SEASON, 9, 1))::INT) % 7 + 3 AS OPENER
+    FROM GAME_SEASON
+)
+UPDATE GAME G
+SET SEASON      = O.SEASON,
+    SEASON_TYPE = CASE
+                      WHEN O.DAY < O.OPENER THEN 1
+                      WHEN (O.DAY - O.OPENER) / 7 + 1 > CASE WHEN O.SEASON >= 2021 THEN 18 ELSE 17 END THEN 3
+                      ELSE 2
+                  END
+FROM GAME_OPENER O
+WHERE G.ID = O.ID;
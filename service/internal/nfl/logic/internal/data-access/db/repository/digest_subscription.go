@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+var _ DigestSubscriptionDAO = &DigestSubscriptionDAOImpl{}
+
+type DigestSubscriptionDAOImpl struct {
+	logger zerolog.Logger
+	db     *sqlx.DB
+}
+
+func NewDigestSubscriptionDAOImpl(logger zerolog.Logger, db *sqlx.DB) *DigestSubscriptionDAOImpl {
+	return &DigestSubscriptionDAOImpl{
+		logger: logger.With().Str("repo", "DigestSubscriptionDAO").Logger(),
+		db:     db,
+	}
+}
+
+const insertDigestSubscriptionStmt = "insert into digest_subscription (email, nfl_teams, mlb_teams, unsubscribe_token) values ($1, $2, $3, $4) returning id"
+
+// InsertDigestSubscription subscribes to the digest and returns the id of the subscription.
+func (d *DigestSubscriptionDAOImpl) InsertDigestSubscription(subscription DigestSubscription) (uuid.UUID, error) {
+	logger := d.logger.With().Str("method", "InsertDigestSubscription").Logger()
+	logger.Info().Msg("inserting digest subscription")
+
+	var id uuid.UUID
+	err := d.db.QueryRowx(insertDigestSubscriptionStmt, subscription.Email, subscription.NFLTeams, subscription.MLBTeams,
+		subscription.UnsubscribeToken).Scan(&id)
+	if err != nil {
+		return uuid.Nil, errors.Join(err, ErrInsertDigestSubscription)
+	}
+
+	return id, nil
+}
+
+const getDigestSubscriptionsStmt = "select id, email, nfl_teams, mlb_teams, unsubscribe_token, created_at, updated_at, deleted_at from digest_subscription where deleted_at is null order by created_at"
+
+// GetDigestSubscriptions returns the current subscriptions, oldest first.
+func (d *DigestSubscriptionDAOImpl) GetDigestSubscriptions() ([]DigestSubscription, error) {
+	logger := d.logger.With().Str("method", "GetDigestSubscriptions").Logger()
+
+	var subscriptions []DigestSubscription
+	err := d.db.Select(&subscriptions, getDigestSubscriptionsStmt)
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return subscriptions, nil
+}
+
+const deleteDigestSubscriptionStmt = "UPDATE DIGEST_SUBSCRIPTION SET deleted_at = now() WHERE unsubscribe_token = $1 AND deleted_at is null"
+
+// DeleteDigestSubscription unsubscribes the subscription with unsubscribeToken. ErrNoDigestSubscription is
+// returned when there is no such subscription, like when the link was already followed.
+func (d *DigestSubscriptionDAOImpl) DeleteDigestSubscription(unsubscribeToken string) error {
+	logger := d.logger.With().Str("method", "DeleteDigestSubscription").Logger()
+	logger.Info().Msg("deleting digest subscription")
+
+	result, err := d.db.Exec(deleteDigestSubscriptionStmt, unsubscribeToken)
+	if err != nil {
+		return errors.Join(err, ErrDeleteDigestSubscription)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(err, ErrDeleteDigestSubscription)
+	}
+	if deleted == 0 {
+		return ErrNoDigestSubscription
+	}
+
+	return nil
+}
+
+// digestDateLayout is how the day of a digest is stored, so it is the same day whatever the location of its date.
+const digestDateLayout = "2006-01-02"
+
+// language=sql
+const insertDigestSentStmt = `insert into digest_sent (subscription_id, digest_date) values ($1, $2)
+on conflict (subscription_id, digest_date) do nothing`
+
+// InsertDigestSent logs that the subscription was emailed the digest of the day of date.
+func (d *DigestSubscriptionDAOImpl) InsertDigestSent(subscriptionID uuid.UUID, date time.Time) error {
+	logger := d.logger.With().Str("method", "InsertDigestSent").Logger()
+	logger.Debug().Msgf("inserting digest sent of %s", subscriptionID)
+
+	_, err := d.db.Exec(insertDigestSentStmt, subscriptionID, date.Format(digestDateLayout))
+	if err != nil {
+		return errors.Join(err, ErrInsertDigestSent)
+	}
+
+	return nil
+}
+
+const getDigestSentIDsStmt = "select subscription_id from digest_sent where digest_date = $1"
+
+// GetDigestSentIDs returns the ids of the subscriptions already emailed the digest of the day of date.
+func (d *DigestSubscriptionDAOImpl) GetDigestSentIDs(date time.Time) ([]uuid.UUID, error) {
+	logger := d.logger.With().Str("method", "GetDigestSentIDs").Logger()
+
+	var ids []uuid.UUID
+	err := d.db.Select(&ids, getDigestSentIDsStmt, date.Format(digestDateLayout))
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return ids, nil
+}
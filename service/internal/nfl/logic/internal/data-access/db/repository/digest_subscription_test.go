@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var testDigestSubscriptionID = uuid.MustParse("0b4f5c1e-7a51-4f0e-8c1a-2d9b6f3e4a70")
+
+func TestDigestSubscriptionDAOImpl_InsertDigestSubscription(t *testing.T) {
+	subscription := DigestSubscription{
+		Email:            "fan@example.com",
+		NFLTeams:         pq.StringArray{"BUF"},
+		MLBTeams:         pq.StringArray{},
+		UnsubscribeToken: "t0ken",
+	}
+
+	testCases := map[string]struct {
+		mockDB      func(sqlMock sqlmock.Sqlmock)
+		expectedID  uuid.UUID
+		expectedErr error
+	}{
+		"should return the id of the subscription": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(insertDigestSubscriptionStmt)).
+					WithArgs("fan@example.com", pq.StringArray{"BUF"}, pq.StringArray{}, "t0ken").
+					WillReturnRows(sqlMock.NewRows([]string{"id"}).AddRow(testDigestSubscriptionID.String()))
+			},
+			expectedID: testDigestSubscriptionID,
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(insertDigestSubscriptionStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrInsertDigestSubscription,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &DigestSubscriptionDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			id, err := dao.InsertDigestSubscription(subscription)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedID, id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDigestSubscriptionDAOImpl_GetDigestSubscriptions(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "email", "nfl_teams", "mlb_teams", "unsubscribe_token", "created_at", "updated_at", "deleted_at"}
+
+	testCases := map[string]struct {
+		mockDB                func(sqlMock sqlmock.Sqlmock)
+		expectedSubscriptions []DigestSubscription
+		expectedErr           error
+	}{
+		"should get subscriptions with their teams": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns).
+					AddRow(testDigestSubscriptionID.String(), "fan@example.com", "{BUF,MIA}", "{NYY}", "t0ken", now, now, nil)
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getDigestSubscriptionsStmt)).WillReturnRows(rows)
+			},
+			expectedSubscriptions: []DigestSubscription{
+				{ID: testDigestSubscriptionID, Email: "fan@example.com", NFLTeams: pq.StringArray{"BUF", "MIA"},
+					MLBTeams: pq.StringArray{"NYY"}, UnsubscribeToken: "t0ken", CreatedAt: now, UpdatedAt: now},
+			},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getDigestSubscriptionsStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &DigestSubscriptionDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			subscriptions, err := dao.GetDigestSubscriptions()
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedSubscriptions, subscriptions)
+		})
+	}
+}
+
+func TestDigestSubscriptionDAOImpl_DeleteDigestSubscription(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when a subscription is deleted": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteDigestSubscriptionStmt)).WithArgs("t0ken").WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"should return ErrNoDigestSubscription when there is no subscription": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteDigestSubscriptionStmt)).WithArgs("t0ken").WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: ErrNoDigestSubscription,
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteDigestSubscriptionStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrDeleteDigestSubscription,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &DigestSubscriptionDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.DeleteDigestSubscription("t0ken")
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDigestSubscriptionDAOImpl_InsertDigestSent(t *testing.T) {
+	date := time.Date(2023, 9, 11, 23, 30, 0, 0, time.FixedZone("EDT", -4*60*60))
+
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should log the day of date": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(insertDigestSentStmt)).WithArgs(testDigestSubscriptionID, "2023-09-11").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(insertDigestSentStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrInsertDigestSent,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &DigestSubscriptionDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.InsertDigestSent(testDigestSubscriptionID, date)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestDigestSubscriptionDAOImpl_GetDigestSentIDs(t *testing.T) {
+	date := time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockDB      func(sqlMock sqlmock.Sqlmock)
+		expectedIDs []uuid.UUID
+		expectedErr error
+	}{
+		"should get the ids sent the day of date": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows([]string{"subscription_id"}).AddRow(testDigestSubscriptionID.String())
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getDigestSentIDsStmt)).WithArgs("2023-09-11").WillReturnRows(rows)
+			},
+			expectedIDs: []uuid.UUID{testDigestSubscriptionID},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getDigestSentIDsStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &DigestSubscriptionDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			ids, err := dao.GetDigestSentIDs(date)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedIDs, ids)
+		})
+	}
+}
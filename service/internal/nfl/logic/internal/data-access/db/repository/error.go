@@ -7,6 +7,7 @@ var (
 	ErrSqlError        = errors.New("sql database error")
 	ErrInsertGame      = errors.New("error inserting game into database")
 	ErrUpdateGameClock = errors.New("error updating game clock in database")
+	ErrUpdateGame      = errors.New("error updating game in database")
 	ErrNoGames         = errors.New("no games returned from database")
 	ErrNoGame          = errors.New("no game returned from database")
 
@@ -14,4 +15,22 @@ var (
 
 	ErrInsertQuarterScore = errors.New("error inserting quarter score into database")
 	ErrUpdateQuarterScore = errors.New("error updating quarter score into database")
+
+	ErrUpsertScoringPlay  = errors.New("error upserting scoring play into database")
+	ErrDeleteScoringPlays = errors.New("error deleting scoring plays from database")
+
+	ErrUpsertFinalEntry = errors.New("error upserting final entry into database")
+
+	ErrNoWebhook               = errors.New("no webhook returned from database")
+	ErrInsertWebhook           = errors.New("error inserting webhook into database")
+	ErrDeleteWebhook           = errors.New("error deleting webhook from database")
+	ErrInsertWebhookDeadLetter = errors.New("error inserting webhook dead letter into database")
+
+	ErrNoSyncStatus     = errors.New("no sync status returned from database")
+	ErrUpdateSyncStatus = errors.New("error updating sync status in database")
+
+	ErrNoDigestSubscription     = errors.New("no digest subscription returned from database")
+	ErrInsertDigestSubscription = errors.New("error inserting digest subscription into database")
+	ErrDeleteDigestSubscription = errors.New("error deleting digest subscription from database")
+	ErrInsertDigestSent         = errors.New("error inserting digest sent into database")
 )
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+var _ FinalEntryDAO = &FinalEntryDAOImpl{}
+
+type FinalEntryDAOImpl struct {
+	logger zerolog.Logger
+	db     *sqlx.DB
+}
+
+func NewFinalEntryDAOImpl(logger zerolog.Logger, db *sqlx.DB) *FinalEntryDAOImpl {
+	return &FinalEntryDAOImpl{
+		logger: logger.With().Str("repo", "FinalEntryDAO").Logger(),
+		db:     db,
+	}
+}
+
+// language=sql
+const upsertFinalEntryStmt = `insert into final_entry (game_id, title, line_score)
+values (:game_id, :title, :line_score)
+on conflict (game_id) do update set title = excluded.title, line_score = excluded.line_score
+where final_entry.title <> excluded.title or final_entry.line_score <> excluded.line_score;`
+
+// UpsertFinalEntry inserts the feed entry of a game or replaces it when a game is finalized again with a
+// different score. An entry that has not changed keeps its updated time so feed readers do not show it again.
+func (f *FinalEntryDAOImpl) UpsertFinalEntry(entry FinalEntry) error {
+	logger := f.logger.With().Str("method", "UpsertFinalEntry").Logger()
+	logger.Info().Msgf("upserting final entry: %+v", entry)
+
+	_, err := f.db.NamedExec(upsertFinalEntryStmt, &entry)
+	if err != nil {
+		return errors.Join(err, ErrUpsertFinalEntry)
+	}
+
+	return nil
+}
+
+const getFinalEntriesStmt = "select game_id, title, line_score, created_at, updated_at from final_entry order by created_at desc limit $1"
+
+// GetFinalEntries returns the limit most recent feed entries, newest first.
+func (f *FinalEntryDAOImpl) GetFinalEntries(limit int) ([]FinalEntry, error) {
+	logger := f.logger.With().Str("method", "GetFinalEntries").Logger()
+	logger.Info().Msgf("getting %d final entries", limit)
+
+	var entries []FinalEntry
+	err := f.db.Select(&entries, getFinalEntriesStmt, limit)
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return entries, nil
+}
+
+// language=sql
+const getGameFinalEntriesStmt = `SELECT
+    fe.game_id,
+    fe.title,
+    fe.line_score,
+    fe.created_at,
+    fe.updated_at,
+    g.game_time,
+    t_away.abbreviation AS away_team,
+    t_home.abbreviation AS home_team
+FROM
+    final_entry AS fe
+        INNER JOIN
+    game AS g ON fe.game_id = g.id
+        INNER JOIN
+    team AS t_away ON g.away_team = t_away.id
+        INNER JOIN
+    team AS t_home ON g.home_team = t_home.id
+WHERE g.deleted_at IS NULL AND g.game_time >= $1 AND g.game_time < $2
+ORDER BY g.game_time, g.id`
+
+// GetGameFinalEntries returns the feed entries of the games kicking off from start until end, ordered by kickoff.
+func (f *FinalEntryDAOImpl) GetGameFinalEntries(start time.Time, end time.Time) ([]GameFinalEntry, error) {
+	logger := f.logger.With().Str("method", "GetGameFinalEntries").Logger()
+	logger.Info().Msgf("getting final entries of games from %s to %s", start, end)
+
+	entries := make([]GameFinalEntry, 0)
+	err := f.db.Select(&entries, getGameFinalEntriesStmt, start, end)
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return entries, nil
+}
+
+// language=sql
+const upsertMLBFinalEntryStmt = `insert into mlb_final_entry (game_pk, title, line_score)
+values (:game_pk, :title, :line_score)
+on conflict (game_pk) do update set title = excluded.title, line_score = excluded.line_score
+where mlb_final_entry.title <> excluded.title or mlb_final_entry.line_score <> excluded.line_score;`
+
+// UpsertMLBFinalEntry inserts the feed entry of an MLB game or replaces it when its score has changed, keeping the
+// updated time of an entry that has not.
+func (f *FinalEntryDAOImpl) UpsertMLBFinalEntry(entry MLBFinalEntry) error {
+	logger := f.logger.With().Str("method", "UpsertMLBFinalEntry").Logger()
+	logger.Info().Msgf("upserting mlb final entry: %+v", entry)
+
+	_, err := f.db.NamedExec(upsertMLBFinalEntryStmt, &entry)
+	if err != nil {
+		return errors.Join(err, ErrUpsertFinalEntry)
+	}
+
+	return nil
+}
+
+const getMLBFinalEntriesStmt = "select game_pk, title, line_score, created_at, updated_at from mlb_final_entry order by created_at desc limit $1"
+
+// GetMLBFinalEntries returns the limit most recent MLB feed entries, newest first.
+func (f *FinalEntryDAOImpl) GetMLBFinalEntries(limit int) ([]MLBFinalEntry, error) {
+	logger := f.logger.With().Str("method", "GetMLBFinalEntries").Logger()
+	logger.Info().Msgf("getting %d mlb final entries", limit)
+
+	var entries []MLBFinalEntry
+	err := f.db.Select(&entries, getMLBFinalEntriesStmt, limit)
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return entries, nil
+}
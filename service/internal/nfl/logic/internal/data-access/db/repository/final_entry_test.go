@@ -0,0 +1,246 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestFinalEntryDAOImpl_UpsertFinalEntry(t *testing.T) {
+	entry := FinalEntry{
+		GameID:    "401547356",
+		Title:     "Final/OT: BUF 16 @ NYJ 22",
+		LineScore: "* Q    1  2  3  4  5    T *",
+	}
+
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into final_entry")).
+					WithArgs("401547356", "Final/OT: BUF 16 @ NYJ 22", "* Q    1  2  3  4  5    T *").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into final_entry")).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpsertFinalEntry,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &FinalEntryDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpsertFinalEntry(entry)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFinalEntryDAOImpl_GetFinalEntries(t *testing.T) {
+	now := time.Now()
+	columns := []string{"game_id", "title", "line_score", "created_at", "updated_at"}
+
+	testCases := map[string]struct {
+		mockDB          func(sqlMock sqlmock.Sqlmock)
+		expectedEntries []FinalEntry
+		expectedErr     error
+	}{
+		"should get entries newest first": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns).
+					AddRow("401547356", "Final/OT: BUF 16 @ NYJ 22", "BUF", now, now).
+					AddRow("401547355", "Final: LAR 30 @ SEA 13", "LAR", now.Add(-time.Hour), now.Add(-time.Hour))
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getFinalEntriesStmt)).WithArgs(50).WillReturnRows(rows)
+			},
+			expectedEntries: []FinalEntry{
+				{GameID: "401547356", Title: "Final/OT: BUF 16 @ NYJ 22", LineScore: "BUF", CreatedAt: now, UpdatedAt: now},
+				{GameID: "401547355", Title: "Final: LAR 30 @ SEA 13", LineScore: "LAR", CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-time.Hour)},
+			},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getFinalEntriesStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &FinalEntryDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			entries, err := dao.GetFinalEntries(50)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedEntries, entries)
+		})
+	}
+}
+
+func TestFinalEntryDAOImpl_GetGameFinalEntries(t *testing.T) {
+	now := time.Now()
+	start := time.Date(2023, 9, 11, 4, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 1)
+	columns := []string{"game_id", "title", "line_score", "created_at", "updated_at", "game_time", "away_team", "home_team"}
+
+	testCases := map[string]struct {
+		mockDB          func(sqlMock sqlmock.Sqlmock)
+		expectedEntries []GameFinalEntry
+		expectedErr     error
+	}{
+		"should get entries with their games": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns).
+					AddRow("401547356", "Final/OT: BUF 16 @ NYJ 22", "BUF", now, now, start.Add(20*time.Hour), "BUF", "NYJ")
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getGameFinalEntriesStmt)).WithArgs(start, end).WillReturnRows(rows)
+			},
+			expectedEntries: []GameFinalEntry{
+				{
+					FinalEntry: FinalEntry{GameID: "401547356", Title: "Final/OT: BUF 16 @ NYJ 22", LineScore: "BUF", CreatedAt: now, UpdatedAt: now},
+					GameTime:   start.Add(20 * time.Hour),
+					AwayTeam:   "BUF",
+					HomeTeam:   "NYJ",
+				},
+			},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getGameFinalEntriesStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &FinalEntryDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			entries, err := dao.GetGameFinalEntries(start, end)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedEntries, entries)
+		})
+	}
+}
+
+func TestFinalEntryDAOImpl_UpsertMLBFinalEntry(t *testing.T) {
+	entry := MLBFinalEntry{
+		GamePk:    717465,
+		Title:     "Final: NYY 2 @ BOS 1",
+		LineScore: "* 1  2  3    R  H  E *",
+	}
+
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into mlb_final_entry")).
+					WithArgs(717465, "Final: NYY 2 @ BOS 1", "* 1  2  3    R  H  E *").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into mlb_final_entry")).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpsertFinalEntry,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &FinalEntryDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpsertMLBFinalEntry(entry)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestFinalEntryDAOImpl_GetMLBFinalEntries(t *testing.T) {
+	now := time.Now()
+	columns := []string{"game_pk", "title", "line_score", "created_at", "updated_at"}
+
+	testCases := map[string]struct {
+		mockDB          func(sqlMock sqlmock.Sqlmock)
+		expectedEntries []MLBFinalEntry
+		expectedErr     error
+	}{
+		"should get entries newest first": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns).
+					AddRow(717465, "Final: NYY 2 @ BOS 1", "NYY", now, now).
+					AddRow(717464, "Final/10: TB 4 @ TOR 3", "TB", now.Add(-time.Hour), now.Add(-time.Hour))
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getMLBFinalEntriesStmt)).WithArgs(50).WillReturnRows(rows)
+			},
+			expectedEntries: []MLBFinalEntry{
+				{GamePk: 717465, Title: "Final: NYY 2 @ BOS 1", LineScore: "NYY", CreatedAt: now, UpdatedAt: now},
+				{GamePk: 717464, Title: "Final/10: TB 4 @ TOR 3", LineScore: "TB", CreatedAt: now.Add(-time.Hour), UpdatedAt: now.Add(-time.Hour)},
+			},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getMLBFinalEntriesStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &FinalEntryDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			entries, err := dao.GetMLBFinalEntries(50)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedEntries, entries)
+		})
+	}
+}
@@ -21,8 +21,8 @@ func NewGameDAOImpl(logger zerolog.Logger, db *sqlx.DB) *GameDAOImpl {
 	}
 }
 
-const insertGameStmt = `insert into game (id, game_time, quarter, game_clock, away_team, home_team)
-values (:id, :game_time, :quarter, :game_clock, :away_team, :home_team);`
+const insertGameStmt = `insert into game (id, game_time, quarter, game_clock, away_team, home_team, season, season_type)
+values (:id, :game_time, :quarter, :game_clock, :away_team, :home_team, :season, :season_type);`
 
 func (g *GameDAOImpl) InsertGame(game Game) error {
 	logger := g.logger.With().Str("method", "InsertGame").Logger()
@@ -68,6 +68,10 @@ const GetGamesWithTeamAbvStmt = `SELECT
     g.id,
     t_away.abbreviation AS away_team,
     t_home.abbreviation AS home_team,
+    t_away.color AS away_team_color,
+    t_away.alt_color AS away_team_alt_color,
+    t_home.color AS home_team_color,
+    t_home.alt_color AS home_team_alt_color,
     g.game_time, 
     g.game_clock,
     g.quarter
@@ -104,7 +108,7 @@ func (g *GameDAOImpl) GetGamesWithTeamAbv(start time.Time, end *time.Time) ([]Ga
 	return *games, nil
 }
 
-const getGameStmt = "select id, game_time, quarter, game_clock, away_team, home_team, created_at, updated_at, deleted_at from game where id=$1 and deleted_at is null;"
+const getGameStmt = "select id, game_time, quarter, game_clock, away_team, home_team, season, season_type, created_at, updated_at, deleted_at from game where id=$1 and deleted_at is null;"
 
 func (g *GameDAOImpl) GetGame(gameID string) (Game, error) {
 	logger := g.logger.With().Str("method", "GetGame").Logger()
@@ -125,6 +129,51 @@ func (g *GameDAOImpl) GetGame(gameID string) (Game, error) {
 	return *game, nil
 }
 
+const getGameWithTeamAbvStmt = `SELECT
+    g.id,
+    t_away.abbreviation AS away_team,
+    t_home.abbreviation AS home_team,
+    t_away.color AS away_team_color,
+    t_away.alt_color AS away_team_alt_color,
+    t_home.color AS home_team_color,
+    t_home.alt_color AS home_team_alt_color,
+    g.game_time,
+    g.game_clock,
+    g.quarter,
+    g.possession,
+    g.down_distance,
+    g.away_timeouts,
+    g.home_timeouts,
+    g.away_record,
+    g.home_record
+FROM
+    game AS g
+        INNER JOIN
+    team AS t_away ON g.away_team = t_away.id
+        INNER JOIN
+    team AS t_home ON g.home_team = t_home.id where g.id = $1 and g.deleted_at is null`
+
+// GetGameWithTeamAbv returns the game for gameID with team abbreviations, colors, records and the situation
+// of play.
+func (g *GameDAOImpl) GetGameWithTeamAbv(gameID string) (Game, error) {
+	logger := g.logger.With().Str("method", "GetGameWithTeamAbv").Logger()
+	logger.Info().Msgf("getting game for gameID %s", gameID)
+
+	var game = &Game{}
+	err := g.db.Get(game, getGameWithTeamAbvStmt, gameID)
+	if err != nil {
+		switch err {
+		case sql.ErrNoRows:
+			logger.Info().Msgf("No game for %s, %s", gameID, err)
+			return Game{}, ErrNoGame
+		default:
+			return Game{}, errors.Join(err, ErrSqlError)
+		}
+	}
+
+	return *game, nil
+}
+
 const updateQuarterGameClock = "UPDATE GAME set game_clock = $1, quarter = $2 where id = $3 and deleted_at is null"
 
 func (g *GameDAOImpl) UpdateQuarterGameClock(gameID string, quarter string, gameClock string) error {
@@ -155,8 +204,147 @@ func (g *GameDAOImpl) UpdateGameClock(gameID string, gameClock string) error {
 	return nil
 }
 
-const updateGameTimeStmt = "UPDATE GAME SET game_time=$1 WHERE id=$2"
+const updateGameSituationStmt = `UPDATE GAME set possession = $1, down_distance = $2, away_timeouts = $3, home_timeouts = $4
+where id = $5 and deleted_at is null`
+
+func (g *GameDAOImpl) UpdateGameSituation(gameID string, situation GameSituation) error {
+	logger := g.logger.With().Str("method", "UpdateGameSituation").Logger()
+	logger.Info().Msgf("updating situation of game %s", gameID)
+
+	_, err := g.db.Exec(updateGameSituationStmt, situation.Possession, situation.DownDistance,
+		situation.AwayTimeouts, situation.HomeTimeouts, gameID)
+	if err != nil {
+		logger.Info().Msgf("error updating game situation %s, %s", gameID, err)
+		return errors.Join(err, ErrUpdateGame)
+	}
+
+	return nil
+}
+
+const updateGameRecordsStmt = "UPDATE GAME set away_record = $1, home_record = $2 where id = $3 and deleted_at is null"
+
+func (g *GameDAOImpl) UpdateGameRecords(gameID string, awayRecord string, homeRecord string) error {
+	logger := g.logger.With().Str("method", "UpdateGameRecords").Logger()
+	logger.Info().Msgf("updating records of game %s", gameID)
+
+	_, err := g.db.Exec(updateGameRecordsStmt, awayRecord, homeRecord, gameID)
+	if err != nil {
+		logger.Info().Msgf("error updating game records %s, %s", gameID, err)
+		return errors.Join(err, ErrUpdateGame)
+	}
+
+	return nil
+}
+
+const updateGameSeasonStmt = "UPDATE GAME set season = $1, season_type = $2 where id = $3 and deleted_at is null"
+
+// UpdateGameSeason sets the season year and season type, preseason, regular season or postseason, of a game.
+func (g *GameDAOImpl) UpdateGameSeason(gameID string, season int, seasonType int) error {
+	logger := g.logger.With().Str("method", "UpdateGameSeason").Logger()
+	logger.Info().Msgf("updating season of game %s to %d type %d", gameID, season, seasonType)
+
+	_, err := g.db.Exec(updateGameSeasonStmt, season, seasonType, gameID)
+	if err != nil {
+		logger.Info().Msgf("error updating game season %s, %s", gameID, err)
+		return errors.Join(err, ErrUpdateGame)
+	}
+
+	return nil
+}
+
+// GameChangesChannel is the channel the id of a game is notified on when the scheduler sees it change.
+const GameChangesChannel = "game_changes"
+
+const notifyGameChangeStmt = "SELECT pg_notify($1, $2)"
+
+// NotifyGameChange notifies GameChangesChannel that a game has changed, for servers listening for changes.
+func (g *GameDAOImpl) NotifyGameChange(gameID string) error {
+	logger := g.logger.With().Str("method", "NotifyGameChange").Logger()
+	logger.Debug().Msgf("notifying change of game %s", gameID)
+
+	_, err := g.db.Exec(notifyGameChangeStmt, GameChangesChannel, gameID)
+	if err != nil {
+		return errors.Join(err, ErrSqlError)
+	}
+
+	return nil
+}
+
+// language=sql
+const getSeasonResultsStmt = `SELECT
+    g.id,
+    g.game_time,
+    t_away.abbreviation AS away_team,
+    t_home.abbreviation AS home_team,
+    COALESCE(SUM(gqs.score) FILTER (WHERE gqs.team_id = g.away_team), 0) AS away_score,
+    COALESCE(SUM(gqs.score) FILTER (WHERE gqs.team_id = g.home_team), 0) AS home_score
+FROM
+    game AS g
+        INNER JOIN
+    team AS t_away ON g.away_team = t_away.id
+        INNER JOIN
+    team AS t_home ON g.home_team = t_home.id
+        LEFT JOIN
+    game_quarter_score AS gqs ON gqs.game_id = g.id AND gqs.deleted_at IS NULL
+WHERE g.deleted_at IS NULL AND g.quarter = 'F' AND g.season = $1 AND g.season_type = $2
+GROUP BY g.id, g.game_time, t_away.abbreviation, t_home.abbreviation
+ORDER BY g.game_time, g.id`
+
+// GetSeasonResults returns the final scores of the games of a season type in season, ordered by game time.
+func (g *GameDAOImpl) GetSeasonResults(season int, seasonType int) ([]GameResult, error) {
+	logger := g.logger.With().Str("method", "GetSeasonResults").Logger()
+	logger.Info().Msgf("getting results of season %d type %d", season, seasonType)
+
+	results := make([]GameResult, 0)
+	if err := g.db.Select(&results, getSeasonResultsStmt, season, seasonType); err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return results, nil
+}
+
+// language=sql
+const getTeamScheduleStmt = `SELECT
+    g.id,
+    g.game_time,
+    g.quarter,
+    g.season_type,
+    g.schedule_sequence,
+    t_away.abbreviation AS away_team,
+    t_home.abbreviation AS home_team,
+    COALESCE(SUM(gqs.score) FILTER (WHERE gqs.team_id = g.away_team), 0) AS away_score,
+    COALESCE(SUM(gqs.score) FILTER (WHERE gqs.team_id = g.home_team), 0) AS home_score
+FROM
+    game AS g
+        INNER JOIN
+    team AS t_away ON g.away_team = t_away.id
+        INNER JOIN
+    team AS t_home ON g.home_team = t_home.id
+        LEFT JOIN
+    game_quarter_score AS gqs ON gqs.game_id = g.id AND gqs.deleted_at IS NULL
+WHERE g.deleted_at IS NULL AND g.season = $1 AND (t_away.abbreviation = $2 OR t_home.abbreviation = $2)
+GROUP BY g.id, g.game_time, g.quarter, g.season_type, g.schedule_sequence, t_away.abbreviation, t_home.abbreviation
+ORDER BY g.game_time, g.id`
+
+// GetTeamSchedule returns the games of the team with abbreviation in season with their scores so far, ordered by
+// game time.
+func (g *GameDAOImpl) GetTeamSchedule(abbreviation string, season int) ([]ScheduledGame, error) {
+	logger := g.logger.With().Str("method", "GetTeamSchedule").Logger()
+	logger.Info().Msgf("getting schedule of %s in season %d", abbreviation, season)
+
+	games := make([]ScheduledGame, 0)
+	if err := g.db.Select(&games, getTeamScheduleStmt, season, abbreviation); err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return games, nil
+}
+
+const updateGameTimeStmt = "UPDATE GAME SET game_time=$1, schedule_sequence=schedule_sequence+1 WHERE id=$2"
 
+// UpdateGameTime moves the kickoff of a game and counts the change in its schedule sequence.
 func (g *GameDAOImpl) UpdateGameTime(gameID string, gameTime time.Time) error {
 	logger := g.logger.With().Str("method", "UpdateGameTime").Logger()
 	logger.Info().Msgf("updating game %s with gameTime: %v", gameID, gameTime)
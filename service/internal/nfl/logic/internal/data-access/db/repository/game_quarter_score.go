@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/rs/zerolog"
 )
 
@@ -69,3 +70,25 @@ func (g *GameQuarterScoreDAOImpl) UpdateQuarterScore(score int, gameID string, t
 
 	return nil
 }
+
+// language=sql
+const getQuarterScoresForGamesStmt = `select gqs.game_id as id, t.abbreviation, gqs.quarter, gqs.score
+from game_quarter_score gqs
+         inner join team t on t.id = gqs.team_id
+where gqs.game_id = any($1) and gqs.deleted_at is null
+order by gqs.game_id, t.abbreviation, gqs.quarter`
+
+// GetQuarterScoresForGames returns the quarter scores of every game in gameIDs with one query, ordered by game,
+// team and quarter.
+func (g *GameQuarterScoreDAOImpl) GetQuarterScoresForGames(gameIDs []string) ([]GameTeamQuarterScore, error) {
+	logger := g.logger.With().Str("method", "GetQuarterScoresForGames").Logger()
+	logger.Info().Msgf("getting quarter scores for %d games", len(gameIDs))
+
+	quarterScores := make([]GameTeamQuarterScore, 0)
+	if err := g.db.Select(&quarterScores, getQuarterScoresForGamesStmt, pq.Array(gameIDs)); err != nil {
+		logger.Error().Err(err).Msg("while getting quarter scores")
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return quarterScores, nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -103,3 +104,54 @@ func TestGameQuarterScoreDAOImpl_UpdateQuarterScore(t *testing.T) {
 		})
 	}
 }
+
+func TestGameQuarterScoreDAOImpl_GetQuarterScoresForGames(t *testing.T) {
+
+	testCases := map[string]struct {
+		mockDB   func(sqlMock sqlmock.Sqlmock)
+		expected []GameTeamQuarterScore
+		err      error
+	}{
+		"should return the quarter scores of every game": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getQuarterScoresForGamesStmt)).
+					WithArgs(pq.Array([]string{"1", "2"})).
+					WillReturnRows(sqlmock.NewRows([]string{"id", "abbreviation", "quarter", "score"}).
+						AddRow("1", "KC", "1", "7").
+						AddRow("2", "BUF", "1", "3"))
+			},
+			expected: []GameTeamQuarterScore{
+				{GameID: "1", TeamAbbreviation: "KC", Quarter: "1", Score: "7"},
+				{GameID: "2", TeamAbbreviation: "BUF", Quarter: "1", Score: "3"},
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getQuarterScoresForGamesStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			tc.mockDB(mock)
+			dao := &GameQuarterScoreDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+
+			scores, err := dao.GetQuarterScoresForGames([]string{"1", "2"})
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, scores)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
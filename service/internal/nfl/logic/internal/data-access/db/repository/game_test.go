@@ -31,13 +31,13 @@ func TestGameDAOImpl_InsertGame(t *testing.T) {
 		"should return nil error when successful": {
 			input: inputGame,
 			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
-				sqlMock.ExpectExec(regexp.QuoteMeta("insert into game (id, game_time, quarter, game_clock, away_team, home_team) values ($1, $2, $3, $4, $5, $6);")).WillReturnResult(sqlmock.NewResult(1, 1))
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into game (id, game_time, quarter, game_clock, away_team, home_team, season, season_type) values ($1, $2, $3, $4, $5, $6, $7, $8);")).WillReturnResult(sqlmock.NewResult(1, 1))
 			},
 		},
 		"should return error when unsuccessful": {
 			input: inputGame,
 			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
-				sqlMock.ExpectExec(regexp.QuoteMeta("insert into game (id, game_time, quarter, game_clock, away_team, home_team) values ($1, $2, $3, $4, $5, $6);")).WillReturnError(sql.ErrConnDone)
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into game (id, game_time, quarter, game_clock, away_team, home_team, season, season_type) values ($1, $2, $3, $4, $5, $6, $7, $8);")).WillReturnError(sql.ErrConnDone)
 			},
 			err: ErrInsertGame,
 		},
@@ -364,3 +364,355 @@ func TestGameDAOImpl_GetGameTeamQuarterScore(t *testing.T) {
 	}
 
 }
+
+func TestGameDAOImpl_GetGameWithTeamAbv(t *testing.T) {
+	haveGameTime := time.Now()
+	columns := []string{"id", "away_team", "home_team", "away_team_color", "away_team_alt_color", "home_team_color",
+		"home_team_alt_color", "game_time", "game_clock", "quarter", "possession", "down_distance", "away_timeouts",
+		"home_timeouts", "away_record", "home_record"}
+
+	testCases := map[string]struct {
+		mockDB       func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		expectedGame Game
+		expectedErr  error
+	}{
+		"should get game with details for id": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns)
+				rows.AddRow("1", "KC", "BUF", "e31837", "ffb612", "00338d", "c60c30", haveGameTime, "2:00", "4",
+					"KC", "3rd & 4 at BUF 40", 2, 1, "10-3", "9-4")
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getGameWithTeamAbvStmt)).WithArgs("1").WillReturnRows(rows)
+			},
+			expectedGame: Game{
+				ID:               "1",
+				GameTime:         haveGameTime,
+				Quarter:          "4",
+				GameClock:        "2:00",
+				AwayTeam:         "KC",
+				HomeTeam:         "BUF",
+				AwayTeamColor:    "e31837",
+				AwayTeamAltColor: "ffb612",
+				HomeTeamColor:    "00338d",
+				HomeTeamAltColor: "c60c30",
+				AwayRecord:       "10-3",
+				HomeRecord:       "9-4",
+				GameSituation: GameSituation{
+					Possession:   "KC",
+					DownDistance: "3rd & 4 at BUF 40",
+					AwayTimeouts: 2,
+					HomeTimeouts: 1,
+				},
+			},
+		},
+		"should return err no game when no game": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getGameWithTeamAbvStmt)).WillReturnError(sql.ErrNoRows)
+			},
+			expectedErr: ErrNoGame,
+		},
+		"generic error": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getGameWithTeamAbvStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			game, err := dao.GetGameWithTeamAbv("1")
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedGame, game)
+		})
+	}
+}
+
+func TestGameDAOImpl_UpdateGameSituation(t *testing.T) {
+	situation := GameSituation{Possession: "KC", DownDistance: "1st & 10 at KC 25", AwayTimeouts: 3, HomeTimeouts: 2}
+
+	testCases := map[string]struct {
+		mockDB func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameSituationStmt)).
+					WithArgs("KC", "1st & 10 at KC 25", 3, 2, "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameSituationStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpdateGame,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpdateGameSituation("1", situation)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameDAOImpl_UpdateGameRecords(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameRecordsStmt)).
+					WithArgs("10-3", "9-4", "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameRecordsStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpdateGame,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpdateGameRecords("1", "10-3", "9-4")
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameDAOImpl_UpdateGameSeason(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameSeasonStmt)).
+					WithArgs(2023, 2, "1").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameSeasonStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpdateGame,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpdateGameSeason("1", 2023, 2)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameDAOImpl_NotifyGameChange(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should notify the game id on the game changes channel": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(notifyGameChangeStmt)).
+					WithArgs(GameChangesChannel, "1").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(notifyGameChangeStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.NotifyGameChange("1")
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameDAOImpl_GetSeasonResults(t *testing.T) {
+	gameTime := time.Date(2023, 9, 7, 0, 20, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockDB   func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		expected []GameResult
+		err      error
+	}{
+		"should return final scores": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows([]string{"id", "game_time", "away_team", "home_team", "away_score", "home_score"})
+				rows.AddRow("401547353", gameTime, "DET", "KC", 21, 20)
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getSeasonResultsStmt)).WithArgs(2023, 2).WillReturnRows(rows)
+			},
+			expected: []GameResult{
+				{GameID: "401547353", GameTime: gameTime, AwayTeam: "DET", HomeTeam: "KC", AwayScore: 21, HomeScore: 20},
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getSeasonResultsStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			results, err := dao.GetSeasonResults(2023, 2)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, results)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameDAOImpl_GetTeamSchedule(t *testing.T) {
+	gameTime := time.Date(2023, 9, 11, 23, 15, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockDB   func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		expected []ScheduledGame
+		err      error
+	}{
+		"should return games of the team": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows([]string{"id", "game_time", "quarter", "season_type", "schedule_sequence", "away_team", "home_team", "away_score", "home_score"})
+				rows.AddRow("401547356", gameTime, "F", 2, 0, "BUF", "NYJ", 16, 22)
+				rows.AddRow("401547365", gameTime.AddDate(0, 0, 7), "", 2, 1, "LV", "BUF", 0, 0)
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getTeamScheduleStmt)).WithArgs(2023, "BUF").WillReturnRows(rows)
+			},
+			expected: []ScheduledGame{
+				{GameID: "401547356", GameTime: gameTime, Quarter: "F", SeasonType: 2, AwayTeam: "BUF", HomeTeam: "NYJ", AwayScore: 16, HomeScore: 22},
+				{GameID: "401547365", GameTime: gameTime.AddDate(0, 0, 7), SeasonType: 2, ScheduleSequence: 1, AwayTeam: "LV", HomeTeam: "BUF"},
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getTeamScheduleStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			games, err := dao.GetTeamSchedule("BUF", 2023)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.Equal(t, tc.expected, games)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestGameDAOImpl_UpdateGameTime(t *testing.T) {
+	gameTime := time.Date(2023, 12, 17, 21, 25, 0, 0, time.UTC)
+	testCases := map[string]struct {
+		mockDB func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should move the kickoff and count the change": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameTimeStmt)).WithArgs(gameTime, "401547353").WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateGameTimeStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: sql.ErrConnDone,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &GameDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpdateGameTime("401547353", gameTime)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
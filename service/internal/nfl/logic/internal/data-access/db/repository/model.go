@@ -2,6 +2,7 @@ package repository
 
 import (
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"time"
 )
 
@@ -9,21 +10,66 @@ type Team struct {
 	ID           uuid.UUID  `json:"id" db:"id"`
 	Name         string     `json:"name" db:"name"`
 	Abbreviation string     `json:"abbreviation" db:"abbreviation"`
+	Color        string     `json:"color" db:"color"`
+	AltColor     string     `json:"alt_color" db:"alt_color"`
+	Conference   string     `json:"conference" db:"conference"`
+	Division     string     `json:"division" db:"division"`
 	CreatedAt    time.Time  `json:"created_at" db:"created_at"`
 	UpdatedAt    time.Time  `json:"updated_at" db:"updated_at"`
 	DeletedAt    *time.Time `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
 }
 
 type Game struct {
-	ID        string     `json:"id" db:"id"`
-	GameTime  time.Time  `json:"game_time" db:"game_time"`
-	Quarter   string     `json:"quarter" db:"quarter"`
-	GameClock string     `json:"game_clock" db:"game_clock"`
-	AwayTeam  string     `json:"away_team" db:"away_team"`
-	HomeTeam  string     `json:"home_team" db:"home_team"`
-	CreatedAt time.Time  `json:"created_at" db:"created_at"`
-	UpdatedAt time.Time  `json:"updated_at" db:"updated_at"`
-	DeletedAt *time.Time `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
+	ID               string     `json:"id" db:"id"`
+	GameTime         time.Time  `json:"game_time" db:"game_time"`
+	Quarter          string     `json:"quarter" db:"quarter"`
+	GameClock        string     `json:"game_clock" db:"game_clock"`
+	AwayTeam         string     `json:"away_team" db:"away_team"`
+	HomeTeam         string     `json:"home_team" db:"home_team"`
+	AwayTeamColor    string     `json:"away_team_color,omitempty" db:"away_team_color"`
+	AwayTeamAltColor string     `json:"away_team_alt_color,omitempty" db:"away_team_alt_color"`
+	HomeTeamColor    string     `json:"home_team_color,omitempty" db:"home_team_color"`
+	HomeTeamAltColor string     `json:"home_team_alt_color,omitempty" db:"home_team_alt_color"`
+	AwayRecord       string     `json:"away_record,omitempty" db:"away_record"`
+	HomeRecord       string     `json:"home_record,omitempty" db:"home_record"`
+	Season           int        `json:"season" db:"season"`
+	SeasonType       int        `json:"season_type" db:"season_type"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
+	GameSituation
+}
+
+// GameSituation is the state of play of a game in progress.
+type GameSituation struct {
+	Possession   string `json:"possession,omitempty" db:"possession"`
+	DownDistance string `json:"down_distance,omitempty" db:"down_distance"`
+	AwayTimeouts int    `json:"away_timeouts,omitempty" db:"away_timeouts"`
+	HomeTimeouts int    `json:"home_timeouts,omitempty" db:"home_timeouts"`
+}
+
+// GameResult is the final score of a game. AwayTeam and HomeTeam are team abbreviations.
+type GameResult struct {
+	GameID    string    `json:"game_id" db:"id"`
+	GameTime  time.Time `json:"game_time" db:"game_time"`
+	AwayTeam  string    `json:"away_team" db:"away_team"`
+	HomeTeam  string    `json:"home_team" db:"home_team"`
+	AwayScore int       `json:"away_score" db:"away_score"`
+	HomeScore int       `json:"home_score" db:"home_score"`
+}
+
+// ScheduledGame is a game of a team's schedule. The scores are the points so far, zero before kickoff.
+// ScheduleSequence counts the times the kickoff has moved.
+type ScheduledGame struct {
+	GameID           string    `json:"game_id" db:"id"`
+	GameTime         time.Time `json:"game_time" db:"game_time"`
+	Quarter          string    `json:"quarter" db:"quarter"`
+	SeasonType       int       `json:"season_type" db:"season_type"`
+	ScheduleSequence int       `json:"schedule_sequence" db:"schedule_sequence"`
+	AwayTeam         string    `json:"away_team" db:"away_team"`
+	HomeTeam         string    `json:"home_team" db:"home_team"`
+	AwayScore        int       `json:"away_score" db:"away_score"`
+	HomeScore        int       `json:"home_score" db:"home_score"`
 }
 
 type GameQuarterScore struct {
@@ -53,3 +99,84 @@ type GameTeamQuarterScore struct {
 	Quarter          string `db:"quarter"`
 	Score            string `db:"score"`
 }
+
+// ScoringPlay is a play that scored. TeamAbbreviation is only read, plays are written with their TeamID.
+type ScoringPlay struct {
+	ID               string     `json:"id" db:"id"`
+	GameID           string     `json:"game_id" db:"game_id"`
+	TeamID           uuid.UUID  `json:"team_id" db:"team_id"`
+	TeamAbbreviation string     `json:"team_abbreviation" db:"team_abbreviation"`
+	Sequence         int        `json:"sequence" db:"sequence"`
+	Quarter          string     `json:"quarter" db:"quarter"`
+	Clock            string     `json:"clock" db:"clock"`
+	Type             string     `json:"type" db:"type"`
+	Description      string     `json:"description" db:"description"`
+	AwayScore        int        `json:"away_score" db:"away_score"`
+	HomeScore        int        `json:"home_score" db:"home_score"`
+	CreatedAt        time.Time  `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
+}
+
+// FinalEntry is the entry of the final score feed for a game, published when the game is finalized.
+type FinalEntry struct {
+	GameID    string    `json:"game_id" db:"game_id"`
+	Title     string    `json:"title" db:"title"`
+	LineScore string    `json:"line_score" db:"line_score"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// MLBFinalEntry is the entry of the MLB final score feed for a game, published when the game is seen final.
+type MLBFinalEntry struct {
+	GamePk    int       `json:"game_pk" db:"game_pk"`
+	Title     string    `json:"title" db:"title"`
+	LineScore string    `json:"line_score" db:"line_score"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// Webhook is a registered webhook. Empty filters match every sport, team or event type.
+type Webhook struct {
+	ID         uuid.UUID      `json:"id" db:"id"`
+	URL        string         `json:"url" db:"url"`
+	Secret     string         `json:"secret" db:"secret"`
+	Sports     pq.StringArray `json:"sports" db:"sports"`
+	Teams      pq.StringArray `json:"teams" db:"teams"`
+	EventTypes pq.StringArray `json:"event_types" db:"event_types"`
+	CreatedAt  time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt  time.Time      `json:"updated_at" db:"updated_at"`
+	DeletedAt  *time.Time     `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
+}
+
+// WebhookDeadLetter is a delivery of an event to a webhook that failed every attempt.
+type WebhookDeadLetter struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	WebhookID uuid.UUID `json:"webhook_id" db:"webhook_id"`
+	EventID   string    `json:"event_id" db:"event_id"`
+	EventType string    `json:"event_type" db:"event_type"`
+	Payload   string    `json:"payload" db:"payload"`
+	Attempts  int       `json:"attempts" db:"attempts"`
+	LastError string    `json:"last_error" db:"last_error"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+}
+
+// GameFinalEntry is the feed entry of a game with the game's teams and kickoff.
+type GameFinalEntry struct {
+	FinalEntry
+	GameTime time.Time `json:"game_time" db:"game_time"`
+	AwayTeam string    `json:"away_team" db:"away_team"`
+	HomeTeam string    `json:"home_team" db:"home_team"`
+}
+
+// DigestSubscription is someone emailed the finals of their teams. Teams are abbreviations per sport.
+type DigestSubscription struct {
+	ID               uuid.UUID      `json:"id" db:"id"`
+	Email            string         `json:"email" db:"email"`
+	NFLTeams         pq.StringArray `json:"nfl_teams" db:"nfl_teams"`
+	MLBTeams         pq.StringArray `json:"mlb_teams" db:"mlb_teams"`
+	UnsubscribeToken string         `json:"unsubscribe_token" db:"unsubscribe_token"`
+	CreatedAt        time.Time      `json:"created_at" db:"created_at"`
+	UpdatedAt        time.Time      `json:"updated_at" db:"updated_at"`
+	DeletedAt        *time.Time     `json:"deleted_at,omitempty" db:"deleted_at,omitempty"`
+}
@@ -1,15 +1,18 @@
 package repository
 
 import (
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
 	"github.com/rs/zerolog"
 	"time"
 )
+
 //go:generate mockgen -destination ./repository_mock.go -package repository -source=./repository.go Repository
 type (
 	TeamDAO interface {
 		GetTeamByAbv(abbv string) (*Team, error)
 		GetAllTeams() ([]*Team, error)
+		UpdateTeamColors(abbv string, color string, altColor string) error
 	}
 
 	GameDAO interface {
@@ -17,9 +20,16 @@ type (
 		GetGames(start time.Time, end *time.Time) ([]Game, error)
 		GetGamesWithTeamAbv(start time.Time, end *time.Time) ([]Game, error)
 		GetGame(gameID string) (Game, error)
+		GetGameWithTeamAbv(gameID string) (Game, error)
 		UpdateQuarterGameClock(gameID string, quarter string, gameClock string) error
 		UpdateGameTime(gameID string, gameClock time.Time) error
 		UpdateGameClock(gameID string, gameClock string) error
+		UpdateGameSituation(gameID string, situation GameSituation) error
+		UpdateGameRecords(gameID string, awayRecord string, homeRecord string) error
+		UpdateGameSeason(gameID string, season int, seasonType int) error
+		NotifyGameChange(gameID string) error
+		GetSeasonResults(season int, seasonType int) ([]GameResult, error)
+		GetTeamSchedule(abbreviation string, season int) ([]ScheduledGame, error)
 
 		GetGameTeamQuarterScore(start time.Time, end *time.Time) ([]GameTeamQuarterScore, error)
 	}
@@ -28,18 +38,63 @@ type (
 		GetQuarterScoreBy(gameID string, teamAbv string, quarter string) (GameQuarterScore, error)
 		InsertQuarterScore(quarterScore GameQuarterScore) error
 		UpdateQuarterScore(score int, gameID string, teamAbv string, quarter string) error
+		GetQuarterScoresForGames(gameIDs []string) ([]GameTeamQuarterScore, error)
+	}
+
+	ScoringPlayDAO interface {
+		UpsertScoringPlay(play ScoringPlay) error
+		DeleteScoringPlaysExcept(gameID string, playIDs []string) error
+		GetScoringPlays(gameID string) ([]ScoringPlay, error)
+	}
+
+	FinalEntryDAO interface {
+		UpsertFinalEntry(entry FinalEntry) error
+		GetFinalEntries(limit int) ([]FinalEntry, error)
+		GetGameFinalEntries(start time.Time, end time.Time) ([]GameFinalEntry, error)
+		UpsertMLBFinalEntry(entry MLBFinalEntry) error
+		GetMLBFinalEntries(limit int) ([]MLBFinalEntry, error)
+	}
+
+	WebhookDAO interface {
+		InsertWebhook(webhook Webhook) (uuid.UUID, error)
+		GetWebhooks() ([]Webhook, error)
+		DeleteWebhook(id uuid.UUID) error
+		InsertWebhookDeadLetter(letter WebhookDeadLetter) error
+	}
+
+	DigestSubscriptionDAO interface {
+		InsertDigestSubscription(subscription DigestSubscription) (uuid.UUID, error)
+		GetDigestSubscriptions() ([]DigestSubscription, error)
+		DeleteDigestSubscription(unsubscribeToken string) error
+		InsertDigestSent(subscriptionID uuid.UUID, date time.Time) error
+		GetDigestSentIDs(date time.Time) ([]uuid.UUID, error)
+	}
+
+	SyncStatusDAO interface {
+		UpdateSyncTime(name string) error
+		GetSyncTime(name string) (time.Time, error)
 	}
 
 	Repository interface {
 		TeamDAO
 		GameDAO
 		GameQuarterScoreDAO
+		ScoringPlayDAO
+		FinalEntryDAO
+		WebhookDAO
+		DigestSubscriptionDAO
+		SyncStatusDAO
 	}
 
 	RepositoryImpl struct {
 		TeamDAO
 		GameDAO
 		GameQuarterScoreDAO
+		ScoringPlayDAO
+		FinalEntryDAO
+		WebhookDAO
+		DigestSubscriptionDAO
+		SyncStatusDAO
 	}
 )
 
@@ -47,9 +102,19 @@ func NewRepository(logger zerolog.Logger, db *sqlx.DB) *RepositoryImpl {
 	teamDAO := NewTeamDAOImpl(logger, db)
 	gameDAO := NewGameDAOImpl(logger, db)
 	gameQuarterScoreDAO := NewGameQuarterScoreDAOImpl(logger, db)
+	scoringPlayDAO := NewScoringPlayDAOImpl(logger, db)
+	finalEntryDAO := NewFinalEntryDAOImpl(logger, db)
+	webhookDAO := NewWebhookDAOImpl(logger, db)
+	digestSubscriptionDAO := NewDigestSubscriptionDAOImpl(logger, db)
+	syncStatusDAO := NewSyncStatusDAOImpl(logger, db)
 	return &RepositoryImpl{
-		TeamDAO:             teamDAO,
-		GameDAO:             gameDAO,
-		GameQuarterScoreDAO: gameQuarterScoreDAO,
+		TeamDAO:               teamDAO,
+		GameDAO:               gameDAO,
+		GameQuarterScoreDAO:   gameQuarterScoreDAO,
+		ScoringPlayDAO:        scoringPlayDAO,
+		FinalEntryDAO:         finalEntryDAO,
+		WebhookDAO:            webhookDAO,
+		DigestSubscriptionDAO: digestSubscriptionDAO,
+		SyncStatusDAO:         syncStatusDAO,
 	}
 }
@@ -12,6 +12,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -68,6 +69,20 @@ func (mr *MockTeamDAOMockRecorder) GetTeamByAbv(abbv any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByAbv", reflect.TypeOf((*MockTeamDAO)(nil).GetTeamByAbv), abbv)
 }
 
+// UpdateTeamColors mocks base method.
+func (m *MockTeamDAO) UpdateTeamColors(abbv, color, altColor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamColors", abbv, color, altColor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamColors indicates an expected call of UpdateTeamColors.
+func (mr *MockTeamDAOMockRecorder) UpdateTeamColors(abbv, color, altColor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamColors", reflect.TypeOf((*MockTeamDAO)(nil).UpdateTeamColors), abbv, color, altColor)
+}
+
 // MockGameDAO is a mock of GameDAO interface.
 type MockGameDAO struct {
 	ctrl     *gomock.Controller
@@ -121,6 +136,21 @@ func (mr *MockGameDAOMockRecorder) GetGameTeamQuarterScore(start, end any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameTeamQuarterScore", reflect.TypeOf((*MockGameDAO)(nil).GetGameTeamQuarterScore), start, end)
 }
 
+// GetGameWithTeamAbv mocks base method.
+func (m *MockGameDAO) GetGameWithTeamAbv(gameID string) (Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameWithTeamAbv", gameID)
+	ret0, _ := ret[0].(Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameWithTeamAbv indicates an expected call of GetGameWithTeamAbv.
+func (mr *MockGameDAOMockRecorder) GetGameWithTeamAbv(gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameWithTeamAbv", reflect.TypeOf((*MockGameDAO)(nil).GetGameWithTeamAbv), gameID)
+}
+
 // GetGames mocks base method.
 func (m *MockGameDAO) GetGames(start time.Time, end *time.Time) ([]Game, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +181,36 @@ func (mr *MockGameDAOMockRecorder) GetGamesWithTeamAbv(start, end any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesWithTeamAbv", reflect.TypeOf((*MockGameDAO)(nil).GetGamesWithTeamAbv), start, end)
 }
 
+// GetSeasonResults mocks base method.
+func (m *MockGameDAO) GetSeasonResults(season, seasonType int) ([]GameResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeasonResults", season, seasonType)
+	ret0, _ := ret[0].([]GameResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeasonResults indicates an expected call of GetSeasonResults.
+func (mr *MockGameDAOMockRecorder) GetSeasonResults(season, seasonType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeasonResults", reflect.TypeOf((*MockGameDAO)(nil).GetSeasonResults), season, seasonType)
+}
+
+// GetTeamSchedule mocks base method.
+func (m *MockGameDAO) GetTeamSchedule(abbreviation string, season int) ([]ScheduledGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSchedule", abbreviation, season)
+	ret0, _ := ret[0].([]ScheduledGame)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSchedule indicates an expected call of GetTeamSchedule.
+func (mr *MockGameDAOMockRecorder) GetTeamSchedule(abbreviation, season any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSchedule", reflect.TypeOf((*MockGameDAO)(nil).GetTeamSchedule), abbreviation, season)
+}
+
 // InsertGame mocks base method.
 func (m *MockGameDAO) InsertGame(game Game) error {
 	m.ctrl.T.Helper()
@@ -165,6 +225,20 @@ func (mr *MockGameDAOMockRecorder) InsertGame(game any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertGame", reflect.TypeOf((*MockGameDAO)(nil).InsertGame), game)
 }
 
+// NotifyGameChange mocks base method.
+func (m *MockGameDAO) NotifyGameChange(gameID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyGameChange", gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyGameChange indicates an expected call of NotifyGameChange.
+func (mr *MockGameDAOMockRecorder) NotifyGameChange(gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyGameChange", reflect.TypeOf((*MockGameDAO)(nil).NotifyGameChange), gameID)
+}
+
 // UpdateGameClock mocks base method.
 func (m *MockGameDAO) UpdateGameClock(gameID, gameClock string) error {
 	m.ctrl.T.Helper()
@@ -179,6 +253,48 @@ func (mr *MockGameDAOMockRecorder) UpdateGameClock(gameID, gameClock any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameClock", reflect.TypeOf((*MockGameDAO)(nil).UpdateGameClock), gameID, gameClock)
 }
 
+// UpdateGameRecords mocks base method.
+func (m *MockGameDAO) UpdateGameRecords(gameID, awayRecord, homeRecord string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameRecords", gameID, awayRecord, homeRecord)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameRecords indicates an expected call of UpdateGameRecords.
+func (mr *MockGameDAOMockRecorder) UpdateGameRecords(gameID, awayRecord, homeRecord any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameRecords", reflect.TypeOf((*MockGameDAO)(nil).UpdateGameRecords), gameID, awayRecord, homeRecord)
+}
+
+// UpdateGameSeason mocks base method.
+func (m *MockGameDAO) UpdateGameSeason(gameID string, season, seasonType int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameSeason", gameID, season, seasonType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameSeason indicates an expected call of UpdateGameSeason.
+func (mr *MockGameDAOMockRecorder) UpdateGameSeason(gameID, season, seasonType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSeason", reflect.TypeOf((*MockGameDAO)(nil).UpdateGameSeason), gameID, season, seasonType)
+}
+
+// UpdateGameSituation mocks base method.
+func (m *MockGameDAO) UpdateGameSituation(gameID string, situation GameSituation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameSituation", gameID, situation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameSituation indicates an expected call of UpdateGameSituation.
+func (mr *MockGameDAOMockRecorder) UpdateGameSituation(gameID, situation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSituation", reflect.TypeOf((*MockGameDAO)(nil).UpdateGameSituation), gameID, situation)
+}
+
 // UpdateGameTime mocks base method.
 func (m *MockGameDAO) UpdateGameTime(gameID string, gameClock time.Time) error {
 	m.ctrl.T.Helper()
@@ -245,6 +361,21 @@ func (mr *MockGameQuarterScoreDAOMockRecorder) GetQuarterScoreBy(gameID, teamAbv
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarterScoreBy", reflect.TypeOf((*MockGameQuarterScoreDAO)(nil).GetQuarterScoreBy), gameID, teamAbv, quarter)
 }
 
+// GetQuarterScoresForGames mocks base method.
+func (m *MockGameQuarterScoreDAO) GetQuarterScoresForGames(gameIDs []string) ([]GameTeamQuarterScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuarterScoresForGames", gameIDs)
+	ret0, _ := ret[0].([]GameTeamQuarterScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuarterScoresForGames indicates an expected call of GetQuarterScoresForGames.
+func (mr *MockGameQuarterScoreDAOMockRecorder) GetQuarterScoresForGames(gameIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarterScoresForGames", reflect.TypeOf((*MockGameQuarterScoreDAO)(nil).GetQuarterScoresForGames), gameIDs)
+}
+
 // InsertQuarterScore mocks base method.
 func (m *MockGameQuarterScoreDAO) InsertQuarterScore(quarterScore GameQuarterScore) error {
 	m.ctrl.T.Helper()
@@ -273,6 +404,397 @@ func (mr *MockGameQuarterScoreDAOMockRecorder) UpdateQuarterScore(score, gameID,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuarterScore", reflect.TypeOf((*MockGameQuarterScoreDAO)(nil).UpdateQuarterScore), score, gameID, teamAbv, quarter)
 }
 
+// MockScoringPlayDAO is a mock of ScoringPlayDAO interface.
+type MockScoringPlayDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockScoringPlayDAOMockRecorder
+}
+
+// MockScoringPlayDAOMockRecorder is the mock recorder for MockScoringPlayDAO.
+type MockScoringPlayDAOMockRecorder struct {
+	mock *MockScoringPlayDAO
+}
+
+// NewMockScoringPlayDAO creates a new mock instance.
+func NewMockScoringPlayDAO(ctrl *gomock.Controller) *MockScoringPlayDAO {
+	mock := &MockScoringPlayDAO{ctrl: ctrl}
+	mock.recorder = &MockScoringPlayDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScoringPlayDAO) EXPECT() *MockScoringPlayDAOMockRecorder {
+	return m.recorder
+}
+
+// DeleteScoringPlaysExcept mocks base method.
+func (m *MockScoringPlayDAO) DeleteScoringPlaysExcept(gameID string, playIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScoringPlaysExcept", gameID, playIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScoringPlaysExcept indicates an expected call of DeleteScoringPlaysExcept.
+func (mr *MockScoringPlayDAOMockRecorder) DeleteScoringPlaysExcept(gameID, playIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScoringPlaysExcept", reflect.TypeOf((*MockScoringPlayDAO)(nil).DeleteScoringPlaysExcept), gameID, playIDs)
+}
+
+// GetScoringPlays mocks base method.
+func (m *MockScoringPlayDAO) GetScoringPlays(gameID string) ([]ScoringPlay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoringPlays", gameID)
+	ret0, _ := ret[0].([]ScoringPlay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScoringPlays indicates an expected call of GetScoringPlays.
+func (mr *MockScoringPlayDAOMockRecorder) GetScoringPlays(gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoringPlays", reflect.TypeOf((*MockScoringPlayDAO)(nil).GetScoringPlays), gameID)
+}
+
+// UpsertScoringPlay mocks base method.
+func (m *MockScoringPlayDAO) UpsertScoringPlay(play ScoringPlay) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertScoringPlay", play)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertScoringPlay indicates an expected call of UpsertScoringPlay.
+func (mr *MockScoringPlayDAOMockRecorder) UpsertScoringPlay(play any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertScoringPlay", reflect.TypeOf((*MockScoringPlayDAO)(nil).UpsertScoringPlay), play)
+}
+
+// MockFinalEntryDAO is a mock of FinalEntryDAO interface.
+type MockFinalEntryDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockFinalEntryDAOMockRecorder
+}
+
+// MockFinalEntryDAOMockRecorder is the mock recorder for MockFinalEntryDAO.
+type MockFinalEntryDAOMockRecorder struct {
+	mock *MockFinalEntryDAO
+}
+
+// NewMockFinalEntryDAO creates a new mock instance.
+func NewMockFinalEntryDAO(ctrl *gomock.Controller) *MockFinalEntryDAO {
+	mock := &MockFinalEntryDAO{ctrl: ctrl}
+	mock.recorder = &MockFinalEntryDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFinalEntryDAO) EXPECT() *MockFinalEntryDAOMockRecorder {
+	return m.recorder
+}
+
+// GetFinalEntries mocks base method.
+func (m *MockFinalEntryDAO) GetFinalEntries(limit int) ([]FinalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFinalEntries", limit)
+	ret0, _ := ret[0].([]FinalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinalEntries indicates an expected call of GetFinalEntries.
+func (mr *MockFinalEntryDAOMockRecorder) GetFinalEntries(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinalEntries", reflect.TypeOf((*MockFinalEntryDAO)(nil).GetFinalEntries), limit)
+}
+
+// GetGameFinalEntries mocks base method.
+func (m *MockFinalEntryDAO) GetGameFinalEntries(start, end time.Time) ([]GameFinalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameFinalEntries", start, end)
+	ret0, _ := ret[0].([]GameFinalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameFinalEntries indicates an expected call of GetGameFinalEntries.
+func (mr *MockFinalEntryDAOMockRecorder) GetGameFinalEntries(start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameFinalEntries", reflect.TypeOf((*MockFinalEntryDAO)(nil).GetGameFinalEntries), start, end)
+}
+
+// GetMLBFinalEntries mocks base method.
+func (m *MockFinalEntryDAO) GetMLBFinalEntries(limit int) ([]MLBFinalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMLBFinalEntries", limit)
+	ret0, _ := ret[0].([]MLBFinalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMLBFinalEntries indicates an expected call of GetMLBFinalEntries.
+func (mr *MockFinalEntryDAOMockRecorder) GetMLBFinalEntries(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMLBFinalEntries", reflect.TypeOf((*MockFinalEntryDAO)(nil).GetMLBFinalEntries), limit)
+}
+
+// UpsertFinalEntry mocks base method.
+func (m *MockFinalEntryDAO) UpsertFinalEntry(entry FinalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFinalEntry", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFinalEntry indicates an expected call of UpsertFinalEntry.
+func (mr *MockFinalEntryDAOMockRecorder) UpsertFinalEntry(entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFinalEntry", reflect.TypeOf((*MockFinalEntryDAO)(nil).UpsertFinalEntry), entry)
+}
+
+// UpsertMLBFinalEntry mocks base method.
+func (m *MockFinalEntryDAO) UpsertMLBFinalEntry(entry MLBFinalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMLBFinalEntry", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertMLBFinalEntry indicates an expected call of UpsertMLBFinalEntry.
+func (mr *MockFinalEntryDAOMockRecorder) UpsertMLBFinalEntry(entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMLBFinalEntry", reflect.TypeOf((*MockFinalEntryDAO)(nil).UpsertMLBFinalEntry), entry)
+}
+
+// MockWebhookDAO is a mock of WebhookDAO interface.
+type MockWebhookDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockWebhookDAOMockRecorder
+}
+
+// MockWebhookDAOMockRecorder is the mock recorder for MockWebhookDAO.
+type MockWebhookDAOMockRecorder struct {
+	mock *MockWebhookDAO
+}
+
+// NewMockWebhookDAO creates a new mock instance.
+func NewMockWebhookDAO(ctrl *gomock.Controller) *MockWebhookDAO {
+	mock := &MockWebhookDAO{ctrl: ctrl}
+	mock.recorder = &MockWebhookDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebhookDAO) EXPECT() *MockWebhookDAOMockRecorder {
+	return m.recorder
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockWebhookDAO) DeleteWebhook(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockWebhookDAOMockRecorder) DeleteWebhook(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockWebhookDAO)(nil).DeleteWebhook), id)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockWebhookDAO) GetWebhooks() ([]Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks")
+	ret0, _ := ret[0].([]Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockWebhookDAOMockRecorder) GetWebhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockWebhookDAO)(nil).GetWebhooks))
+}
+
+// InsertWebhook mocks base method.
+func (m *MockWebhookDAO) InsertWebhook(webhook Webhook) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWebhook", webhook)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWebhook indicates an expected call of InsertWebhook.
+func (mr *MockWebhookDAOMockRecorder) InsertWebhook(webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWebhook", reflect.TypeOf((*MockWebhookDAO)(nil).InsertWebhook), webhook)
+}
+
+// InsertWebhookDeadLetter mocks base method.
+func (m *MockWebhookDAO) InsertWebhookDeadLetter(letter WebhookDeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWebhookDeadLetter", letter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWebhookDeadLetter indicates an expected call of InsertWebhookDeadLetter.
+func (mr *MockWebhookDAOMockRecorder) InsertWebhookDeadLetter(letter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWebhookDeadLetter", reflect.TypeOf((*MockWebhookDAO)(nil).InsertWebhookDeadLetter), letter)
+}
+
+// MockDigestSubscriptionDAO is a mock of DigestSubscriptionDAO interface.
+type MockDigestSubscriptionDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockDigestSubscriptionDAOMockRecorder
+}
+
+// MockDigestSubscriptionDAOMockRecorder is the mock recorder for MockDigestSubscriptionDAO.
+type MockDigestSubscriptionDAOMockRecorder struct {
+	mock *MockDigestSubscriptionDAO
+}
+
+// NewMockDigestSubscriptionDAO creates a new mock instance.
+func NewMockDigestSubscriptionDAO(ctrl *gomock.Controller) *MockDigestSubscriptionDAO {
+	mock := &MockDigestSubscriptionDAO{ctrl: ctrl}
+	mock.recorder = &MockDigestSubscriptionDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestSubscriptionDAO) EXPECT() *MockDigestSubscriptionDAOMockRecorder {
+	return m.recorder
+}
+
+// DeleteDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionDAO) DeleteDigestSubscription(unsubscribeToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDigestSubscription", unsubscribeToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDigestSubscription indicates an expected call of DeleteDigestSubscription.
+func (mr *MockDigestSubscriptionDAOMockRecorder) DeleteDigestSubscription(unsubscribeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionDAO)(nil).DeleteDigestSubscription), unsubscribeToken)
+}
+
+// GetDigestSentIDs mocks base method.
+func (m *MockDigestSubscriptionDAO) GetDigestSentIDs(date time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSentIDs", date)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSentIDs indicates an expected call of GetDigestSentIDs.
+func (mr *MockDigestSubscriptionDAOMockRecorder) GetDigestSentIDs(date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSentIDs", reflect.TypeOf((*MockDigestSubscriptionDAO)(nil).GetDigestSentIDs), date)
+}
+
+// GetDigestSubscriptions mocks base method.
+func (m *MockDigestSubscriptionDAO) GetDigestSubscriptions() ([]DigestSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSubscriptions")
+	ret0, _ := ret[0].([]DigestSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSubscriptions indicates an expected call of GetDigestSubscriptions.
+func (mr *MockDigestSubscriptionDAOMockRecorder) GetDigestSubscriptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSubscriptions", reflect.TypeOf((*MockDigestSubscriptionDAO)(nil).GetDigestSubscriptions))
+}
+
+// InsertDigestSent mocks base method.
+func (m *MockDigestSubscriptionDAO) InsertDigestSent(subscriptionID uuid.UUID, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDigestSent", subscriptionID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDigestSent indicates an expected call of InsertDigestSent.
+func (mr *MockDigestSubscriptionDAOMockRecorder) InsertDigestSent(subscriptionID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDigestSent", reflect.TypeOf((*MockDigestSubscriptionDAO)(nil).InsertDigestSent), subscriptionID, date)
+}
+
+// InsertDigestSubscription mocks base method.
+func (m *MockDigestSubscriptionDAO) InsertDigestSubscription(subscription DigestSubscription) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDigestSubscription", subscription)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertDigestSubscription indicates an expected call of InsertDigestSubscription.
+func (mr *MockDigestSubscriptionDAOMockRecorder) InsertDigestSubscription(subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDigestSubscription", reflect.TypeOf((*MockDigestSubscriptionDAO)(nil).InsertDigestSubscription), subscription)
+}
+
+// MockSyncStatusDAO is a mock of SyncStatusDAO interface.
+type MockSyncStatusDAO struct {
+	ctrl     *gomock.Controller
+	recorder *MockSyncStatusDAOMockRecorder
+}
+
+// MockSyncStatusDAOMockRecorder is the mock recorder for MockSyncStatusDAO.
+type MockSyncStatusDAOMockRecorder struct {
+	mock *MockSyncStatusDAO
+}
+
+// NewMockSyncStatusDAO creates a new mock instance.
+func NewMockSyncStatusDAO(ctrl *gomock.Controller) *MockSyncStatusDAO {
+	mock := &MockSyncStatusDAO{ctrl: ctrl}
+	mock.recorder = &MockSyncStatusDAOMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSyncStatusDAO) EXPECT() *MockSyncStatusDAOMockRecorder {
+	return m.recorder
+}
+
+// GetSyncTime mocks base method.
+func (m *MockSyncStatusDAO) GetSyncTime(name string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncTime", name)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncTime indicates an expected call of GetSyncTime.
+func (mr *MockSyncStatusDAOMockRecorder) GetSyncTime(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncTime", reflect.TypeOf((*MockSyncStatusDAO)(nil).GetSyncTime), name)
+}
+
+// UpdateSyncTime mocks base method.
+func (m *MockSyncStatusDAO) UpdateSyncTime(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSyncTime", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSyncTime indicates an expected call of UpdateSyncTime.
+func (mr *MockSyncStatusDAOMockRecorder) UpdateSyncTime(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSyncTime", reflect.TypeOf((*MockSyncStatusDAO)(nil).UpdateSyncTime), name)
+}
+
 // MockRepository is a mock of Repository interface.
 type MockRepository struct {
 	ctrl     *gomock.Controller
@@ -296,6 +818,48 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// DeleteDigestSubscription mocks base method.
+func (m *MockRepository) DeleteDigestSubscription(unsubscribeToken string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDigestSubscription", unsubscribeToken)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDigestSubscription indicates an expected call of DeleteDigestSubscription.
+func (mr *MockRepositoryMockRecorder) DeleteDigestSubscription(unsubscribeToken any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDigestSubscription", reflect.TypeOf((*MockRepository)(nil).DeleteDigestSubscription), unsubscribeToken)
+}
+
+// DeleteScoringPlaysExcept mocks base method.
+func (m *MockRepository) DeleteScoringPlaysExcept(gameID string, playIDs []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScoringPlaysExcept", gameID, playIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteScoringPlaysExcept indicates an expected call of DeleteScoringPlaysExcept.
+func (mr *MockRepositoryMockRecorder) DeleteScoringPlaysExcept(gameID, playIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScoringPlaysExcept", reflect.TypeOf((*MockRepository)(nil).DeleteScoringPlaysExcept), gameID, playIDs)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockRepository) DeleteWebhook(id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockRepositoryMockRecorder) DeleteWebhook(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockRepository)(nil).DeleteWebhook), id)
+}
+
 // GetAllTeams mocks base method.
 func (m *MockRepository) GetAllTeams() ([]*Team, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +875,51 @@ func (mr *MockRepositoryMockRecorder) GetAllTeams() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllTeams", reflect.TypeOf((*MockRepository)(nil).GetAllTeams))
 }
 
+// GetDigestSentIDs mocks base method.
+func (m *MockRepository) GetDigestSentIDs(date time.Time) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSentIDs", date)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSentIDs indicates an expected call of GetDigestSentIDs.
+func (mr *MockRepositoryMockRecorder) GetDigestSentIDs(date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSentIDs", reflect.TypeOf((*MockRepository)(nil).GetDigestSentIDs), date)
+}
+
+// GetDigestSubscriptions mocks base method.
+func (m *MockRepository) GetDigestSubscriptions() ([]DigestSubscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDigestSubscriptions")
+	ret0, _ := ret[0].([]DigestSubscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDigestSubscriptions indicates an expected call of GetDigestSubscriptions.
+func (mr *MockRepositoryMockRecorder) GetDigestSubscriptions() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDigestSubscriptions", reflect.TypeOf((*MockRepository)(nil).GetDigestSubscriptions))
+}
+
+// GetFinalEntries mocks base method.
+func (m *MockRepository) GetFinalEntries(limit int) ([]FinalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFinalEntries", limit)
+	ret0, _ := ret[0].([]FinalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinalEntries indicates an expected call of GetFinalEntries.
+func (mr *MockRepositoryMockRecorder) GetFinalEntries(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinalEntries", reflect.TypeOf((*MockRepository)(nil).GetFinalEntries), limit)
+}
+
 // GetGame mocks base method.
 func (m *MockRepository) GetGame(gameID string) (Game, error) {
 	m.ctrl.T.Helper()
@@ -326,6 +935,21 @@ func (mr *MockRepositoryMockRecorder) GetGame(gameID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGame", reflect.TypeOf((*MockRepository)(nil).GetGame), gameID)
 }
 
+// GetGameFinalEntries mocks base method.
+func (m *MockRepository) GetGameFinalEntries(start, end time.Time) ([]GameFinalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameFinalEntries", start, end)
+	ret0, _ := ret[0].([]GameFinalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameFinalEntries indicates an expected call of GetGameFinalEntries.
+func (mr *MockRepositoryMockRecorder) GetGameFinalEntries(start, end any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameFinalEntries", reflect.TypeOf((*MockRepository)(nil).GetGameFinalEntries), start, end)
+}
+
 // GetGameTeamQuarterScore mocks base method.
 func (m *MockRepository) GetGameTeamQuarterScore(start time.Time, end *time.Time) ([]GameTeamQuarterScore, error) {
 	m.ctrl.T.Helper()
@@ -341,6 +965,21 @@ func (mr *MockRepositoryMockRecorder) GetGameTeamQuarterScore(start, end any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameTeamQuarterScore", reflect.TypeOf((*MockRepository)(nil).GetGameTeamQuarterScore), start, end)
 }
 
+// GetGameWithTeamAbv mocks base method.
+func (m *MockRepository) GetGameWithTeamAbv(gameID string) (Game, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGameWithTeamAbv", gameID)
+	ret0, _ := ret[0].(Game)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGameWithTeamAbv indicates an expected call of GetGameWithTeamAbv.
+func (mr *MockRepositoryMockRecorder) GetGameWithTeamAbv(gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGameWithTeamAbv", reflect.TypeOf((*MockRepository)(nil).GetGameWithTeamAbv), gameID)
+}
+
 // GetGames mocks base method.
 func (m *MockRepository) GetGames(start time.Time, end *time.Time) ([]Game, error) {
 	m.ctrl.T.Helper()
@@ -371,6 +1010,21 @@ func (mr *MockRepositoryMockRecorder) GetGamesWithTeamAbv(start, end any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGamesWithTeamAbv", reflect.TypeOf((*MockRepository)(nil).GetGamesWithTeamAbv), start, end)
 }
 
+// GetMLBFinalEntries mocks base method.
+func (m *MockRepository) GetMLBFinalEntries(limit int) ([]MLBFinalEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMLBFinalEntries", limit)
+	ret0, _ := ret[0].([]MLBFinalEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMLBFinalEntries indicates an expected call of GetMLBFinalEntries.
+func (mr *MockRepositoryMockRecorder) GetMLBFinalEntries(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMLBFinalEntries", reflect.TypeOf((*MockRepository)(nil).GetMLBFinalEntries), limit)
+}
+
 // GetQuarterScoreBy mocks base method.
 func (m *MockRepository) GetQuarterScoreBy(gameID, teamAbv, quarter string) (GameQuarterScore, error) {
 	m.ctrl.T.Helper()
@@ -386,6 +1040,66 @@ func (mr *MockRepositoryMockRecorder) GetQuarterScoreBy(gameID, teamAbv, quarter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarterScoreBy", reflect.TypeOf((*MockRepository)(nil).GetQuarterScoreBy), gameID, teamAbv, quarter)
 }
 
+// GetQuarterScoresForGames mocks base method.
+func (m *MockRepository) GetQuarterScoresForGames(gameIDs []string) ([]GameTeamQuarterScore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQuarterScoresForGames", gameIDs)
+	ret0, _ := ret[0].([]GameTeamQuarterScore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQuarterScoresForGames indicates an expected call of GetQuarterScoresForGames.
+func (mr *MockRepositoryMockRecorder) GetQuarterScoresForGames(gameIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQuarterScoresForGames", reflect.TypeOf((*MockRepository)(nil).GetQuarterScoresForGames), gameIDs)
+}
+
+// GetScoringPlays mocks base method.
+func (m *MockRepository) GetScoringPlays(gameID string) ([]ScoringPlay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoringPlays", gameID)
+	ret0, _ := ret[0].([]ScoringPlay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScoringPlays indicates an expected call of GetScoringPlays.
+func (mr *MockRepositoryMockRecorder) GetScoringPlays(gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoringPlays", reflect.TypeOf((*MockRepository)(nil).GetScoringPlays), gameID)
+}
+
+// GetSeasonResults mocks base method.
+func (m *MockRepository) GetSeasonResults(season, seasonType int) ([]GameResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSeasonResults", season, seasonType)
+	ret0, _ := ret[0].([]GameResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSeasonResults indicates an expected call of GetSeasonResults.
+func (mr *MockRepositoryMockRecorder) GetSeasonResults(season, seasonType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSeasonResults", reflect.TypeOf((*MockRepository)(nil).GetSeasonResults), season, seasonType)
+}
+
+// GetSyncTime mocks base method.
+func (m *MockRepository) GetSyncTime(name string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncTime", name)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSyncTime indicates an expected call of GetSyncTime.
+func (mr *MockRepositoryMockRecorder) GetSyncTime(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncTime", reflect.TypeOf((*MockRepository)(nil).GetSyncTime), name)
+}
+
 // GetTeamByAbv mocks base method.
 func (m *MockRepository) GetTeamByAbv(abbv string) (*Team, error) {
 	m.ctrl.T.Helper()
@@ -401,6 +1115,65 @@ func (mr *MockRepositoryMockRecorder) GetTeamByAbv(abbv any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamByAbv", reflect.TypeOf((*MockRepository)(nil).GetTeamByAbv), abbv)
 }
 
+// GetTeamSchedule mocks base method.
+func (m *MockRepository) GetTeamSchedule(abbreviation string, season int) ([]ScheduledGame, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTeamSchedule", abbreviation, season)
+	ret0, _ := ret[0].([]ScheduledGame)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTeamSchedule indicates an expected call of GetTeamSchedule.
+func (mr *MockRepositoryMockRecorder) GetTeamSchedule(abbreviation, season any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTeamSchedule", reflect.TypeOf((*MockRepository)(nil).GetTeamSchedule), abbreviation, season)
+}
+
+// GetWebhooks mocks base method.
+func (m *MockRepository) GetWebhooks() ([]Webhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhooks")
+	ret0, _ := ret[0].([]Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhooks indicates an expected call of GetWebhooks.
+func (mr *MockRepositoryMockRecorder) GetWebhooks() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhooks", reflect.TypeOf((*MockRepository)(nil).GetWebhooks))
+}
+
+// InsertDigestSent mocks base method.
+func (m *MockRepository) InsertDigestSent(subscriptionID uuid.UUID, date time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDigestSent", subscriptionID, date)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertDigestSent indicates an expected call of InsertDigestSent.
+func (mr *MockRepositoryMockRecorder) InsertDigestSent(subscriptionID, date any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDigestSent", reflect.TypeOf((*MockRepository)(nil).InsertDigestSent), subscriptionID, date)
+}
+
+// InsertDigestSubscription mocks base method.
+func (m *MockRepository) InsertDigestSubscription(subscription DigestSubscription) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertDigestSubscription", subscription)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertDigestSubscription indicates an expected call of InsertDigestSubscription.
+func (mr *MockRepositoryMockRecorder) InsertDigestSubscription(subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertDigestSubscription", reflect.TypeOf((*MockRepository)(nil).InsertDigestSubscription), subscription)
+}
+
 // InsertGame mocks base method.
 func (m *MockRepository) InsertGame(game Game) error {
 	m.ctrl.T.Helper()
@@ -429,6 +1202,49 @@ func (mr *MockRepositoryMockRecorder) InsertQuarterScore(quarterScore any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertQuarterScore", reflect.TypeOf((*MockRepository)(nil).InsertQuarterScore), quarterScore)
 }
 
+// InsertWebhook mocks base method.
+func (m *MockRepository) InsertWebhook(webhook Webhook) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWebhook", webhook)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InsertWebhook indicates an expected call of InsertWebhook.
+func (mr *MockRepositoryMockRecorder) InsertWebhook(webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWebhook", reflect.TypeOf((*MockRepository)(nil).InsertWebhook), webhook)
+}
+
+// InsertWebhookDeadLetter mocks base method.
+func (m *MockRepository) InsertWebhookDeadLetter(letter WebhookDeadLetter) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InsertWebhookDeadLetter", letter)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InsertWebhookDeadLetter indicates an expected call of InsertWebhookDeadLetter.
+func (mr *MockRepositoryMockRecorder) InsertWebhookDeadLetter(letter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InsertWebhookDeadLetter", reflect.TypeOf((*MockRepository)(nil).InsertWebhookDeadLetter), letter)
+}
+
+// NotifyGameChange mocks base method.
+func (m *MockRepository) NotifyGameChange(gameID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyGameChange", gameID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyGameChange indicates an expected call of NotifyGameChange.
+func (mr *MockRepositoryMockRecorder) NotifyGameChange(gameID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyGameChange", reflect.TypeOf((*MockRepository)(nil).NotifyGameChange), gameID)
+}
+
 // UpdateGameClock mocks base method.
 func (m *MockRepository) UpdateGameClock(gameID, gameClock string) error {
 	m.ctrl.T.Helper()
@@ -443,6 +1259,48 @@ func (mr *MockRepositoryMockRecorder) UpdateGameClock(gameID, gameClock any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameClock", reflect.TypeOf((*MockRepository)(nil).UpdateGameClock), gameID, gameClock)
 }
 
+// UpdateGameRecords mocks base method.
+func (m *MockRepository) UpdateGameRecords(gameID, awayRecord, homeRecord string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameRecords", gameID, awayRecord, homeRecord)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameRecords indicates an expected call of UpdateGameRecords.
+func (mr *MockRepositoryMockRecorder) UpdateGameRecords(gameID, awayRecord, homeRecord any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameRecords", reflect.TypeOf((*MockRepository)(nil).UpdateGameRecords), gameID, awayRecord, homeRecord)
+}
+
+// UpdateGameSeason mocks base method.
+func (m *MockRepository) UpdateGameSeason(gameID string, season, seasonType int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameSeason", gameID, season, seasonType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameSeason indicates an expected call of UpdateGameSeason.
+func (mr *MockRepositoryMockRecorder) UpdateGameSeason(gameID, season, seasonType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSeason", reflect.TypeOf((*MockRepository)(nil).UpdateGameSeason), gameID, season, seasonType)
+}
+
+// UpdateGameSituation mocks base method.
+func (m *MockRepository) UpdateGameSituation(gameID string, situation GameSituation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGameSituation", gameID, situation)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateGameSituation indicates an expected call of UpdateGameSituation.
+func (mr *MockRepositoryMockRecorder) UpdateGameSituation(gameID, situation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGameSituation", reflect.TypeOf((*MockRepository)(nil).UpdateGameSituation), gameID, situation)
+}
+
 // UpdateGameTime mocks base method.
 func (m *MockRepository) UpdateGameTime(gameID string, gameClock time.Time) error {
 	m.ctrl.T.Helper()
@@ -484,3 +1342,73 @@ func (mr *MockRepositoryMockRecorder) UpdateQuarterScore(score, gameID, teamAbv,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuarterScore", reflect.TypeOf((*MockRepository)(nil).UpdateQuarterScore), score, gameID, teamAbv, quarter)
 }
+
+// UpdateSyncTime mocks base method.
+func (m *MockRepository) UpdateSyncTime(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSyncTime", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSyncTime indicates an expected call of UpdateSyncTime.
+func (mr *MockRepositoryMockRecorder) UpdateSyncTime(name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSyncTime", reflect.TypeOf((*MockRepository)(nil).UpdateSyncTime), name)
+}
+
+// UpdateTeamColors mocks base method.
+func (m *MockRepository) UpdateTeamColors(abbv, color, altColor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTeamColors", abbv, color, altColor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTeamColors indicates an expected call of UpdateTeamColors.
+func (mr *MockRepositoryMockRecorder) UpdateTeamColors(abbv, color, altColor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTeamColors", reflect.TypeOf((*MockRepository)(nil).UpdateTeamColors), abbv, color, altColor)
+}
+
+// UpsertFinalEntry mocks base method.
+func (m *MockRepository) UpsertFinalEntry(entry FinalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertFinalEntry", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertFinalEntry indicates an expected call of UpsertFinalEntry.
+func (mr *MockRepositoryMockRecorder) UpsertFinalEntry(entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertFinalEntry", reflect.TypeOf((*MockRepository)(nil).UpsertFinalEntry), entry)
+}
+
+// UpsertMLBFinalEntry mocks base method.
+func (m *MockRepository) UpsertMLBFinalEntry(entry MLBFinalEntry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertMLBFinalEntry", entry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertMLBFinalEntry indicates an expected call of UpsertMLBFinalEntry.
+func (mr *MockRepositoryMockRecorder) UpsertMLBFinalEntry(entry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertMLBFinalEntry", reflect.TypeOf((*MockRepository)(nil).UpsertMLBFinalEntry), entry)
+}
+
+// UpsertScoringPlay mocks base method.
+func (m *MockRepository) UpsertScoringPlay(play ScoringPlay) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertScoringPlay", play)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertScoringPlay indicates an expected call of UpsertScoringPlay.
+func (mr *MockRepositoryMockRecorder) UpsertScoringPlay(play any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertScoringPlay", reflect.TypeOf((*MockRepository)(nil).UpsertScoringPlay), play)
+}
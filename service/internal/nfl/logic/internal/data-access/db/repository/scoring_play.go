@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+)
+
+var _ ScoringPlayDAO = &ScoringPlayDAOImpl{}
+
+type ScoringPlayDAOImpl struct {
+	logger zerolog.Logger
+	db     *sqlx.DB
+}
+
+func NewScoringPlayDAOImpl(logger zerolog.Logger, db *sqlx.DB) *ScoringPlayDAOImpl {
+	return &ScoringPlayDAOImpl{
+		logger: logger.With().Str("repo", "ScoringPlayDAO").Logger(),
+		db:     db,
+	}
+}
+
+// language=sql
+const upsertScoringPlayStmt = `insert into scoring_play (id, game_id, team_id, sequence, quarter, clock, type, description, away_score, home_score)
+values (:id, :game_id, :team_id, :sequence, :quarter, :clock, :type, :description, :away_score, :home_score)
+on conflict (id) do update set team_id = excluded.team_id, sequence = excluded.sequence, quarter = excluded.quarter,
+    clock = excluded.clock, type = excluded.type, description = excluded.description, away_score = excluded.away_score,
+    home_score = excluded.home_score, deleted_at = null;`
+
+// UpsertScoringPlay inserts a scoring play or replaces the play with the same id when ESPN revises it.
+func (s *ScoringPlayDAOImpl) UpsertScoringPlay(play ScoringPlay) error {
+	logger := s.logger.With().Str("method", "UpsertScoringPlay").Logger()
+	logger.Info().Msgf("upserting scoring play: %+v", play)
+
+	_, err := s.db.NamedExec(upsertScoringPlayStmt, &play)
+	if err != nil {
+		return errors.Join(err, ErrUpsertScoringPlay)
+	}
+
+	return nil
+}
+
+const deleteScoringPlaysExceptStmt = "UPDATE SCORING_PLAY SET deleted_at = now() WHERE game_id = $1 AND deleted_at is null AND NOT (id = ANY($2))"
+
+// DeleteScoringPlaysExcept removes the scoring plays of a game that are not in playIDs, as when ESPN drops an
+// overturned play.
+func (s *ScoringPlayDAOImpl) DeleteScoringPlaysExcept(gameID string, playIDs []string) error {
+	logger := s.logger.With().Str("method", "DeleteScoringPlaysExcept").Logger()
+	logger.Info().Msgf("deleting scoring plays of game %s not in %v", gameID, playIDs)
+
+	_, err := s.db.Exec(deleteScoringPlaysExceptStmt, gameID, pq.Array(playIDs))
+	if err != nil {
+		return errors.Join(err, ErrDeleteScoringPlays)
+	}
+
+	return nil
+}
+
+// language=sql
+const getScoringPlaysStmt = `select sp.id, sp.game_id, sp.team_id, t.abbreviation as team_abbreviation, sp.sequence, sp.quarter,
+    sp.clock, sp.type, sp.description, sp.away_score, sp.home_score, sp.created_at, sp.updated_at, sp.deleted_at
+from scoring_play sp
+         inner join team t on t.id = sp.team_id
+where sp.game_id = $1 and sp.deleted_at is null
+order by sp.sequence`
+
+// GetScoringPlays returns the scoring plays of a game in the order they happened.
+func (s *ScoringPlayDAOImpl) GetScoringPlays(gameID string) ([]ScoringPlay, error) {
+	logger := s.logger.With().Str("method", "GetScoringPlays").Logger()
+	logger.Info().Msgf("getting scoring plays for game %s", gameID)
+
+	var plays []ScoringPlay
+	err := s.db.Select(&plays, getScoringPlaysStmt, gameID)
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return plays, nil
+}
@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestScoringPlayDAOImpl_UpsertScoringPlay(t *testing.T) {
+	teamID := uuid.New()
+	play := ScoringPlay{
+		ID:          "4015473531",
+		GameID:      "401547353",
+		TeamID:      teamID,
+		Sequence:    2,
+		Quarter:     "2",
+		Clock:       "7:32",
+		Type:        "TD",
+		Description: "Kelce 12 yd pass",
+		AwayScore:   14,
+		HomeScore:   3,
+	}
+
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into scoring_play")).
+					WithArgs("4015473531", "401547353", teamID, 2, "2", "7:32", "TD", "Kelce 12 yd pass", 14, 3).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into scoring_play")).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpsertScoringPlay,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &ScoringPlayDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpsertScoringPlay(play)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestScoringPlayDAOImpl_DeleteScoringPlaysExcept(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteScoringPlaysExceptStmt)).
+					WithArgs("401547353", pq.Array([]string{"1", "2"})).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteScoringPlaysExceptStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrDeleteScoringPlays,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &ScoringPlayDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.DeleteScoringPlaysExcept("401547353", []string{"1", "2"})
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestScoringPlayDAOImpl_GetScoringPlays(t *testing.T) {
+	now := time.Now()
+	buf, kc := uuid.New(), uuid.New()
+	columns := []string{"id", "game_id", "team_id", "team_abbreviation", "sequence", "quarter", "clock", "type", "description",
+		"away_score", "home_score", "created_at", "updated_at", "deleted_at"}
+
+	testCases := map[string]struct {
+		mockDB        func(sqlMock sqlmock.Sqlmock)
+		expectedPlays []ScoringPlay
+		expectedErr   error
+	}{
+		"should get plays in order": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns).
+					AddRow("1", "401547353", buf, "BUF", 0, "1", "3:10", "FG", "Bass 45 yd field goal", 0, 3, now, now, nil).
+					AddRow("2", "401547353", kc, "KC", 1, "2", "7:32", "TD", "Kelce 12 yd pass", 7, 3, now, now, nil)
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getScoringPlaysStmt)).WithArgs("401547353").WillReturnRows(rows)
+			},
+			expectedPlays: []ScoringPlay{
+				{ID: "1", GameID: "401547353", TeamID: buf, TeamAbbreviation: "BUF", Sequence: 0, Quarter: "1", Clock: "3:10", Type: "FG",
+					Description: "Bass 45 yd field goal", AwayScore: 0, HomeScore: 3, CreatedAt: now, UpdatedAt: now},
+				{ID: "2", GameID: "401547353", TeamID: kc, TeamAbbreviation: "KC", Sequence: 1, Quarter: "2", Clock: "7:32", Type: "TD",
+					Description: "Kelce 12 yd pass", AwayScore: 7, HomeScore: 3, CreatedAt: now, UpdatedAt: now},
+			},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getScoringPlaysStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &ScoringPlayDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			plays, err := dao.GetScoringPlays("401547353")
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedPlays, plays)
+		})
+	}
+}
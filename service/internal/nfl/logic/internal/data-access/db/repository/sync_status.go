@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"database/sql"
+	"errors"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+// SyncGameInfo is the sync status the scheduler records as it fetches the info of games in progress.
+const SyncGameInfo = "game_info"
+
+var _ SyncStatusDAO = &SyncStatusDAOImpl{}
+
+type SyncStatusDAOImpl struct {
+	logger zerolog.Logger
+	db     *sqlx.DB
+}
+
+func NewSyncStatusDAOImpl(logger zerolog.Logger, db *sqlx.DB) *SyncStatusDAOImpl {
+	return &SyncStatusDAOImpl{
+		logger: logger.With().Str("repo", "SyncStatusDAO").Logger(),
+		db:     db,
+	}
+}
+
+// language=sql
+const updateSyncTimeStmt = `insert into sync_status (name, synced_at) values ($1, now())
+on conflict (name) do update set synced_at = excluded.synced_at`
+
+// UpdateSyncTime records that name was synchronized now.
+func (s *SyncStatusDAOImpl) UpdateSyncTime(name string) error {
+	logger := s.logger.With().Str("method", "UpdateSyncTime").Logger()
+	logger.Debug().Msgf("updating sync time of %s", name)
+
+	_, err := s.db.Exec(updateSyncTimeStmt, name)
+	if err != nil {
+		return errors.Join(err, ErrUpdateSyncStatus)
+	}
+
+	return nil
+}
+
+const getSyncTimeStmt = "select synced_at from sync_status where name = $1"
+
+// GetSyncTime returns when name was last synchronized, ErrNoSyncStatus when it never has been.
+func (s *SyncStatusDAOImpl) GetSyncTime(name string) (time.Time, error) {
+	logger := s.logger.With().Str("method", "GetSyncTime").Logger()
+
+	var syncedAt time.Time
+	err := s.db.Get(&syncedAt, getSyncTimeStmt, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, ErrNoSyncStatus
+	}
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return time.Time{}, errors.Join(err, ErrSqlError)
+	}
+
+	return syncedAt, nil
+}
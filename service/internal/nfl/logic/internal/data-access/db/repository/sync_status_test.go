@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestSyncStatusDAOImpl_UpdateSyncTime(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into sync_status")).
+					WithArgs(SyncGameInfo).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into sync_status")).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrUpdateSyncStatus,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &SyncStatusDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.UpdateSyncTime(SyncGameInfo)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSyncStatusDAOImpl_GetSyncTime(t *testing.T) {
+	syncedAt := time.Date(2023, 9, 11, 1, 30, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockDB           func(sqlMock sqlmock.Sqlmock)
+		expectedSyncedAt time.Time
+		expectedErr      error
+	}{
+		"should get the sync time": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows([]string{"synced_at"}).AddRow(syncedAt)
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getSyncTimeStmt)).WithArgs(SyncGameInfo).WillReturnRows(rows)
+			},
+			expectedSyncedAt: syncedAt,
+		},
+		"should return no sync status when never synced": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getSyncTimeStmt)).WillReturnError(sql.ErrNoRows)
+			},
+			expectedErr: ErrNoSyncStatus,
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getSyncTimeStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &SyncStatusDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			syncedAt, err := dao.GetSyncTime(SyncGameInfo)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedSyncedAt, syncedAt)
+		})
+	}
+}
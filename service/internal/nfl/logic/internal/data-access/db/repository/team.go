@@ -18,7 +18,7 @@ func NewTeamDAOImpl(logger zerolog.Logger, db *sqlx.DB) *TeamDAOImpl {
 	}
 }
 
-const getTeamByAbvStatement = `SELECT ID, NAME, ABBREVIATION, CREATED_AT, UPDATED_AT, DELETED_AT FROM TEAM WHERE ABBREVIATION = $1 AND DELETED_AT IS NULL`
+const getTeamByAbvStatement = `SELECT ID, NAME, ABBREVIATION, CONFERENCE, DIVISION, CREATED_AT, UPDATED_AT, DELETED_AT FROM TEAM WHERE ABBREVIATION = $1 AND DELETED_AT IS NULL`
 
 func (t *TeamDAOImpl) GetTeamByAbv(abbv string) (*Team, error) {
 	t.logger.Printf("getting team for: %s", abbv)
@@ -38,7 +38,7 @@ func (t *TeamDAOImpl) GetTeamByAbv(abbv string) (*Team, error) {
 	return team, nil
 }
 
-const getAllTeamsStmt = `SELECT ID, NAME, ABBREVIATION, CREATED_AT, UPDATED_AT, DELETED_AT FROM TEAM WHERE DELETED_AT IS NULL `
+const getAllTeamsStmt = `SELECT ID, NAME, ABBREVIATION, CONFERENCE, DIVISION, CREATED_AT, UPDATED_AT, DELETED_AT FROM TEAM WHERE DELETED_AT IS NULL `
 
 func (t *TeamDAOImpl) GetAllTeams() ([]*Team, error) {
 	t.logger.Printf("getting all teams")
@@ -57,3 +57,17 @@ func (t *TeamDAOImpl) GetAllTeams() ([]*Team, error) {
 
 	return teams, nil
 }
+
+const updateTeamColorsStmt = `UPDATE TEAM SET COLOR = $1, ALT_COLOR = $2, UPDATED_AT = NOW() WHERE ABBREVIATION = $3 AND DELETED_AT IS NULL`
+
+func (t *TeamDAOImpl) UpdateTeamColors(abbv string, color string, altColor string) error {
+	logger := t.logger.With().Str("method", "UpdateTeamColors").Logger()
+	logger.Info().Msgf("updating colors for %s to %s, %s", abbv, color, altColor)
+
+	_, err := t.db.Exec(updateTeamColorsStmt, color, altColor, abbv)
+	if err != nil {
+		logger.Error().Err(err).Msg("sql error")
+		return ErrSqlError
+	}
+	return nil
+}
@@ -147,3 +147,44 @@ func TestTeamDAOImpl_GetAllTeams(t *testing.T) {
 	}
 
 }
+
+func TestTeamDAOImpl_UpdateTeamColors(t *testing.T) {
+
+	testCases := map[string]struct {
+		mockDB func(db *sql.DB, sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should update team colors": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateTeamColorsStmt)).
+					WithArgs("e31837", "ffb612", "KC").
+					WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			err: nil,
+		},
+		"sql error": {
+			mockDB: func(db *sql.DB, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(updateTeamColorsStmt)).WillReturnError(errors.New("whoops"))
+			},
+			err: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(db, mock)
+			dao := &TeamDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+
+			err = dao.UpdateTeamColors("KC", "e31837", "ffb612")
+
+			assert.Equal(t, tc.err, err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package repository
+
+import (
+	"errors"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/rs/zerolog"
+)
+
+var _ WebhookDAO = &WebhookDAOImpl{}
+
+type WebhookDAOImpl struct {
+	logger zerolog.Logger
+	db     *sqlx.DB
+}
+
+func NewWebhookDAOImpl(logger zerolog.Logger, db *sqlx.DB) *WebhookDAOImpl {
+	return &WebhookDAOImpl{
+		logger: logger.With().Str("repo", "WebhookDAO").Logger(),
+		db:     db,
+	}
+}
+
+const insertWebhookStmt = "insert into webhook (url, secret, sports, teams, event_types) values ($1, $2, $3, $4, $5) returning id"
+
+// InsertWebhook registers a webhook and returns its id.
+func (w *WebhookDAOImpl) InsertWebhook(webhook Webhook) (uuid.UUID, error) {
+	logger := w.logger.With().Str("method", "InsertWebhook").Logger()
+	logger.Info().Msgf("inserting webhook for %s", webhook.URL)
+
+	var id uuid.UUID
+	err := w.db.QueryRowx(insertWebhookStmt, webhook.URL, webhook.Secret, webhook.Sports, webhook.Teams, webhook.EventTypes).Scan(&id)
+	if err != nil {
+		return uuid.Nil, errors.Join(err, ErrInsertWebhook)
+	}
+
+	return id, nil
+}
+
+const getWebhooksStmt = "select id, url, secret, sports, teams, event_types, created_at, updated_at, deleted_at from webhook where deleted_at is null order by created_at"
+
+// GetWebhooks returns the registered webhooks, oldest first.
+func (w *WebhookDAOImpl) GetWebhooks() ([]Webhook, error) {
+	logger := w.logger.With().Str("method", "GetWebhooks").Logger()
+
+	var webhooks []Webhook
+	err := w.db.Select(&webhooks, getWebhooksStmt)
+	if err != nil {
+		logger.Info().Msgf("sql error: %s", err)
+		return nil, errors.Join(err, ErrSqlError)
+	}
+
+	return webhooks, nil
+}
+
+const deleteWebhookStmt = "UPDATE WEBHOOK SET deleted_at = now() WHERE id = $1 AND deleted_at is null"
+
+// DeleteWebhook unregisters a webhook. Its dead letters are kept. ErrNoWebhook is returned when there is no
+// webhook with id.
+func (w *WebhookDAOImpl) DeleteWebhook(id uuid.UUID) error {
+	logger := w.logger.With().Str("method", "DeleteWebhook").Logger()
+	logger.Info().Msgf("deleting webhook %s", id)
+
+	result, err := w.db.Exec(deleteWebhookStmt, id)
+	if err != nil {
+		return errors.Join(err, ErrDeleteWebhook)
+	}
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		return errors.Join(err, ErrDeleteWebhook)
+	}
+	if deleted == 0 {
+		return ErrNoWebhook
+	}
+
+	return nil
+}
+
+// language=sql
+const insertWebhookDeadLetterStmt = `insert into webhook_dead_letter (webhook_id, event_id, event_type, payload, attempts, last_error)
+values (:webhook_id, :event_id, :event_type, :payload, :attempts, :last_error)`
+
+// InsertWebhookDeadLetter stores a delivery that failed every attempt.
+func (w *WebhookDAOImpl) InsertWebhookDeadLetter(letter WebhookDeadLetter) error {
+	logger := w.logger.With().Str("method", "InsertWebhookDeadLetter").Logger()
+	logger.Info().Msgf("dead lettering event %s for webhook %s", letter.EventID, letter.WebhookID)
+
+	_, err := w.db.NamedExec(insertWebhookDeadLetterStmt, &letter)
+	if err != nil {
+		return errors.Join(err, ErrInsertWebhookDeadLetter)
+	}
+
+	return nil
+}
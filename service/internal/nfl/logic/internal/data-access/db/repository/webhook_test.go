@@ -0,0 +1,200 @@
+package repository
+
+import (
+	"database/sql"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"regexp"
+	"testing"
+	"time"
+)
+
+var testWebhookID = uuid.MustParse("6f1c2a52-0d0e-4d1a-9c55-3b1c1e8f7a10")
+
+func TestWebhookDAOImpl_InsertWebhook(t *testing.T) {
+	webhook := Webhook{
+		URL:        "http://localhost:8081/",
+		Secret:     "s3cret",
+		Sports:     pq.StringArray{"nfl"},
+		Teams:      pq.StringArray{"BUF"},
+		EventTypes: pq.StringArray{},
+	}
+
+	testCases := map[string]struct {
+		mockDB      func(sqlMock sqlmock.Sqlmock)
+		expectedID  uuid.UUID
+		expectedErr error
+	}{
+		"should return the id of the webhook": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(insertWebhookStmt)).
+					WithArgs("http://localhost:8081/", "s3cret", pq.StringArray{"nfl"}, pq.StringArray{"BUF"}, pq.StringArray{}).
+					WillReturnRows(sqlMock.NewRows([]string{"id"}).AddRow(testWebhookID.String()))
+			},
+			expectedID: testWebhookID,
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(insertWebhookStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrInsertWebhook,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &WebhookDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			id, err := dao.InsertWebhook(webhook)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedID, id)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookDAOImpl_GetWebhooks(t *testing.T) {
+	now := time.Now()
+	columns := []string{"id", "url", "secret", "sports", "teams", "event_types", "created_at", "updated_at", "deleted_at"}
+
+	testCases := map[string]struct {
+		mockDB           func(sqlMock sqlmock.Sqlmock)
+		expectedWebhooks []Webhook
+		expectedErr      error
+	}{
+		"should get webhooks with their filters": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				rows := sqlMock.NewRows(columns).
+					AddRow(testWebhookID.String(), "http://localhost:8081/", "s3cret", "{nfl}", "{BUF,MIA}", "{}", now, now, nil)
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getWebhooksStmt)).WillReturnRows(rows)
+			},
+			expectedWebhooks: []Webhook{
+				{ID: testWebhookID, URL: "http://localhost:8081/", Secret: "s3cret", Sports: pq.StringArray{"nfl"},
+					Teams: pq.StringArray{"BUF", "MIA"}, EventTypes: pq.StringArray{}, CreatedAt: now, UpdatedAt: now},
+			},
+		},
+		"should return sql error": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectQuery(regexp.QuoteMeta(getWebhooksStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			expectedErr: ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &WebhookDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			webhooks, err := dao.GetWebhooks()
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedWebhooks, webhooks)
+		})
+	}
+}
+
+func TestWebhookDAOImpl_DeleteWebhook(t *testing.T) {
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when a webhook is deleted": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteWebhookStmt)).WithArgs(testWebhookID).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+		},
+		"should return ErrNoWebhook when there is no webhook": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteWebhookStmt)).WithArgs(testWebhookID).WillReturnResult(sqlmock.NewResult(0, 0))
+			},
+			err: ErrNoWebhook,
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta(deleteWebhookStmt)).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrDeleteWebhook,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &WebhookDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.DeleteWebhook(testWebhookID)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestWebhookDAOImpl_InsertWebhookDeadLetter(t *testing.T) {
+	letter := WebhookDeadLetter{
+		WebhookID: testWebhookID,
+		EventID:   "401547353-final",
+		EventType: "final",
+		Payload:   `{"id":"401547353-final"}`,
+		Attempts:  4,
+		LastError: "receiver answered 503 Service Unavailable",
+	}
+
+	testCases := map[string]struct {
+		mockDB func(sqlMock sqlmock.Sqlmock)
+		err    error
+	}{
+		"should return nil error when successful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into webhook_dead_letter")).
+					WithArgs(testWebhookID, "401547353-final", "final", `{"id":"401547353-final"}`, 4, "receiver answered 503 Service Unavailable").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+			},
+		},
+		"should return error when unsuccessful": {
+			mockDB: func(sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectExec(regexp.QuoteMeta("insert into webhook_dead_letter")).WillReturnError(sql.ErrConnDone)
+			},
+			err: ErrInsertWebhookDeadLetter,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			assert.NoError(t, err)
+
+			tc.mockDB(mock)
+			dao := &WebhookDAOImpl{
+				logger: zerolog.Nop(),
+				db:     sqlx.NewDb(db, "postgres"),
+			}
+			err = dao.InsertWebhookDeadLetter(letter)
+
+			assert.ErrorIs(t, err, tc.err)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
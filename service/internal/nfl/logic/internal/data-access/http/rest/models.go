@@ -16,6 +16,30 @@ type Event struct {
 			ShortDetail string `json:"shortDetail"`
 		} `json:"type"`
 	} `json:"status"`
+	Competitions []Competition `json:"competitions"`
+}
+
+type Competition struct {
+	Competitors []Competitor `json:"competitors"`
+	// Situation is only sent for games in progress.
+	Situation *Situation `json:"situation,omitempty"`
+}
+
+type Competitor struct {
+	// ID is the ESPN team id.
+	ID       string `json:"id"`
+	HomeAway string `json:"homeAway"`
+	Team     struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"team"`
+}
+
+type Situation struct {
+	DownDistanceText string `json:"downDistanceText"`
+	// Possession is the ESPN team id of the team with the ball.
+	Possession   string `json:"possession"`
+	AwayTimeouts int    `json:"awayTimeouts"`
+	HomeTimeouts int    `json:"homeTimeouts"`
 }
 
 type Content struct {
@@ -27,3 +51,33 @@ type Content struct {
 type ScoreboardResponse struct {
 	Content Content `json:"content"`
 }
+
+// ScoringPlay is a play that scored from ESPN's game summary.
+type ScoringPlay struct {
+	// ID is ESPN's play id. It stays the same when ESPN revises a play.
+	ID   string `json:"id"`
+	Type struct {
+		Text         string `json:"text"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"type"`
+	Text      string `json:"text"`
+	AwayScore int    `json:"awayScore"`
+	HomeScore int    `json:"homeScore"`
+	Period    struct {
+		Number int `json:"number"`
+	} `json:"period"`
+	Clock struct {
+		DisplayValue string `json:"displayValue"`
+	} `json:"clock"`
+	Team struct {
+		Abbreviation string `json:"abbreviation"`
+	} `json:"team"`
+	ScoringType struct {
+		Name         string `json:"name"`
+		Abbreviation string `json:"abbreviation"`
+	} `json:"scoringType"`
+}
+
+type SummaryResponse struct {
+	ScoringPlays []ScoringPlay `json:"scoringPlays"`
+}
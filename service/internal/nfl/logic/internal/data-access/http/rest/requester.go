@@ -3,17 +3,21 @@ package rest
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
 	"github.com/rs/zerolog"
 	"net/http"
 )
 
 const (
 	scoreboardURL = "https://cdn.espn.com/core/nfl/scoreboard?xhr=1&limit=50"
+	summaryURL    = "https://site.api.espn.com/apis/site/v2/sports/football/nfl/summary?event=%s"
 )
+
 //go:generate mockgen -destination ./schedule_requestor_mock.go -package rest . Requester
 type (
 	Requester interface {
 		GetScoreboard() (ScoreboardResponse, error)
+		GetScoringPlays(gameID string) ([]ScoringPlay, error)
 	}
 	RequesterImpl struct {
 		logger     zerolog.Logger
@@ -39,10 +43,16 @@ func (r *RequesterImpl) GetScoreboard() (ScoreboardResponse, error) {
 		return ScoreboardResponse{}, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		logger.Error().Msgf("status code %d while making request to %s", resp.StatusCode, scoreboardURL)
-		return ScoreboardResponse{}, fmt.Errorf("cannot continue. response code: %d", resp.StatusCode)
+	if err := httpclient.CheckResponse(resp); err != nil {
+		logger.Error().Err(err).Msgf("while making request to %s", scoreboardURL)
+		return ScoreboardResponse{}, err
 	}
+	defer func() {
+		err := resp.Body.Close()
+		if err != nil {
+			logger.Error().Err(err).Msg("While closing response body")
+		}
+	}()
 
 	var scoreboardResp ScoreboardResponse
 	err = json.NewDecoder(resp.Body).Decode(&scoreboardResp)
@@ -50,6 +60,27 @@ func (r *RequesterImpl) GetScoreboard() (ScoreboardResponse, error) {
 		logger.Error().Err(err).Msg("while decoding response")
 		return ScoreboardResponse{}, err
 	}
+
+	return scoreboardResp, nil
+
+}
+
+// GetScoringPlays returns the scoring plays of a game in the order they happened.
+func (r *RequesterImpl) GetScoringPlays(gameID string) ([]ScoringPlay, error) {
+	logger := r.logger.With().Str("method", "GetScoringPlays").Logger()
+	logger.Info().Msgf("getting scoring plays for %s", gameID)
+
+	url := fmt.Sprintf(summaryURL, gameID)
+	resp, err := r.httpClient.Get(url)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while making request to: %s", url)
+		return nil, err
+	}
+
+	if err := httpclient.CheckResponse(resp); err != nil {
+		logger.Error().Err(err).Msgf("while making request to %s", url)
+		return nil, err
+	}
 	defer func() {
 		err := resp.Body.Close()
 		if err != nil {
@@ -57,6 +88,12 @@ func (r *RequesterImpl) GetScoreboard() (ScoreboardResponse, error) {
 		}
 	}()
 
-	return scoreboardResp, nil
+	var summary SummaryResponse
+	err = json.NewDecoder(resp.Body).Decode(&summary)
+	if err != nil {
+		logger.Error().Err(err).Msg("while decoding response")
+		return nil, err
+	}
 
+	return summary.ScoringPlays, nil
 }
@@ -51,3 +51,18 @@ func (mr *MockRequesterMockRecorder) GetScoreboard() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoreboard", reflect.TypeOf((*MockRequester)(nil).GetScoreboard))
 }
+
+// GetScoringPlays mocks base method.
+func (m *MockRequester) GetScoringPlays(arg0 string) ([]ScoringPlay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScoringPlays", arg0)
+	ret0, _ := ret[0].([]ScoringPlay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScoringPlays indicates an expected call of GetScoringPlays.
+func (mr *MockRequesterMockRecorder) GetScoringPlays(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScoringPlays", reflect.TypeOf((*MockRequester)(nil).GetScoringPlays), arg0)
+}
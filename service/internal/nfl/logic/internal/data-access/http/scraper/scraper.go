@@ -3,6 +3,7 @@ package scraper
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
 	"io"
 	"net/http"
 	"regexp"
@@ -39,14 +40,9 @@ func (s *Scraper) FetchSchedule() (BySeasonType, error) {
 		return nil, fmt.Errorf("unable to create request for %s: %w", url, err)
 	}
 
-	res, err := s.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("unable to make request for %s: %w", url, err)
-	}
-
-	bytes, err := io.ReadAll(res.Body)
+	bytes, err := s.get(req)
 	if err != nil {
-		return nil, fmt.Errorf("unable to read bytes from request %s: %w", url, err)
+		return nil, err
 	}
 
 	return findWeeksFromBytes(bytes)
@@ -100,16 +96,10 @@ func (s *Scraper) FetchGamesForWeek(week Week) (Games, error) {
 		return Games(nil), fmt.Errorf("unable to create request for %s: %w", url, err)
 	}
 
-	res, err := s.httpClient.Do(req)
-	if err != nil {
-		return Games(nil), fmt.Errorf("unable to make request for %s: %w", url, err)
-	}
-
-	bytes, err := io.ReadAll(res.Body)
+	bytes, err := s.get(req)
 	if err != nil {
-		return Games(nil), fmt.Errorf("unable to read bytes from request %s: %w", url, err)
+		return Games(nil), err
 	}
-	res.Body.Close()
 	return findGamesFromBytes(bytes)
 }
 func findGamesFromBytes(bArr []byte) (Games, error) {
@@ -132,16 +122,31 @@ func (s *Scraper) FetchGameInfo(gameID string) (GameInfo, error) {
 		return GameInfo{}, fmt.Errorf("unable to create request for %s: %w", url, err)
 	}
 
+	bytes, err := s.get(req)
+	if err != nil {
+		return GameInfo{}, err
+	}
+	return findGameInfoFromBytes(bytes)
+}
+
+// get performs req and returns the body of a successful response. The body is always closed.
+func (s *Scraper) get(req *http.Request) ([]byte, error) {
+	url := req.URL.String()
+
 	res, err := s.httpClient.Do(req)
 	if err != nil {
-		return GameInfo{}, fmt.Errorf("unable to make request for %s: %w", url, err)
+		return nil, fmt.Errorf("unable to make request for %s: %w", url, err)
+	}
+	if err := httpclient.CheckResponse(res); err != nil {
+		return nil, fmt.Errorf("unable to make request for %s: %w", url, err)
 	}
+	defer res.Body.Close()
 
 	bytes, err := io.ReadAll(res.Body)
 	if err != nil {
-		return GameInfo{}, fmt.Errorf("unable to read bytes from request %s: %w", url, err)
+		return nil, fmt.Errorf("unable to read bytes from request %s: %w", url, err)
 	}
-	return findGameInfoFromBytes(bytes)
+	return bytes, nil
 }
 
 func findGameInfoFromBytes(bArr []byte) (GameInfo, error) {
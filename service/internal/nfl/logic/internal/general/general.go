@@ -1,8 +1,16 @@
 package general
 
-import "time"
+import (
+	"time"
+	_ "time/tzdata"
+)
 
+// League is the timezone NFL weeks are counted in, whatever timezone the games are shown in.
+var League = mustLoadLocation("America/New_York")
+
+// StartTime returns the Tuesday at midnight starting the week of t, in the League timezone.
 func StartTime(t time.Time) time.Time {
+	t = t.In(League)
 
 	// Calculate the number of days to subtract to reach the previous Tuesday
 	daysToSubtract := int(t.Weekday() - time.Tuesday)
@@ -14,12 +22,14 @@ func StartTime(t time.Time) time.Time {
 	previousTuesday := t.AddDate(0, 0, -daysToSubtract)
 
 	// Set the time to midnight (00:00:00)
-	start := time.Date(previousTuesday.Year(), previousTuesday.Month(), previousTuesday.Day(), 0, 0, 0, 0, time.Local)
+	start := time.Date(previousTuesday.Year(), previousTuesday.Month(), previousTuesday.Day(), 0, 0, 0, 0, t.Location())
 
 	return start.UTC()
 }
 
+// EndTime returns the Monday at 11:59 PM ending the week of t, in the League timezone.
 func EndTime(t time.Time) time.Time {
+	t = t.In(League)
 
 	// Calculate the number of days to add to reach the coming Monday
 	daysToAdd := int(time.Monday - t.Weekday())
@@ -31,7 +41,15 @@ func EndTime(t time.Time) time.Time {
 	comingMonday := t.AddDate(0, 0, daysToAdd)
 
 	// Set the time to 11:59 PM
-	end := time.Date(comingMonday.Year(), comingMonday.Month(), comingMonday.Day(), 23, 59, 59, 0, time.Local)
+	end := time.Date(comingMonday.Year(), comingMonday.Month(), comingMonday.Day(), 23, 59, 59, 0, t.Location())
 
 	return end.UTC()
 }
+
+func mustLoadLocation(name string) *time.Location {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		panic(err)
+	}
+	return loc
+}
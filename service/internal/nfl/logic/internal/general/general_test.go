@@ -0,0 +1,49 @@
+package general
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestWeek(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	// Monday night football kicks off at 8:15 PM ET, 1:15 AM on Tuesday in London.
+	mondayNight := time.Date(2023, 9, 11, 20, 15, 0, 0, League)
+
+	testCases := map[string]struct {
+		t             time.Time
+		expectedStart time.Time
+		expectedEnd   time.Time
+	}{
+		"should count the week in league time": {
+			t:             time.Date(2023, 9, 10, 13, 0, 0, 0, League),
+			expectedStart: time.Date(2023, 9, 5, 0, 0, 0, 0, League),
+			expectedEnd:   time.Date(2023, 9, 11, 23, 59, 59, 0, League),
+		},
+		"should keep monday night in the week of a day in the UK": {
+			t:             time.Date(2023, 9, 10, 0, 0, 0, 0, london),
+			expectedStart: time.Date(2023, 9, 5, 0, 0, 0, 0, League),
+			expectedEnd:   time.Date(2023, 9, 11, 23, 59, 59, 0, League),
+		},
+		"should keep monday night in the week of tuesday morning in the UK": {
+			t:             time.Date(2023, 9, 12, 0, 30, 0, 0, london),
+			expectedStart: time.Date(2023, 9, 5, 0, 0, 0, 0, League),
+			expectedEnd:   time.Date(2023, 9, 11, 23, 59, 59, 0, League),
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			start, end := StartTime(tc.t), EndTime(tc.t)
+
+			assert.True(t, tc.expectedStart.Equal(start), "start %s", start)
+			assert.True(t, tc.expectedEnd.Equal(end), "end %s", end)
+			assert.True(t, !mondayNight.Before(start) && mondayNight.Before(end), "monday night should be in the week")
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package webhookstore
+
+import (
+	"github.com/google/uuid"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+)
+
+var _ webhook.Store = Store{}
+
+// Store reads webhooks and writes dead letters with the repository. The scheduler and the server send their
+// webhooks through it, so NFL and MLB events reach the same webhooks.
+type Store struct {
+	repo repository.WebhookDAO
+}
+
+func New(repo repository.WebhookDAO) Store {
+	return Store{repo: repo}
+}
+
+func (s Store) Subscriptions() ([]webhook.Subscription, error) {
+	webhooks, err := s.repo.GetWebhooks()
+	if err != nil {
+		return nil, err
+	}
+	subscriptions := make([]webhook.Subscription, 0, len(webhooks))
+	for _, wh := range webhooks {
+		sub := webhook.Subscription{ID: wh.ID.String(), URL: wh.URL, Secret: wh.Secret, Sports: wh.Sports, Teams: wh.Teams}
+		for _, eventType := range wh.EventTypes {
+			sub.EventTypes = append(sub.EventTypes, webhook.EventType(eventType))
+		}
+		subscriptions = append(subscriptions, sub)
+	}
+	return subscriptions, nil
+}
+
+func (s Store) DeadLetter(letter webhook.DeadLetter) error {
+	id, err := uuid.Parse(letter.SubscriptionID)
+	if err != nil {
+		return err
+	}
+	return s.repo.InsertWebhookDeadLetter(repository.WebhookDeadLetter{
+		WebhookID: id,
+		EventID:   letter.Event.ID,
+		EventType: string(letter.Event.Type),
+		Payload:   string(letter.Payload),
+		Attempts:  letter.Attempts,
+		LastError: letter.LastError,
+	})
+}
@@ -0,0 +1,49 @@
+package webhookstore
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"testing"
+)
+
+func TestStore(t *testing.T) {
+	id := uuid.MustParse("6f1c2a52-0d0e-4d1a-9c55-3b1c1e8f7a10")
+	ctrl := gomock.NewController(t)
+	mockRepository := repository.NewMockRepository(ctrl)
+	mockRepository.EXPECT().GetWebhooks().Return([]repository.Webhook{
+		{ID: id, URL: "http://localhost:8081/", Secret: "s3cret", Sports: pq.StringArray{"nfl"}, Teams: pq.StringArray{}, EventTypes: pq.StringArray{"final"}},
+	}, nil)
+	mockRepository.EXPECT().InsertWebhookDeadLetter(repository.WebhookDeadLetter{
+		WebhookID: id,
+		EventID:   "123-final",
+		EventType: "final",
+		Payload:   `{"id":"123-final"}`,
+		Attempts:  4,
+		LastError: "receiver answered 500 Internal Server Error",
+	}).Return(nil)
+	store := New(mockRepository)
+
+	subscriptions, err := store.Subscriptions()
+	require.NoError(t, err)
+	assert.Equal(t, []webhook.Subscription{{
+		ID:         id.String(),
+		URL:        "http://localhost:8081/",
+		Secret:     "s3cret",
+		Sports:     []string{"nfl"},
+		Teams:      []string{},
+		EventTypes: []webhook.EventType{webhook.Final},
+	}}, subscriptions)
+
+	assert.NoError(t, store.DeadLetter(webhook.DeadLetter{
+		SubscriptionID: id.String(),
+		Event:          webhook.Event{ID: "123-final", Type: webhook.Final},
+		Payload:        []byte(`{"id":"123-final"}`),
+		Attempts:       4,
+		LastError:      "receiver answered 500 Internal Server Error",
+	}))
+}
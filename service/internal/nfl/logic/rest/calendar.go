@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/calendar"
+	"sort"
+	"strings"
+	"time"
+)
+
+// gameDuration is how long a game is blocked out in calendars.
+const gameDuration = 3*time.Hour + 30*time.Minute
+
+// GetCalendar returns the games of the teams with abbreviations in season as a calendar. Games between two of
+// the teams are listed once and finals carry the score.
+func (c *Controller) GetCalendar(abbreviations []string, season int) (calendar.Calendar, error) {
+	logger := c.logger.With().Str("method", "GetCalendar").Logger()
+
+	teams, err := c.GetTeams()
+	if err != nil {
+		return calendar.Calendar{}, err
+	}
+	known := make(map[string]bool, len(teams))
+	for _, t := range teams {
+		known[t.Abbreviation] = true
+	}
+
+	cal := calendar.Calendar{Name: fmt.Sprintf("%s NFL Schedule", strings.Join(abbreviations, ", "))}
+	listed := make(map[string]bool)
+	for _, abbreviation := range abbreviations {
+		if !known[abbreviation] {
+			return calendar.Calendar{}, ErrNoTeam
+		}
+
+		games, err := c.repo.GetTeamSchedule(abbreviation, season)
+		if err != nil {
+			logger.Error().Err(err).Msgf("while getting schedule of %s", abbreviation)
+			return calendar.Calendar{}, err
+		}
+		for _, g := range games {
+			if listed[g.GameID] {
+				continue
+			}
+			listed[g.GameID] = true
+
+			event := calendar.Event{
+				UID:      fmt.Sprintf("nfl-%s@mini-score", g.GameID),
+				Start:    g.GameTime,
+				Duration: gameDuration,
+				Summary:  fmt.Sprintf("%s @ %s", g.AwayTeam, g.HomeTeam),
+				Sequence: g.ScheduleSequence,
+			}
+			if g.Quarter == "F" {
+				event.Description = fmt.Sprintf("Final: %s %d, %s %d", g.AwayTeam, g.AwayScore, g.HomeTeam, g.HomeScore)
+			}
+			cal.Events = append(cal.Events, event)
+		}
+	}
+
+	sort.SliceStable(cal.Events, func(i, j int) bool { return cal.Events[i].Start.Before(cal.Events[j].Start) })
+	return cal, nil
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/calendar"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_GetCalendar(t *testing.T) {
+	teams := []*repository.Team{{Abbreviation: "BUF"}, {Abbreviation: "NYJ"}, {Abbreviation: "MIA"}}
+	kickoff := time.Date(2023, 9, 12, 0, 15, 0, 0, time.UTC)
+	bufGames := []repository.ScheduledGame{
+		{GameID: "1", GameTime: kickoff, Quarter: "F", AwayTeam: "BUF", HomeTeam: "NYJ", AwayScore: 16, HomeScore: 22},
+		{GameID: "3", GameTime: kickoff.AddDate(0, 0, 14), ScheduleSequence: 2, AwayTeam: "MIA", HomeTeam: "BUF"},
+	}
+	nyjGames := []repository.ScheduledGame{
+		{GameID: "1", GameTime: kickoff, Quarter: "F", AwayTeam: "BUF", HomeTeam: "NYJ", AwayScore: 16, HomeScore: 22},
+		{GameID: "2", GameTime: kickoff.AddDate(0, 0, 6), AwayTeam: "NYJ", HomeTeam: "DAL"},
+	}
+
+	testCases := map[string]struct {
+		abbreviations    []string
+		mockRepo         func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedCalendar calendar.Calendar
+		expectedErr      error
+	}{
+		"should list games of every team once with final scores": {
+			abbreviations: []string{"BUF", "NYJ"},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				mockRepo.EXPECT().GetTeamSchedule("BUF", 2023).Return(bufGames, nil)
+				mockRepo.EXPECT().GetTeamSchedule("NYJ", 2023).Return(nyjGames, nil)
+				return mockRepo
+			},
+			expectedCalendar: calendar.Calendar{
+				Name: "BUF, NYJ NFL Schedule",
+				Events: []calendar.Event{
+					{UID: "nfl-1@mini-score", Start: kickoff, Duration: gameDuration, Summary: "BUF @ NYJ", Description: "Final: BUF 16, NYJ 22"},
+					{UID: "nfl-2@mini-score", Start: kickoff.AddDate(0, 0, 6), Duration: gameDuration, Summary: "NYJ @ DAL"},
+					{UID: "nfl-3@mini-score", Start: kickoff.AddDate(0, 0, 14), Duration: gameDuration, Summary: "MIA @ BUF", Sequence: 2},
+				},
+			},
+		},
+		"should return ErrNoTeam for unknown teams": {
+			abbreviations: []string{"XYZ"},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				return mockRepo
+			},
+			expectedErr: ErrNoTeam,
+		},
+		"should return error when a schedule fails": {
+			abbreviations: []string{"BUF"},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				mockRepo.EXPECT().GetTeamSchedule("BUF", 2023).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			cal, err := c.GetCalendar(tc.abbreviations, 2023)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Equal(t, tc.expectedCalendar, cal)
+		})
+	}
+}
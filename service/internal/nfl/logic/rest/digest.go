@@ -0,0 +1,230 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/internal/digest"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"net/mail"
+	"time"
+)
+
+var (
+	_ DigestRegistry = &Controller{}
+	_ digest.Store   = &Controller{}
+)
+
+var (
+	// ErrInvalidDigestSubscription is returned when a subscription can not be made as asked.
+	ErrInvalidDigestSubscription = errors.New("invalid digest subscription")
+	// ErrNoDigestSubscription is returned when there is no subscription for an unsubscribe token.
+	ErrNoDigestSubscription = errors.New("no digest subscription")
+)
+
+type (
+	// DigestRegistry manages who is emailed the digest of their teams' finals.
+	DigestRegistry interface {
+		SubscribeDigest(subscription DigestSubscription) (DigestSubscription, error)
+		GetDigestSubscriptions() ([]DigestSubscription, error)
+		UnsubscribeDigest(unsubscribeToken string) error
+	}
+
+	// DigestSubscription is someone emailed the finals of their teams. UnsubscribeToken is only set when they
+	// subscribe.
+	DigestSubscription struct {
+		ID               string    `json:"id"`
+		Email            string    `json:"email"`
+		NFLTeams         []string  `json:"nfl_teams"`
+		MLBTeams         []string  `json:"mlb_teams"`
+		UnsubscribeToken string    `json:"unsubscribe_token,omitempty"`
+		CreatedAt        time.Time `json:"created_at"`
+	}
+)
+
+// SubscribeDigest validates the subscription and stores it with a new unsubscribe token.
+func (c *Controller) SubscribeDigest(subscription DigestSubscription) (DigestSubscription, error) {
+	logger := c.logger.With().Str("method", "SubscribeDigest").Logger()
+
+	model, err := digestSubscriptionModel(subscription)
+	if err != nil {
+		return DigestSubscription{}, err
+	}
+	if model.UnsubscribeToken, err = newSecret(); err != nil {
+		logger.Error().Err(err).Msg("while generating unsubscribe token")
+		return DigestSubscription{}, err
+	}
+
+	id, err := c.repo.InsertDigestSubscription(model)
+	if err != nil {
+		logger.Error().Err(err).Msg("while inserting digest subscription")
+		return DigestSubscription{}, err
+	}
+	model.ID = id
+	model.CreatedAt = time.Now()
+
+	s := digestSubscriptionFromModel(model)
+	s.UnsubscribeToken = model.UnsubscribeToken
+	return s, nil
+}
+
+// GetDigestSubscriptions returns the subscriptions without their unsubscribe tokens, oldest first.
+func (c *Controller) GetDigestSubscriptions() ([]DigestSubscription, error) {
+	logger := c.logger.With().Str("method", "GetDigestSubscriptions").Logger()
+
+	models, err := c.repo.GetDigestSubscriptions()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting digest subscriptions")
+		return nil, err
+	}
+
+	subscriptions := make([]DigestSubscription, 0, len(models))
+	for _, m := range models {
+		subscriptions = append(subscriptions, digestSubscriptionFromModel(m))
+	}
+	return subscriptions, nil
+}
+
+// UnsubscribeDigest stops the digest of the subscription with unsubscribeToken.
+func (c *Controller) UnsubscribeDigest(unsubscribeToken string) error {
+	logger := c.logger.With().Str("method", "UnsubscribeDigest").Logger()
+
+	if unsubscribeToken == "" {
+		return ErrNoDigestSubscription
+	}
+	if err := c.repo.DeleteDigestSubscription(unsubscribeToken); err != nil {
+		if errors.Is(err, repository.ErrNoDigestSubscription) {
+			return ErrNoDigestSubscription
+		}
+		logger.Error().Err(err).Msg("while deleting digest subscription")
+		return err
+	}
+	return nil
+}
+
+// Subscribers returns the subscriptions as the digest sends to them, with the ids of the subscriptions.
+func (c *Controller) Subscribers() ([]digest.Subscriber, error) {
+	logger := c.logger.With().Str("method", "Subscribers").Logger()
+
+	models, err := c.repo.GetDigestSubscriptions()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting digest subscriptions")
+		return nil, err
+	}
+
+	subscribers := make([]digest.Subscriber, 0, len(models))
+	for _, m := range models {
+		subscribers = append(subscribers, digest.Subscriber{
+			ID:               m.ID.String(),
+			Email:            m.Email,
+			Teams:            map[favorites.Sport][]string{favorites.NFL: m.NFLTeams, favorites.MLB: m.MLBTeams},
+			UnsubscribeToken: m.UnsubscribeToken,
+		})
+	}
+	return subscribers, nil
+}
+
+// SentDigests returns the ids of the subscribers already sent the digest of the day of date.
+func (c *Controller) SentDigests(date time.Time) (map[string]bool, error) {
+	logger := c.logger.With().Str("method", "SentDigests").Logger()
+
+	ids, err := c.repo.GetDigestSentIDs(date)
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting sent digests")
+		return nil, err
+	}
+
+	sent := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		sent[id.String()] = true
+	}
+	return sent, nil
+}
+
+// MarkDigestSent logs that the subscriber was sent the digest of the day of date.
+func (c *Controller) MarkDigestSent(subscriberID string, date time.Time) error {
+	logger := c.logger.With().Str("method", "MarkDigestSent").Logger()
+
+	id, err := uuid.Parse(subscriberID)
+	if err != nil {
+		return fmt.Errorf("%w: invalid id %q", ErrNoDigestSubscription, subscriberID)
+	}
+	if err := c.repo.InsertDigestSent(id, date); err != nil {
+		logger.Error().Err(err).Msg("while inserting digest sent")
+		return err
+	}
+	return nil
+}
+
+// GetFinals returns the finals of the games kicking off on the day of date, in date's location. It is the NFL
+// source of the digest.
+func (c *Controller) GetFinals(date time.Time) ([]digest.Final, error) {
+	logger := c.logger.With().Str("method", "GetFinals").Logger()
+
+	start := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, date.Location())
+	entries, err := c.repo.GetGameFinalEntries(start.UTC(), start.AddDate(0, 0, 1).UTC())
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting final entries")
+		return nil, err
+	}
+
+	finals := make([]digest.Final, 0, len(entries))
+	for _, e := range entries {
+		finals = append(finals, digest.Final{
+			Sport:     favorites.NFL,
+			AwayTeam:  e.AwayTeam,
+			HomeTeam:  e.HomeTeam,
+			Title:     e.Title,
+			LineScore: e.LineScore,
+		})
+	}
+	return finals, nil
+}
+
+// digestSubscriptionModel checks the subscription, keeping only the address of the email and upper casing teams.
+func digestSubscriptionModel(subscription DigestSubscription) (repository.DigestSubscription, error) {
+	address, err := mail.ParseAddress(subscription.Email)
+	if err != nil {
+		return repository.DigestSubscription{}, fmt.Errorf("%w: invalid email", ErrInvalidDigestSubscription)
+	}
+
+	nflTeams, err := digestTeams(subscription.NFLTeams)
+	if err != nil {
+		return repository.DigestSubscription{}, err
+	}
+	mlbTeams, err := digestTeams(subscription.MLBTeams)
+	if err != nil {
+		return repository.DigestSubscription{}, err
+	}
+
+	model := repository.DigestSubscription{Email: address.Address, NFLTeams: nflTeams, MLBTeams: mlbTeams}
+	if len(model.NFLTeams)+len(model.MLBTeams) == 0 {
+		return repository.DigestSubscription{}, fmt.Errorf("%w: pick at least one team", ErrInvalidDigestSubscription)
+	}
+	return model, nil
+}
+
+// digestTeams reads team abbreviations, which may also be separated by commas.
+func digestTeams(values []string) (pq.StringArray, error) {
+	teams := pq.StringArray{}
+	for _, v := range values {
+		parsed := favorites.ParseTeams(v, ",")
+		if len(parsed) == 0 {
+			return nil, fmt.Errorf("%w: invalid team %q", ErrInvalidDigestSubscription, v)
+		}
+		teams = append(teams, parsed...)
+	}
+	return teams, nil
+}
+
+func digestSubscriptionFromModel(m repository.DigestSubscription) DigestSubscription {
+	return DigestSubscription{
+		ID:        m.ID.String(),
+		Email:     m.Email,
+		NFLTeams:  append([]string{}, m.NFLTeams...),
+		MLBTeams:  append([]string{}, m.MLBTeams...),
+		CreatedAt: m.CreatedAt,
+	}
+}
@@ -0,0 +1,299 @@
+package rest
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/internal/digest"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+var digestSubscriptionID = uuid.MustParse("0b4f5c1e-7a51-4f0e-8c1a-2d9b6f3e4a70")
+
+func TestController_SubscribeDigest(t *testing.T) {
+	testCases := map[string]struct {
+		subscription         DigestSubscription
+		mockRepo             func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedSubscription DigestSubscription
+		expectedErr          error
+	}{
+		"should store subscription with the address and teams": {
+			subscription: DigestSubscription{Email: "Bills Fan <fan@example.com>", NFLTeams: []string{"buf"}, MLBTeams: []string{"NYY,bos"}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertDigestSubscription(gomock.Any()).DoAndReturn(func(s repository.DigestSubscription) (uuid.UUID, error) {
+					assert.Equal(t, "fan@example.com", s.Email)
+					assert.Equal(t, pq.StringArray{"BUF"}, s.NFLTeams)
+					assert.Equal(t, pq.StringArray{"NYY", "BOS"}, s.MLBTeams)
+					assert.Len(t, s.UnsubscribeToken, 64)
+					return digestSubscriptionID, nil
+				})
+				return mockRepo
+			},
+			expectedSubscription: DigestSubscription{ID: digestSubscriptionID.String(), Email: "fan@example.com", NFLTeams: []string{"BUF"}, MLBTeams: []string{"NYY", "BOS"}},
+		},
+		"should reject invalid email": {
+			subscription: DigestSubscription{Email: "fan", NFLTeams: []string{"BUF"}},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidDigestSubscription,
+		},
+		"should reject invalid team": {
+			subscription: DigestSubscription{Email: "fan@example.com", NFLTeams: []string{"Buffalo Bills"}},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidDigestSubscription,
+		},
+		"should reject subscription without teams": {
+			subscription: DigestSubscription{Email: "fan@example.com"},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidDigestSubscription,
+		},
+		"should return error when insert fails": {
+			subscription: DigestSubscription{Email: "fan@example.com", NFLTeams: []string{"BUF"}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertDigestSubscription(gomock.Any()).Return(uuid.Nil, repository.ErrInsertDigestSubscription)
+				return mockRepo
+			},
+			expectedErr: repository.ErrInsertDigestSubscription,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			s, err := c.SubscribeDigest(tc.subscription)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Len(t, s.UnsubscribeToken, 64)
+			assert.False(t, s.CreatedAt.IsZero())
+			s.UnsubscribeToken, s.CreatedAt = "", time.Time{}
+			assert.Equal(t, tc.expectedSubscription, s)
+		})
+	}
+}
+
+func TestController_GetDigestSubscriptions(t *testing.T) {
+	created := time.Date(2023, 9, 12, 3, 41, 0, 0, time.UTC)
+	mockRepo := repository.NewMockRepository(gomock.NewController(t))
+	mockRepo.EXPECT().GetDigestSubscriptions().Return([]repository.DigestSubscription{
+		{ID: digestSubscriptionID, Email: "fan@example.com", NFLTeams: pq.StringArray{"BUF"}, MLBTeams: pq.StringArray{}, UnsubscribeToken: "t0ken", CreatedAt: created},
+	}, nil)
+	c := &Controller{logger: zerolog.Nop(), repo: mockRepo}
+
+	subscriptions, err := c.GetDigestSubscriptions()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []DigestSubscription{
+		{ID: digestSubscriptionID.String(), Email: "fan@example.com", NFLTeams: []string{"BUF"}, MLBTeams: []string{}, CreatedAt: created},
+	}, subscriptions)
+}
+
+func TestController_UnsubscribeDigest(t *testing.T) {
+	testCases := map[string]struct {
+		token       string
+		mockRepo    func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedErr error
+	}{
+		"should unsubscribe": {
+			token: "t0ken",
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().DeleteDigestSubscription("t0ken").Return(nil)
+				return mockRepo
+			},
+		},
+		"should return ErrNoDigestSubscription for unknown token": {
+			token: "t0ken",
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().DeleteDigestSubscription("t0ken").Return(repository.ErrNoDigestSubscription)
+				return mockRepo
+			},
+			expectedErr: ErrNoDigestSubscription,
+		},
+		"should return ErrNoDigestSubscription for empty token": {
+			mockRepo:    repository.NewMockRepository,
+			expectedErr: ErrNoDigestSubscription,
+		},
+		"should return error when delete fails": {
+			token: "t0ken",
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().DeleteDigestSubscription("t0ken").Return(repository.ErrDeleteDigestSubscription)
+				return mockRepo
+			},
+			expectedErr: repository.ErrDeleteDigestSubscription,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			assert.ErrorIs(t, c.UnsubscribeDigest(tc.token), tc.expectedErr)
+		})
+	}
+}
+
+func TestController_Subscribers(t *testing.T) {
+	mockRepo := repository.NewMockRepository(gomock.NewController(t))
+	mockRepo.EXPECT().GetDigestSubscriptions().Return([]repository.DigestSubscription{
+		{ID: digestSubscriptionID, Email: "fan@example.com", NFLTeams: pq.StringArray{"BUF"}, MLBTeams: pq.StringArray{"NYY"}, UnsubscribeToken: "t0ken"},
+	}, nil)
+	c := &Controller{logger: zerolog.Nop(), repo: mockRepo}
+
+	subscribers, err := c.Subscribers()
+
+	assert.NoError(t, err)
+	assert.Equal(t, []digest.Subscriber{
+		{ID: digestSubscriptionID.String(), Email: "fan@example.com", Teams: map[favorites.Sport][]string{favorites.NFL: {"BUF"}, favorites.MLB: {"NYY"}}, UnsubscribeToken: "t0ken"},
+	}, subscribers)
+}
+
+func TestController_SentDigests(t *testing.T) {
+	date := time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockRepo     func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedSent map[string]bool
+		expectedErr  error
+	}{
+		"should return the ids already sent": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetDigestSentIDs(date).Return([]uuid.UUID{digestSubscriptionID}, nil)
+				return mockRepo
+			},
+			expectedSent: map[string]bool{digestSubscriptionID.String(): true},
+		},
+		"should return error when sent digests fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetDigestSentIDs(date).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			sent, err := c.SentDigests(date)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedSent, sent)
+		})
+	}
+}
+
+func TestController_MarkDigestSent(t *testing.T) {
+	date := time.Date(2023, 9, 11, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		id          string
+		mockRepo    func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedErr error
+	}{
+		"should log the digest sent": {
+			id: digestSubscriptionID.String(),
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertDigestSent(digestSubscriptionID, date).Return(nil)
+				return mockRepo
+			},
+		},
+		"should return ErrNoDigestSubscription for invalid id": {
+			id:          "not-a-uuid",
+			mockRepo:    repository.NewMockRepository,
+			expectedErr: ErrNoDigestSubscription,
+		},
+		"should return error when insert fails": {
+			id: digestSubscriptionID.String(),
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertDigestSent(digestSubscriptionID, date).Return(repository.ErrInsertDigestSent)
+				return mockRepo
+			},
+			expectedErr: repository.ErrInsertDigestSent,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			assert.ErrorIs(t, c.MarkDigestSent(tc.id, date), tc.expectedErr)
+		})
+	}
+}
+
+func TestController_GetFinals(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	date := time.Date(2023, 9, 11, 15, 0, 0, 0, loc)
+	start := time.Date(2023, 9, 11, 4, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockRepo       func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedFinals []digest.Final
+		expectedErr    error
+	}{
+		"should return finals of the day in the date's location": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameFinalEntries(start, start.AddDate(0, 0, 1)).Return([]repository.GameFinalEntry{
+					{FinalEntry: repository.FinalEntry{GameID: "1", Title: "Final/OT: BUF 16 @ NYJ 22", LineScore: "BUF 16"}, AwayTeam: "BUF", HomeTeam: "NYJ"},
+				}, nil)
+				return mockRepo
+			},
+			expectedFinals: []digest.Final{
+				{Sport: favorites.NFL, AwayTeam: "BUF", HomeTeam: "NYJ", Title: "Final/OT: BUF 16 @ NYJ 22", LineScore: "BUF 16"},
+			},
+		},
+		"should return error when entries fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameFinalEntries(start, start.AddDate(0, 0, 1)).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			finals, err := c.GetFinals(date)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedFinals, finals)
+		})
+	}
+}
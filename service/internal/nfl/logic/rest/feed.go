@@ -0,0 +1,63 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/feed"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"strconv"
+)
+
+// feedEntries is how many of the most recent finals the feed lists.
+const feedEntries = 50
+
+// GetFeed returns the feed of the most recent final scores, newest first. Entries are written as games are
+// finalized.
+func (c *Controller) GetFeed() (feed.Feed, error) {
+	logger := c.logger.With().Str("method", "GetFeed").Logger()
+
+	entries, err := c.repo.GetFinalEntries(feedEntries)
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting final entries")
+		return feed.Feed{}, err
+	}
+
+	f := feed.Feed{Title: "NFL Final Scores", ID: "urn:mini-score:nfl"}
+	for _, e := range entries {
+		f.Entries = append(f.Entries, feed.Entry{
+			ID:        "urn:mini-score:nfl:" + e.GameID,
+			Title:     e.Title,
+			Published: e.CreatedAt,
+			Updated:   e.UpdatedAt,
+			Content:   e.LineScore,
+		})
+	}
+	return f, nil
+}
+
+// SaveMLBFinal stores the feed entry of an MLB game by game pk, so the MLB feed outlives the server. The entry keeps
+// its published time when it is saved again unchanged.
+func (c *Controller) SaveMLBFinal(gamePk int, entry feed.Entry) error {
+	return c.repo.UpsertMLBFinalEntry(repository.MLBFinalEntry{GamePk: gamePk, Title: entry.Title, LineScore: entry.Content})
+}
+
+// GetMLBFinals returns the limit most recent MLB feed entries, newest first.
+func (c *Controller) GetMLBFinals(limit int) ([]feed.Entry, error) {
+	logger := c.logger.With().Str("method", "GetMLBFinals").Logger()
+
+	entries, err := c.repo.GetMLBFinalEntries(limit)
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting mlb final entries")
+		return nil, err
+	}
+
+	var finals []feed.Entry
+	for _, e := range entries {
+		finals = append(finals, feed.Entry{
+			ID:        "urn:mini-score:mlb:" + strconv.Itoa(e.GamePk),
+			Title:     e.Title,
+			Published: e.CreatedAt,
+			Updated:   e.UpdatedAt,
+			Content:   e.LineScore,
+		})
+	}
+	return finals, nil
+}
@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/feed"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_GetFeed(t *testing.T) {
+	final := time.Date(2023, 9, 12, 3, 41, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockRepo     func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedFeed feed.Feed
+		expectedErr  error
+	}{
+		"should list final entries": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetFinalEntries(feedEntries).Return([]repository.FinalEntry{
+					{GameID: "1", Title: "Final/OT: BUF 16 @ NYJ 22", LineScore: "BUF 16", CreatedAt: final, UpdatedAt: final.Add(time.Minute)},
+				}, nil)
+				return mockRepo
+			},
+			expectedFeed: feed.Feed{
+				Title: "NFL Final Scores",
+				ID:    "urn:mini-score:nfl",
+				Entries: []feed.Entry{
+					{ID: "urn:mini-score:nfl:1", Title: "Final/OT: BUF 16 @ NYJ 22", Published: final, Updated: final.Add(time.Minute), Content: "BUF 16"},
+				},
+			},
+		},
+		"should return error when entries fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetFinalEntries(feedEntries).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			f, err := c.GetFeed()
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedFeed, f)
+		})
+	}
+}
+
+func TestController_SaveMLBFinal(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepository(ctrl)
+	mockRepo.EXPECT().UpsertMLBFinalEntry(repository.MLBFinalEntry{GamePk: 717465, Title: "Final: NYY 2 @ BOS 1", LineScore: "NYY 2"}).
+		Return(repository.ErrUpsertFinalEntry)
+	c := &Controller{logger: zerolog.Nop(), repo: mockRepo}
+
+	err := c.SaveMLBFinal(717465, feed.Entry{ID: "urn:mini-score:mlb:717465", Title: "Final: NYY 2 @ BOS 1", Content: "NYY 2"})
+
+	assert.ErrorIs(t, err, repository.ErrUpsertFinalEntry)
+}
+
+func TestController_GetMLBFinals(t *testing.T) {
+	final := time.Date(2023, 9, 12, 3, 41, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockRepo        func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedEntries []feed.Entry
+		expectedErr     error
+	}{
+		"should list final entries by game pk": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetMLBFinalEntries(10).Return([]repository.MLBFinalEntry{
+					{GamePk: 717465, Title: "Final: NYY 2 @ BOS 1", LineScore: "NYY 2", CreatedAt: final, UpdatedAt: final.Add(time.Minute)},
+				}, nil)
+				return mockRepo
+			},
+			expectedEntries: []feed.Entry{
+				{ID: "urn:mini-score:mlb:717465", Title: "Final: NYY 2 @ BOS 1", Published: final, Updated: final.Add(time.Minute), Content: "NYY 2"},
+			},
+		},
+		"should return error when entries fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetMLBFinalEntries(10).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			entries, err := c.GetMLBFinals(10)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedEntries, entries)
+		})
+	}
+}
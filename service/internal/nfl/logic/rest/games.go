@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"sort"
+	"strconv"
+	"time"
+)
+
+var _ GameLister = &Controller{}
+
+type (
+	// GameLister lists games without their quarter scores, so the quarter scores of many games can be loaded at
+	// once.
+	GameLister interface {
+		GetGames(start, end time.Time, loc *time.Location) ([]GameSummary, error)
+		GetQuarterScores(gameIDs []string) (map[string]QuarterScores, error)
+	}
+
+	// GameSummary is a game with its teams and status but not its scores.
+	GameSummary struct {
+		ID       string
+		AwayTeam string
+		HomeTeam string
+		Status   renderer.Status
+	}
+
+	// QuarterScores are the points of each team of a game in each quarter, by team abbreviation. Overtime is the
+	// fifth quarter.
+	QuarterScores map[string][]int
+)
+
+// GetGames returns the games kicking off from start until end ordered by kickoff, with game times shown in loc.
+func (c *Controller) GetGames(start, end time.Time, loc *time.Location) ([]GameSummary, error) {
+	logger := c.logger.With().Str("method", "GetGames").Logger()
+
+	games, err := c.repo.GetGamesWithTeamAbv(start, &end)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting games between %s and %s", start, end)
+		return nil, err
+	}
+
+	scores := buildScoreFromDB(nil, games)
+	sort.Sort(ByGameTime(scores))
+	summaries := make([]GameSummary, 0, len(scores))
+	for _, sc := range scores {
+		g := sc.game(loc)
+		summaries = append(summaries, GameSummary{ID: g.ID, AwayTeam: g.Away.Name, HomeTeam: g.Home.Name, Status: g.Status})
+	}
+	return summaries, nil
+}
+
+// GetQuarterScores returns the quarter scores of the games with gameIDs by game id, with one query for every
+// game. Games without scores are left out, quarter scores that are not numbers are 0.
+func (c *Controller) GetQuarterScores(gameIDs []string) (map[string]QuarterScores, error) {
+	logger := c.logger.With().Str("method", "GetQuarterScores").Logger()
+
+	gqs, err := c.repo.GetQuarterScoresForGames(gameIDs)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting quarter scores of %d games", len(gameIDs))
+		return nil, err
+	}
+
+	scores := make(map[string]QuarterScores)
+	for _, qs := range gqs {
+		if scores[qs.GameID] == nil {
+			scores[qs.GameID] = make(QuarterScores)
+		}
+		score, err := strconv.Atoi(qs.Score)
+		if err != nil {
+			score = 0
+		}
+		scores[qs.GameID][qs.TeamAbbreviation] = append(scores[qs.GameID][qs.TeamAbbreviation], score)
+	}
+	return scores, nil
+}
@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_GetGames(t *testing.T) {
+	eastern, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	start := time.Date(2023, 9, 7, 0, 0, 0, 0, eastern)
+	end := start.AddDate(0, 0, 7)
+	kickoff := time.Date(2023, 9, 8, 0, 20, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockRepo    func(ctrl *gomock.Controller) *repository.MockRepository
+		expected    []GameSummary
+		expectedErr error
+	}{
+		"should return games ordered by kickoff with their status": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGamesWithTeamAbv(start, &end).Return([]repository.Game{
+					{ID: "2", GameTime: kickoff.AddDate(0, 0, 3), AwayTeam: "BUF", HomeTeam: "NYJ"},
+					{ID: "1", GameTime: kickoff, Quarter: "F", GameClock: "0:00", AwayTeam: "DET", HomeTeam: "KC"},
+				}, nil)
+				return mockRepo
+			},
+			expected: []GameSummary{
+				{ID: "1", AwayTeam: "DET", HomeTeam: "KC", Status: renderer.Status{State: renderer.Final, Detail: "QF", Clock: "0:00", Start: kickoff}},
+				{ID: "2", AwayTeam: "BUF", HomeTeam: "NYJ", Status: renderer.Status{State: renderer.Scheduled, Detail: "Q", Clock: "Sun, 8:20 PM", Start: kickoff.AddDate(0, 0, 3)}},
+			},
+		},
+		"should return error when the games fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGamesWithTeamAbv(start, &end).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			games, err := c.GetGames(start, end, eastern)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, games)
+		})
+	}
+}
+
+func TestController_GetQuarterScores(t *testing.T) {
+	testCases := map[string]struct {
+		mockRepo    func(ctrl *gomock.Controller) *repository.MockRepository
+		expected    map[string]QuarterScores
+		expectedErr error
+	}{
+		"should group quarter scores by game and team": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"1", "2", "3"}).Return([]repository.GameTeamQuarterScore{
+					{GameID: "1", TeamAbbreviation: "DET", Quarter: "1", Score: "0"},
+					{GameID: "1", TeamAbbreviation: "DET", Quarter: "2", Score: "14"},
+					{GameID: "1", TeamAbbreviation: "KC", Quarter: "1", Score: "7"},
+					{GameID: "1", TeamAbbreviation: "KC", Quarter: "2", Score: "x"},
+					{GameID: "2", TeamAbbreviation: "BUF", Quarter: "1", Score: "3"},
+				}, nil)
+				return mockRepo
+			},
+			expected: map[string]QuarterScores{
+				"1": {"DET": {0, 14}, "KC": {7, 0}},
+				"2": {"BUF": {3}},
+			},
+		},
+		"should return error when the quarter scores fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"1", "2", "3"}).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			scores, err := c.GetQuarterScores([]string{"1", "2", "3"})
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expected, scores)
+		})
+	}
+}
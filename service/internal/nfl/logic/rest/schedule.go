@@ -0,0 +1,124 @@
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/http/scraper"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/standings"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"io"
+	"time"
+)
+
+const (
+	scheduleDateFormat = "Mon Jan 2"
+	kickoffFormat      = "3:04 PM"
+)
+
+// ErrNoTeam is returned when there is no team for an abbreviation.
+var ErrNoTeam = errors.New("no team")
+
+var (
+	scheduleColumns = []string{"OPP", "RESULT", "RECORD"}
+	seasonTypeNames = map[int]string{
+		int(scraper.PreSeason):  "Preseason",
+		int(scraper.RegSeason):  "Regular Season",
+		int(scraper.PostSeason): "Postseason",
+	}
+)
+
+// Schedule is the page of the games of a team in a season.
+type Schedule struct {
+	Team   Team
+	Season int
+	games  []repository.ScheduledGame
+	loc    *time.Location
+}
+
+// GetTeamSchedule returns the schedule of the team with abbreviation in season with kickoff times shown in loc.
+func (c *Controller) GetTeamSchedule(abbreviation string, season int, loc *time.Location) (Schedule, error) {
+	logger := c.logger.With().Str("method", "GetTeamSchedule").Logger()
+
+	teams, err := c.GetTeams()
+	if err != nil {
+		return Schedule{}, err
+	}
+	schedule := Schedule{Season: season, loc: loc}
+	for _, t := range teams {
+		if t.Abbreviation == abbreviation {
+			schedule.Team = t
+		}
+	}
+	if schedule.Team.Abbreviation == "" {
+		return Schedule{}, ErrNoTeam
+	}
+
+	schedule.games, err = c.repo.GetTeamSchedule(abbreviation, season)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting schedule of %s", abbreviation)
+		return Schedule{}, err
+	}
+	return schedule, nil
+}
+
+func (s Schedule) Render(writer io.Writer, r renderer.TableRenderer) error {
+	return r.RenderTables(writer, s.Tables())
+}
+
+// Tables returns a table of games for each part of the season the team has games in, with the record of the
+// team after each final.
+func (s Schedule) Tables() renderer.Tables {
+	tables := renderer.Tables{Heading: fmt.Sprintf("%d %s Schedule", s.Season, s.Team.Name)}
+
+	byType := make(map[int]*renderer.Table)
+	records := make(map[int]*standings.Record)
+	for _, seasonType := range []scraper.SeasonType{scraper.PreSeason, scraper.RegSeason, scraper.PostSeason} {
+		byType[int(seasonType)] = &renderer.Table{Title: seasonTypeNames[int(seasonType)], Columns: scheduleColumns}
+		records[int(seasonType)] = &standings.Record{}
+	}
+
+	for _, g := range s.games {
+		table, ok := byType[g.SeasonType]
+		if !ok {
+			continue
+		}
+		table.Rows = append(table.Rows, s.row(g, records[g.SeasonType]))
+	}
+
+	for _, seasonType := range []scraper.SeasonType{scraper.PreSeason, scraper.RegSeason, scraper.PostSeason} {
+		if table := byType[int(seasonType)]; len(table.Rows) > 0 {
+			tables.Tables = append(tables.Tables, *table)
+		}
+	}
+	return tables
+}
+
+// row describes a game from the side of the team, counting finals in record.
+func (s Schedule) row(g repository.ScheduledGame, record *standings.Record) []string {
+	opponent, pointsFor, pointsAgainst := "vs "+g.AwayTeam, g.HomeScore, g.AwayScore
+	if g.AwayTeam == s.Team.Abbreviation {
+		opponent, pointsFor, pointsAgainst = "@ "+g.HomeTeam, g.AwayScore, g.HomeScore
+	}
+	date := g.GameTime.In(s.loc).Format(scheduleDateFormat)
+
+	switch g.Quarter {
+	case "":
+		return []string{date, opponent, g.GameTime.In(s.loc).Format(kickoffFormat), ""}
+	case "F":
+		outcome := "T"
+		switch {
+		case pointsFor > pointsAgainst:
+			outcome = "W"
+			record.Wins++
+		case pointsFor < pointsAgainst:
+			outcome = "L"
+			record.Losses++
+		default:
+			record.Ties++
+		}
+		return []string{date, opponent, fmt.Sprintf("%s %d-%d", outcome, pointsFor, pointsAgainst), record.String()}
+	default:
+		return []string{date, opponent, fmt.Sprintf("Q%s %d-%d", g.Quarter, pointsFor, pointsAgainst), ""}
+	}
+}
@@ -0,0 +1,104 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_GetTeamSchedule(t *testing.T) {
+	eastern, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	teams := []*repository.Team{
+		{Abbreviation: "BUF", Name: "Buffalo Bills"},
+		{Abbreviation: "NYJ", Name: "New York Jets"},
+	}
+	kickoff := time.Date(2023, 9, 12, 0, 15, 0, 0, time.UTC)
+	games := []repository.ScheduledGame{
+		{GameID: "1", GameTime: kickoff.AddDate(0, 0, -28), Quarter: "F", SeasonType: 1, AwayTeam: "IND", HomeTeam: "BUF", AwayScore: 19, HomeScore: 23},
+		{GameID: "2", GameTime: kickoff, Quarter: "F", SeasonType: 2, AwayTeam: "BUF", HomeTeam: "NYJ", AwayScore: 16, HomeScore: 22},
+		{GameID: "3", GameTime: kickoff.AddDate(0, 0, 6), Quarter: "F", SeasonType: 2, AwayTeam: "LV", HomeTeam: "BUF", AwayScore: 10, HomeScore: 38},
+		{GameID: "4", GameTime: kickoff.AddDate(0, 0, 13), Quarter: "3", SeasonType: 2, AwayTeam: "BUF", HomeTeam: "WSH", AwayScore: 24, HomeScore: 3},
+		{GameID: "5", GameTime: kickoff.AddDate(0, 0, 20), SeasonType: 2, AwayTeam: "MIA", HomeTeam: "BUF"},
+	}
+
+	testCases := map[string]struct {
+		abbreviation   string
+		mockRepo       func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedTables renderer.Tables
+		expectedErr    error
+	}{
+		"should return games with results, kickoff times and running record": {
+			abbreviation: "BUF",
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				mockRepo.EXPECT().GetTeamSchedule("BUF", 2023).Return(games, nil)
+				return mockRepo
+			},
+			expectedTables: renderer.Tables{
+				Heading: "2023 Buffalo Bills Schedule",
+				Tables: []renderer.Table{
+					{
+						Title:   "Preseason",
+						Columns: scheduleColumns,
+						Rows: [][]string{
+							{"Mon Aug 14", "vs IND", "W 23-19", "1-0"},
+						},
+					},
+					{
+						Title:   "Regular Season",
+						Columns: scheduleColumns,
+						Rows: [][]string{
+							{"Mon Sep 11", "@ NYJ", "L 16-22", "0-1"},
+							{"Sun Sep 17", "vs LV", "W 38-10", "1-1"},
+							{"Sun Sep 24", "@ WSH", "Q3 24-3", ""},
+							{"Sun Oct 1", "vs MIA", "8:15 PM", ""},
+						},
+					},
+				},
+			},
+		},
+		"should return ErrNoTeam for unknown teams": {
+			abbreviation: "XYZ",
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				return mockRepo
+			},
+			expectedErr: ErrNoTeam,
+		},
+		"should return error when the schedule fails": {
+			abbreviation: "BUF",
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				mockRepo.EXPECT().GetTeamSchedule("BUF", 2023).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			s, err := c.GetTeamSchedule(tc.abbreviation, 2023, eastern)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Equal(t, tc.expectedTables, s.Tables())
+		})
+	}
+}
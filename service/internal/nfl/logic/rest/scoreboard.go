@@ -1,33 +1,72 @@
 package rest
 
 import (
+	"errors"
 	"fmt"
 	"github.com/jmoiron/sqlx"
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/rmarken5/mini-score/service/internal/calendar"
+	"github.com/rmarken5/mini-score/service/internal/feed"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
 	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
 	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/general"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
 	"github.com/rs/zerolog"
 	"io"
 	"sort"
 	"strconv"
-	"strings"
 	"time"
 )
 
 const (
 	gameTimeFormat    = "Mon, 3:04 PM"
 	headingTimeFormat = "Jan, 02 2006"
+	// staleAfter is how long the scheduler may go without syncing games in progress before their scores are shown
+	// as of its last sync.
+	staleAfter = time.Minute
+	// lastGamesCapacity bounds the weeks whose last scores are kept.
+	lastGamesCapacity = 32
+	// lastGamePagesCapacity bounds the games whose last pages are kept.
+	lastGamePagesCapacity = 64
 )
 
 var _ ScoreboardFacade = &Controller{}
 
+// ErrNoGame is returned when there is no game for an id.
+var ErrNoGame = errors.New("no game")
+
+// GameChangesChannel is the database channel the scheduler notifies with the id of each game it changes.
+const GameChangesChannel = repository.GameChangesChannel
+
 type (
 	ScoreboardFacade interface {
 		GetScoreboardForDate(date time.Time) (Scores, error)
+		GetTeams() ([]Team, error)
+		GetGame(gameID string, loc *time.Location) (Game, error)
+		GetStandings(season int) (Standings, error)
+		GetTeamSchedule(abbreviation string, season int, loc *time.Location) (Schedule, error)
+		GetCalendar(abbreviations []string, season int) (calendar.Calendar, error)
+		GetFeed() (feed.Feed, error)
+	}
+
+	// Game is the page of a single game.
+	Game struct {
+		score score
+		loc   *time.Location
+	}
+
+	Team struct {
+		Abbreviation string
+		Name         string
 	}
 
 	Controller struct {
-		logger zerolog.Logger
-		repo   repository.Repository
+		logger    zerolog.Logger
+		repo      repository.Repository
+		lastGames *lastgood.Store[[]score]
+		// lastGamePages are the last scores of game pages by game id. Finals are served from them.
+		lastGamePages *lastgood.Store[score]
 	}
 
 	score struct {
@@ -36,20 +75,37 @@ type (
 		quarter            string
 		gameClock          string
 		startTime          time.Time
+		asOf               time.Time // set when the score is the last known good value rather than current
+		situation          repository.GameSituation
+		awayRecord         string
+		homeRecord         string
+		scoringPlays       []repository.ScoringPlay
 	}
 	team struct {
 		name   string
+		color  string
 		scores []string
 	}
-	Scores []score
 
-	ByGameTime Scores
+	// PrintOptions control how a scoreboard is drawn as text.
+	PrintOptions = renderer.TextOptions
+
+	// Scores are the scores of a week of games.
+	Scores struct {
+		games []score
+		// unavailableAt is when the scores could not be read and none were kept for the week, so the board is empty.
+		unavailableAt time.Time
+	}
+
+	ByGameTime []score
 )
 
 func NewScoreboardFacade(logger zerolog.Logger, db *sqlx.DB) *Controller {
 	return &Controller{
-		logger: logger,
-		repo:   repository.NewRepository(logger, db),
+		logger:        logger,
+		repo:          repository.NewRepository(logger, db),
+		lastGames:     lastgood.New[[]score](lastGamesCapacity),
+		lastGamePages: lastgood.New[score](lastGamePagesCapacity),
 	}
 }
 
@@ -63,39 +119,157 @@ func (c *Controller) GetScoreboardForDate(date time.Time) (Scores, error) {
 	gqs, err := c.repo.GetGameTeamQuarterScore(start, &end)
 	if err != nil {
 		logger.Error().Err(err).Msg("while getting scores")
+		return c.lastGoodScores(start), nil
 	}
 
 	games, err := c.repo.GetGamesWithTeamAbv(start, &end)
 	if err != nil {
 		logger.Error().Err(err).Msg("while getting games")
+		return c.lastGoodScores(start), nil
 	}
 
 	scores := buildScoreFromDB(gqs, games)
 	sort.Sort(ByGameTime(scores))
+	c.lastGames.Put(weekKey(start), scores)
 
-	return scores, nil
+	return Scores{games: c.markStale(scores, time.Now())}, nil
 }
 
-func buildScoreFromDB(gts []repository.GameTeamQuarterScore, games []repository.Game) Scores {
+// markStale marks the games in progress of scores as of the scheduler's last sync when it has not synced them for
+// staleAfter at now. Their scores are read without error but no longer change while the scheduler is down.
+func (c *Controller) markStale(scores []score, now time.Time) []score {
+	logger := c.logger.With().Str("method", "markStale").Logger()
 
-	scores := make(Scores, 0)
-	for _, g := range games {
-		gameClock := g.GameClock
-		if gameClock == "" {
-			gameClock = g.GameTime.Local().Format(gameTimeFormat)
+	live := false
+	for _, s := range scores {
+		live = live || s.inProgress()
+	}
+	if !live {
+		return scores
+	}
+
+	syncedAt, err := c.repo.GetSyncTime(repository.SyncGameInfo)
+	if err != nil {
+		logger.Warn().Err(err).Msg("while getting the scheduler's last sync")
+		return scores
+	}
+	if now.Sub(syncedAt) < staleAfter {
+		return scores
+	}
+
+	stale := make([]score, len(scores))
+	for i, s := range scores {
+		if s.inProgress() {
+			s.asOf = syncedAt
 		}
+		stale[i] = s
+	}
+	return stale
+}
+
+// GetTeams returns every team sorted by abbreviation.
+func (c *Controller) GetTeams() ([]Team, error) {
+	logger := c.logger.With().Str("method", "GetTeams").Logger()
+
+	dbTeams, err := c.repo.GetAllTeams()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting teams")
+		return nil, err
+	}
+
+	teams := make([]Team, 0, len(dbTeams))
+	for _, t := range dbTeams {
+		teams = append(teams, Team{Abbreviation: t.Abbreviation, Name: t.Name})
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Abbreviation < teams[j].Abbreviation })
+	return teams, nil
+}
+
+// GetGame returns the game for gameID with game times shown in loc.
+func (c *Controller) GetGame(gameID string, loc *time.Location) (Game, error) {
+	logger := c.logger.With().Str("method", "GetGame").Logger()
+
+	// finals no longer change so their pages are served without the database
+	if sc, _, ok := c.lastGamePages.Get(gameID); ok && sc.quarter == "F" {
+		return Game{score: sc, loc: loc}, nil
+	}
+
+	g, err := c.repo.GetGameWithTeamAbv(gameID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting game %s", gameID)
+		if errors.Is(err, repository.ErrNoGame) {
+			return Game{}, ErrNoGame
+		}
+		return c.lastGoodGame(gameID, loc, err)
+	}
+
+	gqs, err := c.repo.GetQuarterScoresForGames([]string{gameID})
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting scores for game %s", gameID)
+		return c.lastGoodGame(gameID, loc, err)
+	}
+
+	sc := buildScoreFromDB(gqs, []repository.Game{g})[0]
+	sc.situation = g.GameSituation
+	sc.awayRecord, sc.homeRecord = g.AwayRecord, g.HomeRecord
+
+	// the page is still useful without the scoring summary
+	sc.scoringPlays, err = c.repo.GetScoringPlays(gameID)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting scoring plays for game %s", gameID)
+	} else {
+		c.lastGamePages.Put(gameID, sc)
+	}
+	return Game{score: sc, loc: loc}, nil
+}
+
+// lastGoodGame returns the last page served for the game, marked with when it was current. err is returned when
+// the game has not been served.
+func (c *Controller) lastGoodGame(gameID string, loc *time.Location, err error) (Game, error) {
+	sc, asOf, ok := c.lastGamePages.Get(gameID)
+	if !ok {
+		return Game{}, err
+	}
+	sc.asOf = asOf
+	return Game{score: sc, loc: loc}, nil
+}
+
+// lastGoodScores returns the last scores served for the week starting at start, marked with when they were
+// current. When no scores have been served for the week the board is empty and marked unavailable.
+func (c *Controller) lastGoodScores(start time.Time) Scores {
+	scores, asOf, ok := c.lastGames.Get(weekKey(start))
+	if !ok {
+		return Scores{unavailableAt: time.Now()}
+	}
+
+	stale := make([]score, len(scores))
+	for i, s := range scores {
+		s.asOf = asOf
+		stale[i] = s
+	}
+	return Scores{games: stale}
+}
+
+// buildScoreFromDB builds the scores of games. Game times are left to be shown in the timezone of each request,
+// so the scores can be kept for viewers in any timezone.
+func buildScoreFromDB(gts []repository.GameTeamQuarterScore, games []repository.Game) []score {
+
+	scores := make([]score, 0)
+	for _, g := range games {
 		s := score{
 			gameID: g.ID,
 			awayTeam: team{
 				name:   g.AwayTeam,
+				color:  ansi.TeamColor(g.AwayTeamColor, g.AwayTeamAltColor),
 				scores: getScoresForTeam(g.ID, g.AwayTeam, gts),
 			},
 			homeTeam: team{
 				name:   g.HomeTeam,
+				color:  ansi.TeamColor(g.HomeTeamColor, g.HomeTeamAltColor),
 				scores: getScoresForTeam(g.ID, g.HomeTeam, gts),
 			},
 			quarter:   g.Quarter,
-			gameClock: gameClock,
+			gameClock: g.GameClock,
 			startTime: g.GameTime,
 		}
 
@@ -116,138 +290,147 @@ func getScoresForTeam(gameID, teamName string, quarterScores []repository.GameTe
 	return scores
 }
 
-func (s Scores) PrintScoreboard(writer io.Writer, scoresDate time.Time, scoresPerLine int) error {
-	sb := strings.Builder{}
+// PrintScoreboard writes the scoreboard for scoresDate as text.
+func (s Scores) PrintScoreboard(writer io.Writer, scoresDate time.Time, opts PrintOptions) error {
+	return s.Render(writer, scoresDate, renderer.Text{Options: opts})
+}
 
-	sb.WriteString(fmt.Sprintf("%s\n", scoresDate.Format(headingTimeFormat)))
-	for i := 0; i < len(s); {
-		diff := 0
-		if scoresPerLine+i >= len(s) {
-			diff = (scoresPerLine + i) - len(s)
-		}
-		upperBounds := (scoresPerLine + i) - diff
+// Render writes the scoreboard for scoresDate with r.
+func (s Scores) Render(writer io.Writer, scoresDate time.Time, r renderer.Renderer) error {
+	return r.Render(writer, s.Board(scoresDate))
+}
 
-		if i > 0 {
-			sb.WriteString("\n")
-		}
-		for _, score := range s[i:upperBounds] {
-			sb.WriteString(score.buildTopAndBottomBoarder() + " ")
-		}
-		sb.WriteString("\n")
-		for _, score := range s[i:upperBounds] {
-			sb.WriteString(score.buildQuarterLine() + " ")
-		}
-		sb.WriteString("\n")
-		for _, score := range s[i:upperBounds] {
-			sb.WriteString(score.buildAwayLine() + " ")
-		}
-		sb.WriteString("\n")
-		for _, score := range s[i:upperBounds] {
-			sb.WriteString(score.buildGameClockLine() + " ")
-		}
-		sb.WriteString("\n")
-		for _, score := range s[i:upperBounds] {
-			sb.WriteString(score.buildHomeLine() + " ")
-		}
-		sb.WriteString("\n")
-		for _, score := range s[i:upperBounds] {
-			sb.WriteString(score.buildTopAndBottomBoarder() + " ")
-		}
-		i += scoresPerLine
+// Board maps the scores to the scoreboard for scoresDate, with game times shown in the location of scoresDate.
+func (s Scores) Board(scoresDate time.Time) renderer.Board {
+	games := make([]renderer.Game, 0, len(s.games))
+	for _, sc := range s.games {
+		games = append(games, sc.game(scoresDate.Location()))
 	}
+	return renderer.Board{Date: scoresDate, AsOf: s.asOf(), Games: games}
+}
 
-	_, err := writer.Write([]byte(sb.String()))
-	if err != nil {
-		return err
-	}
-	return nil
+// Render writes the game page with r.
+func (g Game) Render(writer io.Writer, r renderer.Renderer) error {
+	return r.Render(writer, g.Board())
 }
 
-func (s score) buildQuarterLine() string {
-	sb := strings.Builder{}
-	sb.WriteString(fmt.Sprintf("* %-3s", "Q"))
-	for i, _ := range s.awayTeam.scores {
-		sb.WriteString(fmt.Sprintf("%3d", i+1))
-	}
-	sb.WriteString(fmt.Sprintf("%5s", ""))
-	var gapSize = 2
-	if len(s.awayTeam.scores)%2 == 0 {
-		gapSize = 3
-	}
-	sb.WriteString(fmt.Sprintf("%"+strconv.Itoa(gapSize)+"s", "*"))
-	return sb.String()
+// Board maps the game to its page. Games in progress list possession, down and distance and timeouts.
+func (g Game) Board() renderer.Board {
+	game := g.score.game(g.loc)
+	game.Details = g.score.details()
+	return renderer.Board{Date: g.score.startTime.In(g.loc), AsOf: g.score.asOf, Games: []renderer.Game{game}}
 }
 
-func (s score) buildAwayLine() string {
-	sb := strings.Builder{}
-	sb.WriteString(fmt.Sprintf("* %-3s", s.awayTeam.name))
-	var currentScore int
-	for _, val := range s.awayTeam.scores {
-		iVal, err := strconv.Atoi(val)
-		if err != nil {
-			iVal = 0
-			// TODO log
-		}
-		currentScore += iVal
-		sb.WriteString(fmt.Sprintf("%3d", iVal))
-	}
-	sb.WriteString(fmt.Sprintf("%5d", currentScore))
-	var gapSize = 2
-	if len(s.awayTeam.scores)%2 == 0 {
-		gapSize = 3
+// Favorites returns s with the games of selected teams first, dropping other games when the selection hides them.
+func (s Scores) Favorites(sel favorites.Selection) Scores {
+	order := sel.Order(len(s.games), func(i int) []string {
+		return []string{s.games[i].awayTeam.name, s.games[i].homeTeam.name}
+	})
+	ordered := make([]score, 0, len(order))
+	for _, i := range order {
+		ordered = append(ordered, s.games[i])
 	}
-	sb.WriteString(fmt.Sprintf("%"+strconv.Itoa(gapSize)+"s", "*"))
-	return sb.String()
+	return Scores{games: ordered, unavailableAt: s.unavailableAt}
 }
 
-func (s score) buildHomeLine() string {
-	sb := strings.Builder{}
-	sb.WriteString(fmt.Sprintf("* %-3s", s.homeTeam.name))
-	var currentScore int
-	for _, val := range s.homeTeam.scores {
-		iVal, err := strconv.Atoi(val)
-		if err != nil {
-			iVal = 0
-			// TODO log
-		}
-		currentScore += iVal
-		sb.WriteString(fmt.Sprintf("%3d", iVal))
-	}
-	sb.WriteString(fmt.Sprintf("%5d", currentScore))
-	var gapSize = 2
-	if len(s.homeTeam.scores)%2 == 0 {
-		gapSize = 3
+func weekKey(start time.Time) string {
+	return start.Format(time.RFC3339)
+}
+
+// asOf returns when the oldest stale score in s was current, or when s was unavailable, or the zero time when all
+// scores are current.
+func (s Scores) asOf() time.Time {
+	times := make([]time.Time, 0, len(s.games)+1)
+	for _, sc := range s.games {
+		times = append(times, sc.asOf)
 	}
-	sb.WriteString(fmt.Sprintf("%"+strconv.Itoa(gapSize)+"s", "*"))
-	return sb.String()
+	return lastgood.Oldest(append(times, s.unavailableAt)...)
 }
 
-func (s score) buildGameClockLine() string {
-	sb := strings.Builder{}
-	sb.WriteString(fmt.Sprintf("* Q%-2s", s.quarter))
+// inProgress reports if the game has started and is not final.
+func (s score) inProgress() bool {
+	return s.quarter != "" && s.quarter != "F"
+}
 
-	lineLen := 3*len(s.homeTeam.scores) + 5
-	sb.WriteString(fmt.Sprintf("%"+strconv.Itoa(lineLen)+"s", s.gameClock))
+// game maps the score to its game with the game time of games that have not started shown in loc.
+func (s score) game(loc *time.Location) renderer.Game {
+	periods := len(s.awayTeam.scores)
+	if len(s.homeTeam.scores) > periods {
+		periods = len(s.homeTeam.scores)
+	}
+
+	state := renderer.Scheduled
+	switch {
+	case s.quarter == "F":
+		state = renderer.Final
+	case s.quarter != "":
+		state = renderer.Live
+	}
 
-	var gapSize = 2
-	if len(s.homeTeam.scores)%2 == 0 {
-		gapSize = 3
+	return renderer.Game{
+		ID:          s.gameID,
+		PeriodLabel: "Q",
+		Periods:     periods,
+		TotalLabels: []string{"T"},
+		Away:        s.awayTeam.team(),
+		Home:        s.homeTeam.team(),
+		Status: renderer.Status{
+			State:  state,
+			Detail: "Q" + s.quarter,
+			Clock:  s.clock(loc),
+			Start:  s.startTime,
+		},
 	}
-	sb.WriteString(fmt.Sprintf("%"+strconv.Itoa(gapSize)+"s", "*"))
+}
 
-	return sb.String()
+// clock is the game clock, or the game time in loc before the game has a clock.
+func (s score) clock(loc *time.Location) string {
+	if s.gameClock == "" {
+		return s.startTime.In(loc).Format(gameTimeFormat)
+	}
+	return s.gameClock
 }
 
-func (s score) buildTopAndBottomBoarder() string {
-	sb := strings.Builder{}
-	lineLen := (10 + 3*len(s.awayTeam.scores)) / 2
-	for i := 0; i < lineLen; i++ {
-		sb.WriteString("* ")
+func (s score) details() []renderer.Detail {
+	details := make([]renderer.Detail, 0, 4)
+	if s.quarter != "" && s.quarter != "F" {
+		if s.situation.Possession != "" {
+			details = append(details, renderer.Detail{Label: "Possession", Value: s.situation.Possession})
+		}
+		if s.situation.DownDistance != "" {
+			details = append(details, renderer.Detail{Label: "Down", Value: s.situation.DownDistance})
+		}
+		details = append(details, renderer.Detail{Label: "Timeouts", Value: fmt.Sprintf("%s %d, %s %d",
+			s.awayTeam.name, s.situation.AwayTimeouts, s.homeTeam.name, s.situation.HomeTimeouts)})
+	}
+	if s.awayRecord != "" && s.homeRecord != "" {
+		details = append(details, renderer.Detail{Label: "Records", Value: fmt.Sprintf("%s %s, %s %s",
+			s.awayTeam.name, s.awayRecord, s.homeTeam.name, s.homeRecord)})
+	}
+	for _, play := range s.scoringPlays {
+		details = append(details, renderer.Detail{Label: "Scoring", Value: scoringSummary(play)})
 	}
+	return details
+}
 
-	sb.WriteString("* *")
+// scoringSummary describes a scoring play like KC TD 7:32 Q2 — Travis Kelce 12 Yd pass from Patrick Mahomes.
+func scoringSummary(play repository.ScoringPlay) string {
+	return fmt.Sprintf("%s %s %s Q%s — %s", play.TeamAbbreviation, play.Type, play.Clock, play.Quarter, play.Description)
+}
 
-	return sb.String()
+// team maps quarter scores that are not numbers to 0.
+func (t team) team() renderer.Team {
+	periods := make([]string, 0, len(t.scores))
+	total := 0
+	for _, val := range t.scores {
+		score, err := strconv.Atoi(val)
+		if err != nil {
+			score = 0
+		}
+		total += score
+		periods = append(periods, strconv.Itoa(score))
+	}
+	return renderer.Team{Name: t.name, Color: t.color, Periods: periods, Totals: []string{strconv.Itoa(total)}}
 }
 
 func (b ByGameTime) Len() int {
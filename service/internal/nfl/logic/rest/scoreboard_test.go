@@ -3,8 +3,15 @@ package rest
 import (
 	"bytes"
 	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/general"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"testing"
 	"time"
 )
@@ -16,12 +23,13 @@ func TestScores_PrintScoreboard(t *testing.T) {
 	dateString := fmt.Sprintf("%s\n", now.Format(headingTimeFormat))
 	testCases := map[string]struct {
 		scores         Scores
+		width          int
 		boardsPerLine  int
 		expectedString string
 		err            error
 	}{
 		"print one game": {
-			scores: Scores{{
+			scores: Scores{games: []score{{
 				awayTeam: team{
 					name: "PIT",
 					scores: []string{
@@ -37,7 +45,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 				quarter:   "4",
 				gameClock: "05:43",
 				startTime: start,
-			}},
+			}}},
 			expectedString: dateString + `* * * * * * * * * * * * * 
 * Q    1  2  3  4       * 
 * PIT 14  7 10  7   38  * 
@@ -47,7 +55,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 			boardsPerLine: 1,
 		},
 		"print one game OT": {
-			scores: Scores{{
+			scores: Scores{games: []score{{
 				awayTeam: team{
 					name: "PIT",
 					scores: []string{
@@ -63,7 +71,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 				quarter:   "4",
 				gameClock: "05:43",
 				startTime: start,
-			}},
+			}}},
 			boardsPerLine: 2,
 			expectedString: dateString + `* * * * * * * * * * * * * * 
 * Q    1  2  3  4  5      * 
@@ -73,7 +81,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 * * * * * * * * * * * * * * `,
 		},
 		"print two games one line": {
-			scores: Scores{{
+			scores: Scores{games: []score{{
 				awayTeam: team{
 					name: "PIT",
 					scores: []string{
@@ -107,7 +115,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 					gameClock: "05:43",
 					startTime: start,
 				},
-			},
+			}},
 			expectedString: dateString + `* * * * * * * * * * * * * * * * * * * * * * * * * * 
 * Q    1  2  3  4       * * Q    1  2  3  4       * 
 * PIT 14  7 10  7   38  * * BAL 14  7 10  7   38  * 
@@ -117,7 +125,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 			boardsPerLine: 2,
 		},
 		"print two games two lines": {
-			scores: Scores{{
+			scores: Scores{games: []score{{
 				awayTeam: team{
 					name: "PIT",
 					scores: []string{
@@ -151,7 +159,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 					gameClock: "05:43",
 					startTime: start,
 				},
-			},
+			}},
 			expectedString: dateString + `* * * * * * * * * * * * * 
 * Q    1  2  3  4       * 
 * PIT 14  7 10  7   38  * 
@@ -167,7 +175,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 			boardsPerLine: 1,
 		},
 		"print three games two lines": {
-			scores: Scores{{
+			scores: Scores{games: []score{{
 				awayTeam: team{
 					name: "PIT",
 					scores: []string{
@@ -218,7 +226,7 @@ func TestScores_PrintScoreboard(t *testing.T) {
 					gameClock: "05:43",
 					startTime: start,
 				},
-			},
+			}},
 			expectedString: dateString + `* * * * * * * * * * * * * * * * * * * * * * * * * * 
 * Q    1  2  3  4       * * Q    1  2  3  4       * 
 * PIT 14  7 10  7   38  * * BAL 14  7 10  7   38  * 
@@ -233,13 +241,76 @@ func TestScores_PrintScoreboard(t *testing.T) {
 * * * * * * * * * * * * * `,
 			boardsPerLine: 2,
 		},
+		"print games to fit width aligned with overtime": {
+			scores: Scores{games: []score{{
+				awayTeam:  team{name: "PIT", scores: []string{"14", "7", "10", "7", "7"}},
+				homeTeam:  team{name: "SF", scores: []string{"10", "10", "3", "10", "3"}},
+				quarter:   "5",
+				gameClock: "05:43",
+				startTime: start,
+			}, {
+				awayTeam:  team{name: "BAL", scores: []string{"14", "7", "10", "7"}},
+				homeTeam:  team{name: "IND", scores: []string{"10", "10", "3", "10"}},
+				quarter:   "4",
+				gameClock: "05:43",
+				startTime: start,
+			}, {
+				awayTeam:  team{name: "ATL", scores: []string{"14", "7", "10", "7"}},
+				homeTeam:  team{name: "KC", scores: []string{"10", "10", "3", "10"}},
+				quarter:   "4",
+				gameClock: "05:43",
+				startTime: start,
+			}}},
+			width:         60,
+			boardsPerLine: 3,
+			expectedString: dateString + `* * * * * * * * * * * * * * * * * * * * * * * * * * * 
+* Q    1  2  3  4  5      * * Q    1  2  3  4       * 
+* PIT 14  7 10  7  7   45 * * BAL 14  7 10  7   38  * 
+* Q5                05:43 * * Q4             05:43  * 
+* SF  10 10  3 10  3   36 * * IND 10 10  3 10   33  * 
+* * * * * * * * * * * * * * * * * * * * * * * * * * * 
+* * * * * * * * * * * * *   
+* Q    1  2  3  4       *   
+* ATL 14  7 10  7   38  *   
+* Q4             05:43  *   
+* KC  10 10  3 10   33  *   
+* * * * * * * * * * * * *   `,
+		},
+		"print stale game with banner": {
+			scores: Scores{games: []score{{
+				awayTeam: team{
+					name: "PIT",
+					scores: []string{
+						"14", "7", "10", "7",
+					},
+				},
+				homeTeam: team{
+					name: "SF",
+					scores: []string{
+						"10", "10", "3", "10",
+					},
+				},
+				quarter:   "4",
+				gameClock: "05:43",
+				startTime: start,
+				asOf:      time.Date(2023, 9, 10, 15, 4, 0, 0, time.Local),
+			}}},
+			expectedString: dateString + `as of 15:04, updates delayed
+* * * * * * * * * * * * * 
+* Q    1  2  3  4       * 
+* PIT 14  7 10  7   38  * 
+* Q4             05:43  * 
+* SF  10 10  3 10   33  * 
+* * * * * * * * * * * * * `,
+			boardsPerLine: 1,
+		},
 	}
 	for name, tc := range testCases {
 		name := name
 		tc := tc
 		t.Run(name, func(t *testing.T) {
 			bw := bytes.Buffer{}
-			err := tc.scores.PrintScoreboard(&bw, now, tc.boardsPerLine)
+			err := tc.scores.PrintScoreboard(&bw, now, PrintOptions{Width: tc.width, PerLine: tc.boardsPerLine})
 			assert.ErrorIs(t, err, tc.err)
 			gotString := bw.String()
 			fmt.Println(gotString)
@@ -247,3 +318,441 @@ func TestScores_PrintScoreboard(t *testing.T) {
 		})
 	}
 }
+
+func TestController_GetScoreboardForDate(t *testing.T) {
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, time.Local)
+	games := []repository.Game{{
+		ID:        "401547353",
+		GameTime:  date.Add(13 * time.Hour),
+		Quarter:   "2",
+		GameClock: "7:32",
+		AwayTeam:  "KC",
+		HomeTeam:  "BUF",
+	}}
+	quarterScores := []repository.GameTeamQuarterScore{
+		{GameID: "401547353", TeamAbbreviation: "KC", Quarter: "1", Score: "7"},
+		{GameID: "401547353", TeamAbbreviation: "BUF", Quarter: "1", Score: "3"},
+	}
+
+	testCases := map[string]struct {
+		mockRepo      func(ctrl *gomock.Controller) *repository.MockRepository
+		primed        bool
+		expectedStale bool
+		expectedLen   int
+	}{
+		"should return current scores": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetGamesWithTeamAbv(gomock.Any(), gomock.Any()).Return(games, nil)
+				mockRepo.EXPECT().GetSyncTime(repository.SyncGameInfo).Return(time.Now(), nil)
+				return mockRepo
+			},
+			expectedLen: 1,
+		},
+		"should return scores as of the last sync when the scheduler has stopped": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetGamesWithTeamAbv(gomock.Any(), gomock.Any()).Return(games, nil)
+				mockRepo.EXPECT().GetSyncTime(repository.SyncGameInfo).Return(time.Now().Add(-staleAfter), nil)
+				return mockRepo
+			},
+			expectedStale: true,
+			expectedLen:   1,
+		},
+		"should return current scores when the last sync is not known": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetGamesWithTeamAbv(gomock.Any(), gomock.Any()).Return(games, nil)
+				mockRepo.EXPECT().GetSyncTime(repository.SyncGameInfo).Return(time.Time{}, repository.ErrNoSyncStatus)
+				return mockRepo
+			},
+			expectedLen: 1,
+		},
+		"should return last good scores when the database fails": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			primed:        true,
+			expectedStale: true,
+			expectedLen:   1,
+		},
+		"should return an empty board marked stale when the database fails and nothing was served": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetGamesWithTeamAbv(gomock.Any(), gomock.Any()).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedStale: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{
+				logger:    zerolog.Nop(),
+				repo:      tc.mockRepo(ctrl),
+				lastGames: lastgood.New[[]score](lastGamesCapacity),
+			}
+			if tc.primed {
+				c.lastGames.Put(weekKey(general.StartTime(date)), buildScoreFromDB(quarterScores, games))
+			}
+
+			scores, err := c.GetScoreboardForDate(date)
+
+			require.NoError(t, err)
+			board := scores.Board(date)
+			assert.Len(t, board.Games, tc.expectedLen)
+			assert.Equal(t, tc.expectedStale, !board.AsOf.IsZero())
+		})
+	}
+}
+
+func TestController_markStale(t *testing.T) {
+	now := time.Date(2023, 9, 10, 18, 0, 0, 0, time.UTC)
+	syncedAt := now.Add(-10 * time.Minute)
+	scores := []score{{gameID: "1", quarter: "3"}, {gameID: "2", quarter: "F"}, {gameID: "3"}}
+
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepository(ctrl)
+	mockRepo.EXPECT().GetSyncTime(repository.SyncGameInfo).Return(syncedAt, nil)
+	c := &Controller{logger: zerolog.Nop(), repo: mockRepo}
+
+	stale := c.markStale(scores, now)
+
+	assert.Equal(t, syncedAt, stale[0].asOf, "games in progress should be as of the last sync")
+	assert.True(t, stale[1].asOf.IsZero(), "final games should not be stale")
+	assert.True(t, stale[2].asOf.IsZero(), "games that have not started should not be stale")
+	assert.True(t, scores[0].asOf.IsZero(), "the last good scores should not be changed")
+	assert.Equal(t, scores[1:], c.markStale(scores[1:], now), "the last sync should not be read without games in progress")
+}
+
+func TestController_GetScoreboardForDate_UKTimezone(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, london)
+	start := time.Date(2023, 9, 5, 0, 0, 0, 0, general.League).UTC()
+	end := time.Date(2023, 9, 11, 23, 59, 59, 0, general.League).UTC()
+	mondayNight := repository.Game{
+		ID:       "401547354",
+		GameTime: time.Date(2023, 9, 12, 0, 15, 0, 0, time.UTC),
+		AwayTeam: "BUF",
+		HomeTeam: "NYJ",
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepository(ctrl)
+	mockRepo.EXPECT().GetGameTeamQuarterScore(start, &end).Return(nil, nil)
+	mockRepo.EXPECT().GetGamesWithTeamAbv(start, &end).Return([]repository.Game{mondayNight}, nil)
+	c := &Controller{logger: zerolog.Nop(), repo: mockRepo, lastGames: lastgood.New[[]score](lastGamesCapacity)}
+
+	scores, err := c.GetScoreboardForDate(date)
+
+	require.NoError(t, err)
+	require.Len(t, scores.games, 1)
+	assert.Equal(t, "Tue, 1:15 AM", scores.Board(date).Games[0].Status.Clock, "kickoff should be shown in the viewer's timezone")
+}
+
+func TestController_GetScoreboardForDate_LastGoodTimezone(t *testing.T) {
+	london, err := time.LoadLocation("Europe/London")
+	require.NoError(t, err)
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	mondayNight := repository.Game{
+		ID:       "401547354",
+		GameTime: time.Date(2023, 9, 12, 0, 15, 0, 0, time.UTC),
+		AwayTeam: "BUF",
+		HomeTeam: "NYJ",
+	}
+
+	ctrl := gomock.NewController(t)
+	mockRepo := repository.NewMockRepository(ctrl)
+	gomock.InOrder(
+		mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(nil, nil),
+		mockRepo.EXPECT().GetGameTeamQuarterScore(gomock.Any(), gomock.Any()).Return(nil, repository.ErrSqlError),
+	)
+	mockRepo.EXPECT().GetGamesWithTeamAbv(gomock.Any(), gomock.Any()).Return([]repository.Game{mondayNight}, nil)
+	c := &Controller{logger: zerolog.Nop(), repo: mockRepo, lastGames: lastgood.New[[]score](lastGamesCapacity)}
+
+	_, err = c.GetScoreboardForDate(time.Date(2023, 9, 10, 0, 0, 0, 0, london))
+	require.NoError(t, err)
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, newYork)
+	scores, err := c.GetScoreboardForDate(date)
+
+	require.NoError(t, err)
+	board := scores.Board(date)
+	require.Len(t, board.Games, 1)
+	assert.False(t, board.AsOf.IsZero(), "the last good scores should be served")
+	assert.Equal(t, "Mon, 8:15 PM", board.Games[0].Status.Clock, "kickoff should be shown in the timezone of the request, not the last viewer's")
+}
+
+func TestController_GetGame(t *testing.T) {
+	kickoff := time.Date(2023, 9, 10, 17, 0, 0, 0, time.UTC)
+	game := repository.Game{
+		ID:            "401547353",
+		GameTime:      kickoff,
+		Quarter:       "2",
+		GameClock:     "7:32",
+		AwayTeam:      "KC",
+		HomeTeam:      "BUF",
+		AwayRecord:    "10-3",
+		HomeRecord:    "9-4",
+		GameSituation: repository.GameSituation{Possession: "KC", DownDistance: "3rd & 4 at BUF 40", AwayTimeouts: 2, HomeTimeouts: 3},
+	}
+	quarterScores := []repository.GameTeamQuarterScore{
+		{GameID: "401547353", TeamAbbreviation: "KC", Quarter: "1", Score: "7"},
+		{GameID: "401547353", TeamAbbreviation: "BUF", Quarter: "1", Score: "3"},
+	}
+
+	testCases := map[string]struct {
+		mockRepo        func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedDetails []renderer.Detail
+		expectedErr     error
+	}{
+		"should return game with situation and records": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(game, nil)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"401547353"}).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetScoringPlays("401547353").Return([]repository.ScoringPlay{
+					{TeamAbbreviation: "KC", Type: "TD", Clock: "7:32", Quarter: "2", Description: "Kelce 12 yd pass"},
+				}, nil)
+				return mockRepo
+			},
+			expectedDetails: []renderer.Detail{
+				{Label: "Possession", Value: "KC"},
+				{Label: "Down", Value: "3rd & 4 at BUF 40"},
+				{Label: "Timeouts", Value: "KC 2, BUF 3"},
+				{Label: "Records", Value: "KC 10-3, BUF 9-4"},
+				{Label: "Scoring", Value: "KC TD 7:32 Q2 — Kelce 12 yd pass"},
+			},
+		},
+		"should return game without scoring summary when plays fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(game, nil)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"401547353"}).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetScoringPlays("401547353").Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedDetails: []renderer.Detail{
+				{Label: "Possession", Value: "KC"},
+				{Label: "Down", Value: "3rd & 4 at BUF 40"},
+				{Label: "Timeouts", Value: "KC 2, BUF 3"},
+				{Label: "Records", Value: "KC 10-3, BUF 9-4"},
+			},
+		},
+		"should return ErrNoGame for unknown games": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(repository.Game{}, repository.ErrNoGame)
+				return mockRepo
+			},
+			expectedErr: ErrNoGame,
+		},
+		"should return error when the database fails": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(game, nil)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"401547353"}).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl), lastGamePages: lastgood.New[score](lastGamePagesCapacity)}
+
+			g, err := c.GetGame("401547353", time.UTC)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Equal(t, []string{"7"}, g.score.awayTeam.scores)
+			assert.Equal(t, tc.expectedDetails, g.score.details())
+		})
+	}
+}
+
+func TestController_GetGameLastGood(t *testing.T) {
+	game := repository.Game{ID: "401547353", GameTime: time.Date(2023, 9, 10, 17, 0, 0, 0, time.UTC), Quarter: "2", AwayTeam: "KC", HomeTeam: "BUF"}
+	quarterScores := []repository.GameTeamQuarterScore{
+		{GameID: "401547353", TeamAbbreviation: "KC", Quarter: "1", Score: "7"},
+		{GameID: "401547353", TeamAbbreviation: "BUF", Quarter: "1", Score: "3"},
+	}
+	final := game
+	final.Quarter = "F"
+
+	testCases := map[string]struct {
+		mockRepo      func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedStale bool
+	}{
+		"should serve the last page when the database fails": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(game, nil)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"401547353"}).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetScoringPlays("401547353").Return(nil, nil)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(repository.Game{}, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedStale: true,
+		},
+		"should serve finals without the database": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetGameWithTeamAbv("401547353").Return(final, nil)
+				mockRepo.EXPECT().GetQuarterScoresForGames([]string{"401547353"}).Return(quarterScores, nil)
+				mockRepo.EXPECT().GetScoringPlays("401547353").Return(nil, nil)
+				return mockRepo
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl), lastGamePages: lastgood.New[score](lastGamePagesCapacity)}
+
+			_, err := c.GetGame("401547353", time.UTC)
+			require.NoError(t, err)
+			g, err := c.GetGame("401547353", time.UTC)
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"7"}, g.score.awayTeam.scores)
+			assert.Equal(t, tc.expectedStale, !g.Board().AsOf.IsZero())
+		})
+	}
+}
+
+func TestScores_Favorites(t *testing.T) {
+	scores := Scores{games: []score{
+		{gameID: "1", awayTeam: team{name: "KC"}, homeTeam: team{name: "DET"}},
+		{gameID: "2", awayTeam: team{name: "CAR"}, homeTeam: team{name: "ATL"}},
+		{gameID: "3", awayTeam: team{name: "ARI"}, homeTeam: team{name: "BUF"}},
+	}}
+
+	testCases := map[string]struct {
+		sel             favorites.Selection
+		expectedGameIDs []string
+	}{
+		"should keep order without favorites": {
+			expectedGameIDs: []string{"1", "2", "3"},
+		},
+		"should put favorites first": {
+			sel:             favorites.Selection{Teams: []string{"BUF"}},
+			expectedGameIDs: []string{"3", "1", "2"},
+		},
+		"should only show selected teams": {
+			sel:             favorites.Selection{Teams: []string{"KC", "BUF"}, HideOthers: true},
+			expectedGameIDs: []string{"1", "3"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			gameIDs := make([]string, 0)
+			for _, s := range scores.Favorites(tc.sel).games {
+				gameIDs = append(gameIDs, s.gameID)
+			}
+			assert.Equal(t, tc.expectedGameIDs, gameIDs)
+		})
+	}
+}
+
+func TestBuildScoreFromDB_GameTimeLocation(t *testing.T) {
+	games := []repository.Game{{
+		ID:       "401547353",
+		GameTime: time.Date(2023, 9, 10, 17, 0, 0, 0, time.UTC),
+		AwayTeam: "KC",
+		HomeTeam: "BUF",
+	}}
+
+	testCases := map[string]struct {
+		location         string
+		expectedGameTime string
+	}{
+		"should show kickoff in eastern time": {
+			location:         "America/New_York",
+			expectedGameTime: "Sun, 1:00 PM",
+		},
+		"should show kickoff in pacific time": {
+			location:         "America/Los_Angeles",
+			expectedGameTime: "Sun, 10:00 AM",
+		},
+		"should show kickoff in uk time": {
+			location:         "Europe/London",
+			expectedGameTime: "Sun, 6:00 PM",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tc.location)
+			require.NoError(t, err)
+
+			scores := buildScoreFromDB(nil, games)
+
+			require.Len(t, scores, 1)
+			assert.Equal(t, tc.expectedGameTime, scores[0].game(loc).Status.Clock)
+		})
+	}
+}
+
+func TestScore_game(t *testing.T) {
+	kc := team{name: "KC", color: "e31837", scores: []string{"7", "7", "0", "7"}}
+	buf := team{name: "BUF", scores: []string{"3", "0", "10"}}
+	start := time.Date(2023, 10, 1, 13, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		score    score
+		expected renderer.Status
+	}{
+		"should be scheduled before kickoff": {
+			score:    score{awayTeam: kc, homeTeam: buf, startTime: start},
+			expected: renderer.Status{State: renderer.Scheduled, Detail: "Q", Clock: "Sun, 1:00 PM", Start: start},
+		},
+		"should be live during a quarter": {
+			score:    score{awayTeam: kc, homeTeam: buf, quarter: "4", gameClock: "2:00", startTime: start},
+			expected: renderer.Status{State: renderer.Live, Detail: "Q4", Clock: "2:00", Start: start},
+		},
+		"should be final after the game": {
+			score:    score{awayTeam: kc, homeTeam: buf, quarter: "F", gameClock: "Final", startTime: start},
+			expected: renderer.Status{State: renderer.Final, Detail: "QF", Clock: "Final", Start: start},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			g := tc.score.game(time.UTC)
+
+			assert.Equal(t, tc.expected, g.Status)
+			assert.Equal(t, 4, g.Periods)
+			assert.Equal(t, []string{"T"}, g.TotalLabels)
+			assert.Equal(t, renderer.Team{Name: "KC", Color: "e31837", Periods: []string{"7", "7", "0", "7"}, Totals: []string{"21"}}, g.Away)
+			assert.Equal(t, renderer.Team{Name: "BUF", Periods: []string{"3", "0", "10"}, Totals: []string{"13"}}, g.Home)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/http/scraper"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/standings"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var standingsColumns = []string{"W", "L", "T", "PCT", "PF", "PA", "NET", "DIV", "STRK"}
+
+// Standings is the page of division standings of a season.
+type Standings struct {
+	Season    int
+	Divisions []standings.Division
+}
+
+// GetStandings returns the division standings of the regular season that starts in season.
+func (c *Controller) GetStandings(season int) (Standings, error) {
+	logger := c.logger.With().Str("method", "GetStandings").Logger()
+
+	dbTeams, err := c.repo.GetAllTeams()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting teams")
+		return Standings{}, err
+	}
+
+	dbResults, err := c.repo.GetSeasonResults(season, int(scraper.RegSeason))
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting results of %d", season)
+		return Standings{}, err
+	}
+
+	teams := make([]standings.Team, 0, len(dbTeams))
+	for _, t := range dbTeams {
+		teams = append(teams, standings.Team{Abbreviation: t.Abbreviation, Conference: t.Conference, Division: t.Division})
+	}
+	results := make([]standings.Result, 0, len(dbResults))
+	for _, r := range dbResults {
+		results = append(results, standings.Result{
+			GameTime:  r.GameTime,
+			AwayTeam:  r.AwayTeam,
+			HomeTeam:  r.HomeTeam,
+			AwayScore: r.AwayScore,
+			HomeScore: r.HomeScore,
+		})
+	}
+
+	return Standings{Season: season, Divisions: standings.Compute(teams, results)}, nil
+}
+
+func (s Standings) Render(writer io.Writer, r renderer.TableRenderer) error {
+	return r.RenderTables(writer, s.Tables())
+}
+
+// Tables returns a table for each division.
+func (s Standings) Tables() renderer.Tables {
+	tables := renderer.Tables{Heading: fmt.Sprintf("%d NFL Standings", s.Season)}
+	for _, d := range s.Divisions {
+		table := renderer.Table{Title: d.Conference + " " + d.Name, Columns: standingsColumns}
+		for _, st := range d.Standings {
+			table.Rows = append(table.Rows, []string{
+				st.Team.Abbreviation,
+				strconv.Itoa(st.Overall.Wins),
+				strconv.Itoa(st.Overall.Losses),
+				strconv.Itoa(st.Overall.Ties),
+				pct(st.Overall.Pct()),
+				strconv.Itoa(st.PointsFor),
+				strconv.Itoa(st.PointsAgainst),
+				fmt.Sprintf("%+d", st.Net()),
+				st.Division.String(),
+				st.Streak.String(),
+			})
+		}
+		tables.Tables = append(tables.Tables, table)
+	}
+	return tables
+}
+
+// pct formats a win percentage the way standings do, like .647 or 1.000.
+func pct(p float64) string {
+	return strings.TrimPrefix(fmt.Sprintf("%.3f", p), "0")
+}
@@ -0,0 +1,92 @@
+package rest
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+func TestController_GetStandings(t *testing.T) {
+	teams := []*repository.Team{
+		{Abbreviation: "BUF", Conference: "AFC", Division: "East"},
+		{Abbreviation: "MIA", Conference: "AFC", Division: "East"},
+		{Abbreviation: "DAL", Conference: "NFC", Division: "East"},
+	}
+	results := []repository.GameResult{
+		{GameID: "1", GameTime: time.Date(2023, 9, 10, 17, 0, 0, 0, time.UTC), AwayTeam: "MIA", HomeTeam: "BUF", AwayScore: 20, HomeScore: 48},
+		{GameID: "2", GameTime: time.Date(2023, 9, 17, 17, 0, 0, 0, time.UTC), AwayTeam: "DAL", HomeTeam: "MIA", AwayScore: 22, HomeScore: 20},
+	}
+
+	testCases := map[string]struct {
+		mockRepo       func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedTables renderer.Tables
+		expectedErr    error
+	}{
+		"should return division tables of the regular season": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				mockRepo.EXPECT().GetSeasonResults(2023, 2).Return(results, nil)
+				return mockRepo
+			},
+			expectedTables: renderer.Tables{
+				Heading: "2023 NFL Standings",
+				Tables: []renderer.Table{
+					{
+						Title:   "AFC East",
+						Columns: standingsColumns,
+						Rows: [][]string{
+							{"BUF", "1", "0", "0", "1.000", "48", "20", "+28", "1-0", "W1"},
+							{"MIA", "0", "2", "0", ".000", "40", "70", "-30", "0-1", "L2"},
+						},
+					},
+					{
+						Title:   "NFC East",
+						Columns: standingsColumns,
+						Rows: [][]string{
+							{"DAL", "1", "0", "0", "1.000", "22", "20", "+2", "0-0", "W1"},
+						},
+					},
+				},
+			},
+		},
+		"should return error when teams fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+		"should return error when results fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetAllTeams().Return(teams, nil)
+				mockRepo.EXPECT().GetSeasonResults(2023, 2).Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			s, err := c.GetStandings(2023)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Equal(t, tc.expectedTables, s.Tables())
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package rest
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/webhookstore"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"net/url"
+	"strings"
+	"time"
+)
+
+var _ WebhookRegistry = &Controller{}
+
+var (
+	// ErrInvalidWebhook is returned when a webhook can not be registered as asked.
+	ErrInvalidWebhook = errors.New("invalid webhook")
+	// ErrNoWebhook is returned when there is no webhook for an id.
+	ErrNoWebhook = errors.New("no webhook")
+)
+
+type (
+	// WebhookRegistry registers the webhooks the scheduler posts NFL events to and the server posts MLB events to.
+	WebhookRegistry interface {
+		RegisterWebhook(registration WebhookRegistration) (Webhook, error)
+		GetWebhooks() ([]Webhook, error)
+		DeleteWebhook(id string) error
+	}
+
+	// WebhookRegistration asks for events to be posted to URL. Empty filters match everything.
+	WebhookRegistration struct {
+		URL        string   `json:"url"`
+		Sports     []string `json:"sports"`
+		Teams      []string `json:"teams"`
+		EventTypes []string `json:"event_types"`
+	}
+
+	// Webhook is a registered webhook. Secret is only set when it is registered, it signs every delivery.
+	Webhook struct {
+		ID         string    `json:"id"`
+		URL        string    `json:"url"`
+		Secret     string    `json:"secret,omitempty"`
+		Sports     []string  `json:"sports"`
+		Teams      []string  `json:"teams"`
+		EventTypes []string  `json:"event_types"`
+		CreatedAt  time.Time `json:"created_at"`
+	}
+)
+
+// RegisterWebhook validates the registration and stores it with a new secret.
+func (c *Controller) RegisterWebhook(registration WebhookRegistration) (Webhook, error) {
+	logger := c.logger.With().Str("method", "RegisterWebhook").Logger()
+
+	model, err := webhookModel(registration)
+	if err != nil {
+		return Webhook{}, err
+	}
+	if model.Secret, err = newSecret(); err != nil {
+		logger.Error().Err(err).Msg("while generating secret")
+		return Webhook{}, err
+	}
+
+	id, err := c.repo.InsertWebhook(model)
+	if err != nil {
+		logger.Error().Err(err).Msg("while inserting webhook")
+		return Webhook{}, err
+	}
+	model.ID = id
+	model.CreatedAt = time.Now()
+
+	w := webhookFromModel(model)
+	w.Secret = model.Secret
+	return w, nil
+}
+
+// WebhookStore returns the store the scheduler sends NFL webhooks with, so events the server sends reach the same
+// webhooks and their failed deliveries are dead lettered alike.
+func (c *Controller) WebhookStore() webhook.Store {
+	return webhookstore.New(c.repo)
+}
+
+// GetWebhooks returns the registered webhooks without their secrets, oldest first.
+func (c *Controller) GetWebhooks() ([]Webhook, error) {
+	logger := c.logger.With().Str("method", "GetWebhooks").Logger()
+
+	models, err := c.repo.GetWebhooks()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting webhooks")
+		return nil, err
+	}
+
+	webhooks := make([]Webhook, 0, len(models))
+	for _, m := range models {
+		webhooks = append(webhooks, webhookFromModel(m))
+	}
+	return webhooks, nil
+}
+
+// DeleteWebhook stops events being posted to the webhook with id.
+func (c *Controller) DeleteWebhook(id string) error {
+	logger := c.logger.With().Str("method", "DeleteWebhook").Logger()
+
+	webhookID, err := uuid.Parse(id)
+	if err != nil {
+		return ErrNoWebhook
+	}
+	if err := c.repo.DeleteWebhook(webhookID); err != nil {
+		if errors.Is(err, repository.ErrNoWebhook) {
+			return ErrNoWebhook
+		}
+		logger.Error().Err(err).Msg("while deleting webhook")
+		return err
+	}
+	return nil
+}
+
+// webhookModel checks the registration, lower casing sports and event types and upper casing teams as they are
+// stored.
+func webhookModel(registration WebhookRegistration) (repository.Webhook, error) {
+	u, err := url.Parse(registration.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return repository.Webhook{}, fmt.Errorf("%w: url must be an absolute http or https url", ErrInvalidWebhook)
+	}
+
+	model := repository.Webhook{URL: u.String(), Sports: pq.StringArray{}, Teams: pq.StringArray{}, EventTypes: pq.StringArray{}}
+	for _, s := range registration.Sports {
+		if !webhook.IsSport(s) {
+			return repository.Webhook{}, fmt.Errorf("%w: unknown sport %q", ErrInvalidWebhook, s)
+		}
+		model.Sports = append(model.Sports, strings.ToLower(s))
+	}
+	for _, t := range registration.Teams {
+		if t = strings.TrimSpace(t); t == "" {
+			return repository.Webhook{}, fmt.Errorf("%w: empty team", ErrInvalidWebhook)
+		}
+		model.Teams = append(model.Teams, strings.ToUpper(t))
+	}
+	for _, e := range registration.EventTypes {
+		if !webhook.IsEventType(e) {
+			return repository.Webhook{}, fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, e)
+		}
+		model.EventTypes = append(model.EventTypes, strings.ToLower(e))
+	}
+	return model, nil
+}
+
+func webhookFromModel(m repository.Webhook) Webhook {
+	return Webhook{
+		ID:         m.ID.String(),
+		URL:        m.URL,
+		Sports:     append([]string{}, m.Sports...),
+		Teams:      append([]string{}, m.Teams...),
+		EventTypes: append([]string{}, m.EventTypes...),
+		CreatedAt:  m.CreatedAt,
+	}
+}
+
+func newSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
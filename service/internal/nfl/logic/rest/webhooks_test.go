@@ -0,0 +1,208 @@
+package rest
+
+import (
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"testing"
+	"time"
+)
+
+var webhookID = uuid.MustParse("6f1c2a52-0d0e-4d1a-9c55-3b1c1e8f7a10")
+
+func TestController_RegisterWebhook(t *testing.T) {
+	testCases := map[string]struct {
+		registration    WebhookRegistration
+		mockRepo        func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedWebhook Webhook
+		expectedErr     error
+	}{
+		"should store webhook with normalized filters": {
+			registration: WebhookRegistration{URL: "http://localhost:8081/hook", Sports: []string{"NFL"}, Teams: []string{" kc"}, EventTypes: []string{"Final"}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertWebhook(gomock.Any()).DoAndReturn(func(w repository.Webhook) (uuid.UUID, error) {
+					assert.Equal(t, "http://localhost:8081/hook", w.URL)
+					assert.Len(t, w.Secret, 64)
+					assert.Equal(t, pq.StringArray{"nfl"}, w.Sports)
+					assert.Equal(t, pq.StringArray{"KC"}, w.Teams)
+					assert.Equal(t, pq.StringArray{"final"}, w.EventTypes)
+					return webhookID, nil
+				})
+				return mockRepo
+			},
+			expectedWebhook: Webhook{ID: webhookID.String(), URL: "http://localhost:8081/hook", Sports: []string{"nfl"}, Teams: []string{"KC"}, EventTypes: []string{"final"}},
+		},
+		"should store webhook without filters": {
+			registration: WebhookRegistration{URL: "https://example.com/hook"},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertWebhook(gomock.Any()).Return(webhookID, nil)
+				return mockRepo
+			},
+			expectedWebhook: Webhook{ID: webhookID.String(), URL: "https://example.com/hook", Sports: []string{}, Teams: []string{}, EventTypes: []string{}},
+		},
+		"should reject url that is not http": {
+			registration: WebhookRegistration{URL: "ftp://example.com/hook"},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidWebhook,
+		},
+		"should reject relative url": {
+			registration: WebhookRegistration{URL: "/hook"},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidWebhook,
+		},
+		"should reject unknown sport": {
+			registration: WebhookRegistration{URL: "https://example.com/hook", Sports: []string{"nhl"}},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidWebhook,
+		},
+		"should store mlb webhook": {
+			registration: WebhookRegistration{URL: "https://example.com/hook", Sports: []string{"MLB"}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertWebhook(gomock.Any()).Return(webhookID, nil)
+				return mockRepo
+			},
+			expectedWebhook: Webhook{ID: webhookID.String(), URL: "https://example.com/hook", Sports: []string{"mlb"}, Teams: []string{}, EventTypes: []string{}},
+		},
+		"should reject empty team": {
+			registration: WebhookRegistration{URL: "https://example.com/hook", Teams: []string{" "}},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidWebhook,
+		},
+		"should reject unknown event type": {
+			registration: WebhookRegistration{URL: "https://example.com/hook", EventTypes: []string{"touchdown"}},
+			mockRepo:     repository.NewMockRepository,
+			expectedErr:  ErrInvalidWebhook,
+		},
+		"should return error when insert fails": {
+			registration: WebhookRegistration{URL: "https://example.com/hook"},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().InsertWebhook(gomock.Any()).Return(uuid.Nil, repository.ErrInsertWebhook)
+				return mockRepo
+			},
+			expectedErr: repository.ErrInsertWebhook,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			w, err := c.RegisterWebhook(tc.registration)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			if tc.expectedErr != nil {
+				return
+			}
+			assert.Len(t, w.Secret, 64)
+			assert.False(t, w.CreatedAt.IsZero())
+			w.Secret, w.CreatedAt = "", time.Time{}
+			assert.Equal(t, tc.expectedWebhook, w)
+		})
+	}
+}
+
+func TestController_GetWebhooks(t *testing.T) {
+	created := time.Date(2023, 9, 12, 3, 41, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		mockRepo         func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedWebhooks []Webhook
+		expectedErr      error
+	}{
+		"should list webhooks without secrets": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetWebhooks().Return([]repository.Webhook{
+					{ID: webhookID, URL: "https://example.com/hook", Secret: "s3cret", Sports: pq.StringArray{"nfl"}, Teams: pq.StringArray{}, EventTypes: pq.StringArray{"final"}, CreatedAt: created},
+				}, nil)
+				return mockRepo
+			},
+			expectedWebhooks: []Webhook{
+				{ID: webhookID.String(), URL: "https://example.com/hook", Sports: []string{"nfl"}, Teams: []string{}, EventTypes: []string{"final"}, CreatedAt: created},
+			},
+		},
+		"should return error when webhooks fail": {
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().GetWebhooks().Return(nil, repository.ErrSqlError)
+				return mockRepo
+			},
+			expectedErr: repository.ErrSqlError,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			webhooks, err := c.GetWebhooks()
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedWebhooks, webhooks)
+		})
+	}
+}
+
+func TestController_DeleteWebhook(t *testing.T) {
+	testCases := map[string]struct {
+		id          string
+		mockRepo    func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedErr error
+	}{
+		"should delete webhook": {
+			id: webhookID.String(),
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().DeleteWebhook(webhookID).Return(nil)
+				return mockRepo
+			},
+		},
+		"should return ErrNoWebhook for unknown webhook": {
+			id: webhookID.String(),
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().DeleteWebhook(webhookID).Return(repository.ErrNoWebhook)
+				return mockRepo
+			},
+			expectedErr: ErrNoWebhook,
+		},
+		"should return ErrNoWebhook for id that is not a uuid": {
+			id:          "1",
+			mockRepo:    repository.NewMockRepository,
+			expectedErr: ErrNoWebhook,
+		},
+		"should return error when delete fails": {
+			id: webhookID.String(),
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().DeleteWebhook(webhookID).Return(repository.ErrDeleteWebhook)
+				return mockRepo
+			},
+			expectedErr: repository.ErrDeleteWebhook,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			c := &Controller{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			assert.ErrorIs(t, c.DeleteWebhook(tc.id), tc.expectedErr)
+		})
+	}
+}
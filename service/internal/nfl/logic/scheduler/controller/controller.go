@@ -3,18 +3,31 @@ package controller
 import (
 	"errors"
 	"fmt"
+	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/rmarken5/mini-score/service/internal/httpclient"
 	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
 	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/http/rest"
 	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/http/scraper"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/webhookstore"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
 	"github.com/rs/zerolog"
-	"net/http"
+	"hash/fnv"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 )
 
+const (
+	// gameDateLayout is the layout of game dates in the ESPN schedule.
+	gameDateLayout = "2006-01-02T15:04Z"
+	// syncInterval is how often fetching game info is recorded as a sync, the server marks scores stale when it is
+	// not.
+	syncInterval = 15 * time.Second
+)
+
 type (
 	Controller interface {
 		KeepScheduleSynchronized(loopExiter <-chan bool, iterationInterval time.Duration)
@@ -33,6 +46,20 @@ type (
 		scoreCacheLock       sync.RWMutex
 		clockCache           map[string]string
 		clockCacheLock       sync.RWMutex
+		teamColorCache       map[string]string
+		teamColorCacheLock   sync.RWMutex
+		situationCache       map[string]repository.GameSituation
+		recordCache          map[string]string
+		scoringPlayCache     map[string]uint64
+		detailCacheLock      sync.RWMutex
+		scoringPlayQueues    *gameRuns
+		dispatcher           Dispatcher
+		webhookCache         map[string]gameState
+		webhookCacheLock     sync.Mutex
+		webhookQueues        *gameQueues
+		// syncedAt is when game info was last recorded as synced.
+		syncedAt time.Time
+		syncLock sync.Mutex
 	}
 )
 
@@ -40,17 +67,26 @@ func NewLogic(logger zerolog.Logger, db *sqlx.DB) *Logic {
 
 	logger = logger.With().Str("service", "Logic").Logger()
 
-	httpClient := &http.Client{}
+	httpClient := httpclient.New(httpclient.ESPNConfig())
 	s := scraper.New(httpClient)
 	restRequester := rest.NewRequester(logger, httpClient)
+	repo := repository.NewRepository(logger, db)
 
 	return &Logic{
 		logger:               logger,
 		scrapper:             s,
-		repo:                 repository.NewRepository(logger, db),
+		repo:                 repo,
 		requester:            restRequester,
 		gameTeamQuarterCache: make(map[string]int),
 		clockCache:           make(map[string]string),
+		teamColorCache:       make(map[string]string),
+		situationCache:       make(map[string]repository.GameSituation),
+		recordCache:          make(map[string]string),
+		scoringPlayCache:     make(map[string]uint64),
+		scoringPlayQueues:    newGameRuns(),
+		dispatcher:           webhook.NewDispatcher(logger, httpclient.New(httpclient.WebhookConfig()), webhookstore.New(repo)),
+		webhookCache:         make(map[string]gameState),
+		webhookQueues:        newGameQueues(),
 	}
 }
 func (l *Logic) KeepScheduleSynchronized(loopExiter <-chan bool, iterationInterval time.Duration) {
@@ -85,25 +121,42 @@ func (l *Logic) syncSchedule() error {
 		return err
 	}
 	for _, games := range gameMap {
-		l.processGameMap(games)
+		l.processGameMap(games, weeks)
 	}
 	return nil
 }
-func (l *Logic) processGameMap(games []scraper.Game) {
+func (l *Logic) processGameMap(games []scraper.Game, weeks []scraper.Week) {
 	logger := l.logger.With().Str("method", "processGameMap").Logger()
 	for _, game := range games {
-		if err := l.processGame(game); err != nil {
+		if err := l.processGame(game, weekOf(weeks, game.Date)); err != nil {
 			logger.Info().Err(err).Fields(game).Msg("processing game")
 		}
 	}
 }
 
-func (l *Logic) processGame(game scraper.Game) error {
+// weekOf returns the week of the schedule a game played at date falls in, nil when no week has the date.
+func weekOf(weeks []scraper.Week, date string) *scraper.Week {
+	gameTime, err := time.Parse(gameDateLayout, date)
+	if err != nil {
+		return nil
+	}
+	for i := range weeks {
+		if !gameTime.Before(weeks[i].StartDate.Time) && !gameTime.After(weeks[i].EndDate.Time) {
+			return &weeks[i]
+		}
+	}
+	return nil
+}
+
+// processGame stores a game of week, which is nil when the week of the game is unknown.
+func (l *Logic) processGame(game scraper.Game, week *scraper.Week) error {
+	l.updateTeamColors(game.Competitors)
+
 	repoGame, err := l.repo.GetGame(game.ID)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrNoGame):
-			err := l.insertGame(game)
+			err := l.insertGame(game, week)
 			if err != nil {
 				return err
 			}
@@ -125,8 +178,53 @@ func (l *Logic) processGame(game scraper.Game) error {
 		}
 		return err
 	}
+	if err := l.updateGameSeason(repoGame, week); err != nil {
+		return err
+	}
 	return l.updateGameTime(game, repoGame)
 }
+
+// updateGameSeason stores the season of a game when it is not stored yet, so standings can tell regular season
+// games from preseason and postseason games.
+func (l *Logic) updateGameSeason(repoGame repository.Game, week *scraper.Week) error {
+	if week == nil || (repoGame.Season == week.Year && repoGame.SeasonType == int(week.SeasonType)) {
+		return nil
+	}
+	return l.repo.UpdateGameSeason(repoGame.ID, week.Year, int(week.SeasonType))
+}
+
+// updateTeamColors stores the colors ESPN sends for competitors so boards can draw teams in their colors.
+// Colors rarely change so they are only written when they differ from the last colors written.
+func (l *Logic) updateTeamColors(competitors []scraper.Competitor) {
+	logger := l.logger.With().Str("method", "updateTeamColors").Logger()
+	for _, comp := range competitors {
+		if comp.TeamColor == "" {
+			continue
+		}
+		colors := comp.TeamColor + ":" + comp.AltColor
+		if l.teamColorCacheByAbv(comp.Abbrev) == colors {
+			continue
+		}
+		if err := l.repo.UpdateTeamColors(comp.Abbrev, comp.TeamColor, comp.AltColor); err != nil {
+			logger.Error().Err(err).Msgf("while updating colors for %s", comp.Abbrev)
+			continue
+		}
+		l.setTeamColorCache(comp.Abbrev, colors)
+	}
+}
+
+func (l *Logic) setTeamColorCache(abv, colors string) {
+	l.teamColorCacheLock.Lock()
+	defer l.teamColorCacheLock.Unlock()
+	l.teamColorCache[abv] = colors
+}
+
+func (l *Logic) teamColorCacheByAbv(abv string) string {
+	l.teamColorCacheLock.RLock()
+	defer l.teamColorCacheLock.RUnlock()
+	return l.teamColorCache[abv]
+}
+
 func (l *Logic) updateGameTime(game scraper.Game, repoGame repository.Game) error {
 	layoutStr := "2006-01-02T15:04Z"
 	gameTime, err := time.Parse(layoutStr, game.Date)
@@ -142,7 +240,7 @@ func (l *Logic) updateGameTime(game scraper.Game, repoGame repository.Game) erro
 	return nil
 }
 
-func (l *Logic) insertGame(game scraper.Game) error {
+func (l *Logic) insertGame(game scraper.Game, week *scraper.Week) error {
 	logger := l.logger.With().Str("method", "insertGame").Logger()
 
 	repoGame, err := l.fromScraperGameToRepo(game)
@@ -150,6 +248,9 @@ func (l *Logic) insertGame(game scraper.Game) error {
 		logger.Info().Err(err).Msgf("unable to convert from scraper game to repo game")
 		return err
 	}
+	if week != nil {
+		repoGame.Season, repoGame.SeasonType = week.Year, int(week.SeasonType)
+	}
 	err = l.repo.InsertGame(repoGame)
 	if err != nil {
 		logger.Info().Err(err).Msgf("unable to insert game")
@@ -210,7 +311,27 @@ func (l *Logic) GetGameInfo(gameID string) (scraper.GameInfo, error) {
 	logger := l.logger.With().Str("method", "GetGameInfo").Logger()
 
 	logger.Info().Msgf("Getting game info for: %s", gameID)
-	return l.scrapper.FetchGameInfo(gameID)
+	info, err := l.scrapper.FetchGameInfo(gameID)
+	if err == nil {
+		l.recordSync(time.Now())
+	}
+	return info, err
+}
+
+// recordSync records the game info as synced at now, at most once every syncInterval.
+func (l *Logic) recordSync(now time.Time) {
+	logger := l.logger.With().Str("method", "recordSync").Logger()
+
+	l.syncLock.Lock()
+	defer l.syncLock.Unlock()
+	if now.Sub(l.syncedAt) < syncInterval {
+		return
+	}
+	if err := l.repo.UpdateSyncTime(repository.SyncGameInfo); err != nil {
+		logger.Error().Err(err).Msg("while recording sync")
+		return
+	}
+	l.syncedAt = now
 }
 
 func (l *Logic) UpdateGame(info scraper.GameInfo) {
@@ -225,6 +346,154 @@ func (l *Logic) UpdateGame(info scraper.GameInfo) {
 			logger.Error().Err(err).Msgf("while trying to update game clock")
 		}
 	}()
+	go func() {
+		if err := l.updateGameRecords(info); err != nil {
+			logger.Error().Err(err).Msgf("while trying to update team records")
+		}
+	}()
+	l.scoringPlayQueues.run(info.GameID, func() {
+		if err := l.updateScoringPlays(info.GameID); err != nil {
+			logger.Error().Err(err).Msgf("while trying to update scoring plays")
+		}
+	})
+	l.notifyChanges(info)
+}
+
+// updateScoringPlays stores the scoring plays of a game. Plays ESPN revises are replaced and plays ESPN no longer
+// lists, like overturned touchdowns, are removed. Nothing is written while the plays are unchanged. Plays of teams
+// that are not in the database are logged and left out. It runs one update of a game at a time, and while ESPN is
+// slow only the update of the latest poll waits, so polls do not pile up requests.
+func (l *Logic) updateScoringPlays(gameID string) error {
+	logger := l.logger.With().Str("method", "updateScoringPlays").Logger()
+
+	espnPlays, err := l.requester.GetScoringPlays(gameID)
+	if err != nil {
+		return err
+	}
+
+	plays := make([]repository.ScoringPlay, 0, len(espnPlays))
+	hash := fnv.New64a()
+	for i, espnPlay := range espnPlays {
+		play := scoringPlayFromESPN(gameID, i, espnPlay)
+		plays = append(plays, play)
+		_, _ = fmt.Fprintf(hash, "%+v\n", play)
+	}
+	fingerprint := hash.Sum64()
+
+	l.detailCacheLock.RLock()
+	cached, isCached := l.scoringPlayCache[gameID]
+	l.detailCacheLock.RUnlock()
+	if isCached && cached == fingerprint {
+		return nil
+	}
+
+	teamIDs := make(map[string]uuid.UUID)
+	ids := make([]string, 0, len(plays))
+	for _, play := range plays {
+		teamID, ok := teamIDs[play.TeamAbbreviation]
+		if !ok {
+			team, err := l.repo.GetTeamByAbv(play.TeamAbbreviation)
+			if errors.Is(err, repository.ErrNoTeam) {
+				logger.Warn().Msgf("skipping scoring play %s of game %s of unknown team %q", play.ID, gameID, play.TeamAbbreviation)
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			teamID = team.ID
+			teamIDs[play.TeamAbbreviation] = teamID
+		}
+		play.TeamID = teamID
+		if err := l.repo.UpsertScoringPlay(play); err != nil {
+			return err
+		}
+		ids = append(ids, play.ID)
+	}
+	if err := l.repo.DeleteScoringPlaysExcept(gameID, ids); err != nil {
+		return err
+	}
+
+	l.detailCacheLock.Lock()
+	defer l.detailCacheLock.Unlock()
+	l.scoringPlayCache[gameID] = fingerprint
+	return nil
+}
+
+func scoringPlayFromESPN(gameID string, sequence int, play rest.ScoringPlay) repository.ScoringPlay {
+	return repository.ScoringPlay{
+		ID:               play.ID,
+		GameID:           gameID,
+		TeamAbbreviation: play.Team.Abbreviation,
+		Sequence:         sequence,
+		Quarter:          strconv.Itoa(play.Period.Number),
+		Clock:            play.Clock.DisplayValue,
+		Type:             scoringType(play),
+		Description:      play.Text,
+		AwayScore:        play.AwayScore,
+		HomeScore:        play.HomeScore,
+	}
+}
+
+// scoringType returns TD, FG, SF or 2PT for a scoring play, falling back to ESPN's abbreviation.
+func scoringType(play rest.ScoringPlay) string {
+	text := strings.ToLower(play.Type.Text + " " + play.ScoringType.Name)
+	switch {
+	case strings.Contains(text, "two-point") || strings.Contains(text, "two point"):
+		return "2PT"
+	case strings.Contains(text, "touchdown"):
+		return "TD"
+	case strings.Contains(text, "field goal") || strings.Contains(text, "field-goal"):
+		return "FG"
+	case strings.Contains(text, "safety"):
+		return "SF"
+	}
+	return strings.ToUpper(play.ScoringType.Abbreviation)
+}
+
+// updateGameRecords stores the records of the teams in a game. Records are only written when they differ from
+// the last records written for the game.
+func (l *Logic) updateGameRecords(info scraper.GameInfo) error {
+	var awayRecord, homeRecord string
+	for _, tm := range info.Tms {
+		if tm.IsHome {
+			homeRecord = totalRecord(tm.Records)
+		} else {
+			awayRecord = totalRecord(tm.Records)
+		}
+	}
+	if awayRecord == "" && homeRecord == "" {
+		return nil
+	}
+
+	records := awayRecord + ":" + homeRecord
+	l.detailCacheLock.RLock()
+	cached := l.recordCache[info.GameID]
+	l.detailCacheLock.RUnlock()
+	if cached == records {
+		return nil
+	}
+
+	if err := l.repo.UpdateGameRecords(info.GameID, awayRecord, homeRecord); err != nil {
+		return err
+	}
+
+	l.detailCacheLock.Lock()
+	defer l.detailCacheLock.Unlock()
+	l.recordCache[info.GameID] = records
+	return nil
+}
+
+// totalRecord returns the overall record of a team, falling back to the first record ESPN sends.
+func totalRecord(records []scraper.Records) string {
+	for _, r := range records {
+		if r.Type == "total" {
+			return r.Summary
+		}
+	}
+	if len(records) > 0 {
+		return records[0].Summary
+	}
+	return ""
 }
 
 func (l *Logic) updateGameQuarterScore(gameInfo scraper.GameInfo) error {
@@ -337,12 +606,17 @@ func (l *Logic) updateTeamQuarterCache(key string, score int) {
 func (l *Logic) updateGameClock(gameID string) error {
 	logger := l.logger.With().Str("method", "updateGameClock").Logger()
 
-	clock, quarter, err := l.getClockAndPeriodForGameID(gameID)
+	ev, err := l.getEventForGameID(gameID)
 	if err != nil {
 		logger.Error().Err(err).Msgf("while getting game clock for gameID: %s", gameID)
 		return err
 	}
 
+	if err := l.updateGameSituation(gameID, ev); err != nil {
+		logger.Error().Err(err).Msgf("while updating game situation for gameID: %s", gameID)
+	}
+
+	clock, quarter := ev.Status.DisplayClock, strconv.Itoa(ev.Status.Period)
 	cachedClock := l.clockCacheByGameID(gameID)
 	if cachedClock == clock {
 		logger.Info().Msgf("Cached clock :%s matches fetched clock :%s, skipping update", cachedClock, clock)
@@ -359,6 +633,52 @@ func (l *Logic) updateGameClock(gameID string) error {
 	return nil
 }
 
+// updateGameSituation stores possession, down and distance and timeouts of a game in progress. Situations are
+// only written when they differ from the last situation written for the game.
+func (l *Logic) updateGameSituation(gameID string, ev rest.Event) error {
+	situation, ok := situationFromEvent(ev)
+	if !ok {
+		return nil
+	}
+
+	l.detailCacheLock.RLock()
+	cached, isCached := l.situationCache[gameID]
+	l.detailCacheLock.RUnlock()
+	if isCached && cached == situation {
+		return nil
+	}
+
+	if err := l.repo.UpdateGameSituation(gameID, situation); err != nil {
+		return err
+	}
+
+	l.detailCacheLock.Lock()
+	defer l.detailCacheLock.Unlock()
+	l.situationCache[gameID] = situation
+	return nil
+}
+
+// situationFromEvent returns the situation of an event with possession as a team abbreviation. ok is false when
+// ESPN sends no situation, as it does before and after games.
+func situationFromEvent(ev rest.Event) (situation repository.GameSituation, ok bool) {
+	if len(ev.Competitions) == 0 || ev.Competitions[0].Situation == nil {
+		return repository.GameSituation{}, false
+	}
+	comp := ev.Competitions[0]
+
+	situation = repository.GameSituation{
+		DownDistance: comp.Situation.DownDistanceText,
+		AwayTimeouts: comp.Situation.AwayTimeouts,
+		HomeTimeouts: comp.Situation.HomeTimeouts,
+	}
+	for _, competitor := range comp.Competitors {
+		if competitor.ID == comp.Situation.Possession {
+			situation.Possession = competitor.Team.Abbreviation
+		}
+	}
+	return situation, true
+}
+
 func (l *Logic) setClockCache(gameID, clock string) {
 	l.clockCacheLock.Lock()
 	defer l.clockCacheLock.Unlock()
@@ -371,34 +691,28 @@ func (l *Logic) clockCacheByGameID(gameID string) string {
 	return l.clockCache[gameID]
 }
 
-func (l *Logic) getClockAndPeriodForGameID(gameID string) (string, string, error) {
-	logger := l.logger.With().Str("method", "GetScheduleForGameID").Logger()
+func (l *Logic) getEventForGameID(gameID string) (rest.Event, error) {
+	logger := l.logger.With().Str("method", "getEventForGameID").Logger()
 	resp, err := l.requester.GetScoreboard()
 	if err != nil {
 		logger.Error().Err(err).Msgf("While getting scoreboard for gameID: %s", gameID)
-		return "", "", err
+		return rest.Event{}, err
 	}
-	gameTime, period, err := l.getTimeAndPeriodFromScoreboard(gameID, resp)
+	ev, err := eventFromScoreboard(gameID, resp)
 	if err != nil {
-		logger.Error().Err(err).Msgf("unable to get time for gameID: %s", gameID)
-		return "", "", err
+		logger.Error().Err(err).Msgf("unable to get event for gameID: %s", gameID)
+		return rest.Event{}, err
 	}
-	return gameTime, period, nil
+	return ev, nil
 }
 
-func (l *Logic) getTimeAndPeriodFromScoreboard(gameID string, response rest.ScoreboardResponse) (string, string, error) {
-	var ev rest.Event
+func eventFromScoreboard(gameID string, response rest.ScoreboardResponse) (rest.Event, error) {
 	for _, event := range response.Content.SBData.Events {
 		if isGameIDMatch(gameID, event.UID) {
-			ev = event
-			break
+			return event, nil
 		}
 	}
-	if ev.UID == "" {
-		return "", "", fmt.Errorf("no match for game in scoreboard")
-	}
-	return ev.Status.DisplayClock, strconv.Itoa(ev.Status.Period), nil
-
+	return rest.Event{}, fmt.Errorf("no match for game in scoreboard")
 }
 
 func isGameIDMatch(gameID, gameUID string) bool {
@@ -409,18 +723,83 @@ func isGameIDMatch(gameID, gameUID string) bool {
 func (l *Logic) FinalizeGame(gameInfo scraper.GameInfo) {
 	logger := l.logger.With().Str("method", "FinalizeGame").Logger()
 	defer l.clearGameClockCache(gameInfo.GameID)
+	defer l.clearGameDetailCache(gameInfo.GameID)
 	defer l.clearGameCache(gameInfo.GameID)
 	go func(gameInfo scraper.GameInfo) {
 		if err := l.updateGameQuarterScore(gameInfo); err != nil {
 			logger.Error().Err(err).Msgf("while trying to update game")
 		}
 	}(gameInfo)
+	l.scoringPlayQueues.run(gameInfo.GameID, func() {
+		if err := l.updateScoringPlays(gameInfo.GameID); err != nil {
+			logger.Error().Err(err).Msgf("while trying to update scoring plays")
+		}
+		l.clearScoringPlayCache(gameInfo.GameID)
+	})
 	go func(gameID, quarter string, gameClock string) {
 		if err := l.repo.UpdateQuarterGameClock(gameID, quarter, gameClock); err != nil {
 			logger.Error().Err(err).Msgf("while trying to update game clock")
 
 		}
 	}(gameInfo.GameID, "F", "Final")
+	go func(gameInfo scraper.GameInfo) {
+		if err := l.updateFinalEntry(gameInfo); err != nil {
+			logger.Error().Err(err).Msgf("while trying to update final entry")
+		}
+	}(gameInfo)
+	l.notifyFinal(gameInfo)
+}
+
+// updateFinalEntry writes the entry of the final score feed for a game with the line score as it is on the board.
+func (l *Logic) updateFinalEntry(gameInfo scraper.GameInfo) error {
+	entry, err := finalEntry(gameInfo)
+	if err != nil {
+		return err
+	}
+	return l.repo.UpsertFinalEntry(entry)
+}
+
+func finalEntry(gameInfo scraper.GameInfo) (repository.FinalEntry, error) {
+	if len(gameInfo.Tms) < 2 {
+		return repository.FinalEntry{}, fmt.Errorf("not enough teams in info to process: %+v", gameInfo.Tms)
+	}
+
+	var away, home renderer.Team
+	for _, tm := range gameInfo.Tms {
+		team := renderer.Team{Name: tm.Abbrev, Totals: []string{tm.Score}}
+		for _, score := range tm.Linescores {
+			team.Periods = append(team.Periods, score.DisplayValue)
+		}
+		if tm.IsHome {
+			home = team
+		} else {
+			away = team
+		}
+	}
+
+	periods := len(away.Periods)
+	if len(home.Periods) > periods {
+		periods = len(home.Periods)
+	}
+	status := "Final"
+	if periods > 4 {
+		status = "Final/OT"
+	}
+
+	game := renderer.Game{
+		ID:          gameInfo.GameID,
+		PeriodLabel: "Q",
+		Periods:     periods,
+		TotalLabels: []string{"T"},
+		Away:        away,
+		Home:        home,
+		Status:      renderer.Status{State: renderer.Final, Detail: "QF", Clock: "Final"},
+	}
+	return repository.FinalEntry{
+		GameID:    gameInfo.GameID,
+		Title:     fmt.Sprintf("%s: %s %s @ %s %s", status, away.Name, away.Total(0), home.Name, home.Total(0)),
+		LineScore: renderer.Text{}.LineScore(game),
+	}, nil
 }
 
 func (l *Logic) clearGameClockCache(gameID string) {
@@ -429,6 +808,21 @@ func (l *Logic) clearGameClockCache(gameID string) {
 	delete(l.clockCache, gameID)
 }
 
+func (l *Logic) clearGameDetailCache(gameID string) {
+	l.detailCacheLock.Lock()
+	defer l.detailCacheLock.Unlock()
+	delete(l.situationCache, gameID)
+	delete(l.recordCache, gameID)
+}
+
+// clearScoringPlayCache runs with the game's scoring plays after their last update, so no update caches the game
+// again.
+func (l *Logic) clearScoringPlayCache(gameID string) {
+	l.detailCacheLock.Lock()
+	defer l.detailCacheLock.Unlock()
+	delete(l.scoringPlayCache, gameID)
+}
+
 func (l *Logic) clearGameCache(gameID string) {
 	l.scoreCacheLock.Lock()
 	defer l.scoreCacheLock.Unlock()
@@ -945,23 +945,47 @@ func TestLogic_GetGameInfo(t *testing.T) {
 	var myErr = errors.New("error")
 	testCases := map[string]struct {
 		mockScraper   func(ctrl *gomock.Controller) *scraper.MockScheduleScraper
+		mockRepo      func(ctrl *gomock.Controller) *repository.MockRepository
 		expectedError error
 	}{
-		"should get gameInfo from scraper": {
+		"should get gameInfo from scraper and record the sync once per interval": {
 			mockScraper: func(ctrl *gomock.Controller) *scraper.MockScheduleScraper {
 				mockScheduleScraper := scraper.NewMockScheduleScraper(ctrl)
-				mockScheduleScraper.EXPECT().FetchGameInfo(gomock.Any()).Return(scraper.GameInfo{}, nil)
+				mockScheduleScraper.EXPECT().FetchGameInfo(gomock.Any()).Return(scraper.GameInfo{}, nil).Times(2)
 				return mockScheduleScraper
 			},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				mockRepo.EXPECT().UpdateSyncTime(repository.SyncGameInfo).Return(nil)
+				return mockRepo
+			},
 		},
 		"should log and return error from scraper": {
 			mockScraper: func(ctrl *gomock.Controller) *scraper.MockScheduleScraper {
 				mockScheduleScraper := scraper.NewMockScheduleScraper(ctrl)
-				mockScheduleScraper.EXPECT().FetchGameInfo(gomock.Any()).Return(scraper.GameInfo{}, myErr)
+				mockScheduleScraper.EXPECT().FetchGameInfo(gomock.Any()).Return(scraper.GameInfo{}, myErr).Times(2)
 				return mockScheduleScraper
 			},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				return repository.NewMockRepository(ctrl)
+			},
 			expectedError: myErr,
 		},
+		"should record the sync again when it could not be recorded": {
+			mockScraper: func(ctrl *gomock.Controller) *scraper.MockScheduleScraper {
+				mockScheduleScraper := scraper.NewMockScheduleScraper(ctrl)
+				mockScheduleScraper.EXPECT().FetchGameInfo(gomock.Any()).Return(scraper.GameInfo{}, nil).Times(2)
+				return mockScheduleScraper
+			},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepo := repository.NewMockRepository(ctrl)
+				gomock.InOrder(
+					mockRepo.EXPECT().UpdateSyncTime(repository.SyncGameInfo).Return(repository.ErrUpdateSyncStatus),
+					mockRepo.EXPECT().UpdateSyncTime(repository.SyncGameInfo).Return(nil),
+				)
+				return mockRepo
+			},
+		},
 	}
 	for name, tc := range testCases {
 		name := name
@@ -969,24 +993,428 @@ func TestLogic_GetGameInfo(t *testing.T) {
 		t.Run(name, func(t *testing.T) {
 			ctrl := gomock.NewController(t)
 
-			var mockScraper *scraper.MockScheduleScraper
-			if tc.mockScraper != nil {
-				mockScraper = tc.mockScraper(ctrl)
+			l := Logic{
+				logger:   zerolog.New(os.Stdout),
+				scrapper: tc.mockScraper(ctrl),
+				repo:     tc.mockRepo(ctrl),
 			}
 
-			l := Logic{
-				logger:               zerolog.New(os.Stdout),
-				scrapper:             mockScraper,
-				repo:                 nil,
-				requester:            nil,
-				gameTeamQuarterCache: nil,
-				scoreCacheLock:       sync.RWMutex{},
-				clockCache:           nil,
-				clockCacheLock:       sync.RWMutex{},
+			for i := 0; i < 2; i++ {
+				_, err := l.GetGameInfo(uuid.NewString())
+				assert.ErrorIs(t, err, tc.expectedError)
 			}
+		})
+	}
+}
 
-			_, err := l.GetGameInfo(uuid.NewString())
-			assert.ErrorIs(t, err, tc.expectedError)
+func TestLogic_updateTeamColors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepository := repository.NewMockRepository(ctrl)
+	mockRepository.EXPECT().UpdateTeamColors("KC", "e31837", "ffb612").Return(nil).Times(1)
+	mockRepository.EXPECT().UpdateTeamColors("BUF", "00338d", "d50a0a").Return(repository.ErrSqlError).Times(2)
+
+	l := &Logic{
+		logger:         zerolog.Nop(),
+		repo:           mockRepository,
+		teamColorCache: make(map[string]string),
+	}
+	competitors := []scraper.Competitor{
+		{Abbrev: "KC", TeamColor: "e31837", AltColor: "ffb612"},
+		{Abbrev: "BUF", TeamColor: "00338d", AltColor: "d50a0a"},
+		{Abbrev: "DET"},
+	}
+
+	l.updateTeamColors(competitors)
+	// colors written successfully are not written again, failed writes are retried
+	l.updateTeamColors(competitors)
+}
+
+func TestLogic_updateGameSituation(t *testing.T) {
+	competitors := []rest.Competitor{{ID: "12", HomeAway: "away"}, {ID: "2", HomeAway: "home"}}
+	competitors[0].Team.Abbreviation = "KC"
+	competitors[1].Team.Abbreviation = "BUF"
+	situation := &rest.Situation{DownDistanceText: "3rd & 4 at BUF 40", Possession: "12", AwayTimeouts: 2, HomeTimeouts: 3}
+
+	testCases := map[string]struct {
+		event    rest.Event
+		mockRepo func(ctrl *gomock.Controller) *repository.MockRepository
+	}{
+		"should store situation once while it is unchanged": {
+			event: rest.Event{Competitions: []rest.Competition{{Competitors: competitors, Situation: situation}}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpdateGameSituation("123", repository.GameSituation{
+					Possession:   "KC",
+					DownDistance: "3rd & 4 at BUF 40",
+					AwayTimeouts: 2,
+					HomeTimeouts: 3,
+				}).Return(nil).Times(1)
+				return mockRepository
+			},
+		},
+		"should retry situation that failed to store": {
+			event: rest.Event{Competitions: []rest.Competition{{Competitors: competitors, Situation: situation}}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpdateGameSituation("123", gomock.Any()).Return(repository.ErrUpdateGame).Times(2)
+				return mockRepository
+			},
+		},
+		"should skip games without a situation": {
+			event: rest.Event{Competitions: []rest.Competition{{Competitors: competitors}}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				return repository.NewMockRepository(ctrl)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			l := &Logic{
+				logger:         zerolog.Nop(),
+				repo:           tc.mockRepo(ctrl),
+				situationCache: make(map[string]repository.GameSituation),
+			}
+
+			_ = l.updateGameSituation("123", tc.event)
+			_ = l.updateGameSituation("123", tc.event)
+		})
+	}
+}
+
+func TestLogic_updateGameRecords(t *testing.T) {
+	testCases := map[string]struct {
+		tms      []scraper.Tms
+		mockRepo func(ctrl *gomock.Controller) *repository.MockRepository
+	}{
+		"should store total records once while they are unchanged": {
+			tms: []scraper.Tms{
+				{Abbrev: "KC", Records: []scraper.Records{{Type: "road", Summary: "5-1"}, {Type: "total", Summary: "10-3"}}},
+				{Abbrev: "BUF", IsHome: true, Records: []scraper.Records{{Type: "total", Summary: "9-4"}}},
+			},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpdateGameRecords("123", "10-3", "9-4").Return(nil).Times(1)
+				return mockRepository
+			},
+		},
+		"should skip games without records": {
+			tms: []scraper.Tms{{Abbrev: "KC"}, {Abbrev: "BUF", IsHome: true}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				return repository.NewMockRepository(ctrl)
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			l := &Logic{
+				logger:      zerolog.Nop(),
+				repo:        tc.mockRepo(ctrl),
+				recordCache: make(map[string]string),
+			}
+			info := scraper.GameInfo{GameID: "123", Tms: tc.tms}
+
+			assert.NoError(t, l.updateGameRecords(info))
+			assert.NoError(t, l.updateGameRecords(info))
+		})
+	}
+}
+
+func TestLogic_updateScoringPlays(t *testing.T) {
+	touchdown := rest.ScoringPlay{ID: "2", Text: "Travis Kelce 12 Yd pass from Patrick Mahomes", AwayScore: 7, HomeScore: 3}
+	touchdown.Type.Text = "Passing Touchdown"
+	touchdown.Period.Number = 2
+	touchdown.Clock.DisplayValue = "7:32"
+	touchdown.Team.Abbreviation = "KC"
+	fieldGoal := rest.ScoringPlay{ID: "1", Text: "Tyler Bass 45 Yd Field Goal", HomeScore: 3}
+	fieldGoal.Type.Text = "Field Goal Good"
+	fieldGoal.Period.Number = 1
+	fieldGoal.Clock.DisplayValue = "3:10"
+	fieldGoal.Team.Abbreviation = "BUF"
+	revised := touchdown
+	revised.Text = "Travis Kelce 14 Yd pass from Patrick Mahomes"
+
+	buf, kc := uuid.New(), uuid.New()
+	mockTeams := func(mockRepository *repository.MockRepository) {
+		mockRepository.EXPECT().GetTeamByAbv("BUF").Return(&repository.Team{ID: buf, Abbreviation: "BUF"}, nil).AnyTimes()
+		mockRepository.EXPECT().GetTeamByAbv("KC").Return(&repository.Team{ID: kc, Abbreviation: "KC"}, nil).AnyTimes()
+	}
+
+	testCases := map[string]struct {
+		polls    [][]rest.ScoringPlay
+		mockRepo func(ctrl *gomock.Controller) *repository.MockRepository
+	}{
+		"should store plays once while they are unchanged": {
+			polls: [][]rest.ScoringPlay{{fieldGoal, touchdown}, {fieldGoal, touchdown}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockTeams(mockRepository)
+				mockRepository.EXPECT().UpsertScoringPlay(repository.ScoringPlay{
+					ID: "1", GameID: "123", TeamID: buf, TeamAbbreviation: "BUF", Sequence: 0, Quarter: "1", Clock: "3:10",
+					Type: "FG", Description: "Tyler Bass 45 Yd Field Goal", HomeScore: 3,
+				}).Return(nil).Times(1)
+				mockRepository.EXPECT().UpsertScoringPlay(repository.ScoringPlay{
+					ID: "2", GameID: "123", TeamID: kc, TeamAbbreviation: "KC", Sequence: 1, Quarter: "2", Clock: "7:32",
+					Type: "TD", Description: "Travis Kelce 12 Yd pass from Patrick Mahomes", AwayScore: 7, HomeScore: 3,
+				}).Return(nil).Times(1)
+				mockRepository.EXPECT().DeleteScoringPlaysExcept("123", []string{"1", "2"}).Return(nil).Times(1)
+				return mockRepository
+			},
+		},
+		"should replace revised plays and remove dropped plays": {
+			polls: [][]rest.ScoringPlay{{fieldGoal, touchdown}, {revised}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockTeams(mockRepository)
+				mockRepository.EXPECT().UpsertScoringPlay(gomock.Any()).Return(nil).Times(2)
+				mockRepository.EXPECT().DeleteScoringPlaysExcept("123", []string{"1", "2"}).Return(nil)
+				mockRepository.EXPECT().UpsertScoringPlay(repository.ScoringPlay{
+					ID: "2", GameID: "123", TeamID: kc, TeamAbbreviation: "KC", Sequence: 0, Quarter: "2", Clock: "7:32",
+					Type: "TD", Description: "Travis Kelce 14 Yd pass from Patrick Mahomes", AwayScore: 7, HomeScore: 3,
+				}).Return(nil)
+				mockRepository.EXPECT().DeleteScoringPlaysExcept("123", []string{"2"}).Return(nil)
+				return mockRepository
+			},
+		},
+		"should skip plays of unknown teams": {
+			polls: [][]rest.ScoringPlay{{fieldGoal, touchdown}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().GetTeamByAbv("BUF").Return(nil, repository.ErrNoTeam)
+				mockRepository.EXPECT().GetTeamByAbv("KC").Return(&repository.Team{ID: kc, Abbreviation: "KC"}, nil)
+				mockRepository.EXPECT().UpsertScoringPlay(gomock.Any()).DoAndReturn(func(play repository.ScoringPlay) error {
+					assert.Equal(t, "2", play.ID)
+					return nil
+				})
+				mockRepository.EXPECT().DeleteScoringPlaysExcept("123", []string{"2"}).Return(nil)
+				return mockRepository
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockRequester := rest.NewMockRequester(ctrl)
+			for _, plays := range tc.polls {
+				mockRequester.EXPECT().GetScoringPlays("123").Return(plays, nil)
+			}
+			l := &Logic{
+				logger:           zerolog.Nop(),
+				repo:             tc.mockRepo(ctrl),
+				requester:        mockRequester,
+				scoringPlayCache: make(map[string]uint64),
+			}
+
+			for range tc.polls {
+				assert.NoError(t, l.updateScoringPlays("123"))
+			}
+		})
+	}
+}
+
+func TestScoringType(t *testing.T) {
+	testCases := map[string]struct {
+		typeText     string
+		scoringName  string
+		abbreviation string
+		expected     string
+	}{
+		"should be TD for touchdowns":               {typeText: "Rushing Touchdown", scoringName: "touchdown", expected: "TD"},
+		"should be FG for field goals":              {typeText: "Field Goal Good", scoringName: "field-goal", expected: "FG"},
+		"should be SF for safeties":                 {typeText: "Safety", scoringName: "safety", expected: "SF"},
+		"should be 2PT for two point conversions":   {typeText: "Defensive 2pt Conversion", scoringName: "two-point-conversion", expected: "2PT"},
+		"should fall back to the ESPN abbreviation": {typeText: "Extra Point", abbreviation: "pat", expected: "PAT"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			play := rest.ScoringPlay{}
+			play.Type.Text = tc.typeText
+			play.ScoringType.Name = tc.scoringName
+			play.ScoringType.Abbreviation = tc.abbreviation
+
+			assert.Equal(t, tc.expected, scoringType(play))
+		})
+	}
+}
+
+func TestWeekOf(t *testing.T) {
+	weeks := []scraper.Week{
+		{
+			StartDate:  scraper.CustomTime{Time: time.Date(2023, 9, 6, 7, 0, 0, 0, time.UTC)},
+			EndDate:    scraper.CustomTime{Time: time.Date(2023, 9, 13, 6, 59, 0, 0, time.UTC)},
+			SeasonType: scraper.RegSeason,
+			WeekNumber: 1,
+			Year:       2023,
+		},
+		{
+			StartDate:  scraper.CustomTime{Time: time.Date(2024, 1, 10, 8, 0, 0, 0, time.UTC)},
+			EndDate:    scraper.CustomTime{Time: time.Date(2024, 1, 17, 7, 59, 0, 0, time.UTC)},
+			SeasonType: scraper.PostSeason,
+			WeekNumber: 1,
+			Year:       2023,
+		},
+	}
+
+	testCases := map[string]struct {
+		date     string
+		expected *scraper.Week
+	}{
+		"should find the week of a regular season game":  {date: "2023-09-12T00:15Z", expected: &weeks[0]},
+		"should find the week of a postseason game":      {date: "2024-01-14T01:15Z", expected: &weeks[1]},
+		"should return nil for a date outside the weeks": {date: "2023-12-25T21:30Z"},
+		"should return nil for a date that is invalid":   {date: "TBD"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, weekOf(weeks, tc.date))
+		})
+	}
+}
+
+func TestLogic_updateGameSeason(t *testing.T) {
+	week := &scraper.Week{SeasonType: scraper.RegSeason, Year: 2023}
+
+	testCases := map[string]struct {
+		game     repository.Game
+		week     *scraper.Week
+		mockRepo func(ctrl *gomock.Controller) *repository.MockRepository
+		err      error
+	}{
+		"should store the season of a game without one": {
+			game: repository.Game{ID: "123"},
+			week: week,
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpdateGameSeason("123", 2023, 2).Return(nil)
+				return mockRepository
+			},
+		},
+		"should not store an unchanged season": {
+			game: repository.Game{ID: "123", Season: 2023, SeasonType: 2},
+			week: week,
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				return repository.NewMockRepository(ctrl)
+			},
+		},
+		"should skip games of unknown weeks": {
+			game: repository.Game{ID: "123"},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				return repository.NewMockRepository(ctrl)
+			},
+		},
+		"should return error when the season fails to store": {
+			game: repository.Game{ID: "123"},
+			week: week,
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpdateGameSeason("123", 2023, 2).Return(repository.ErrUpdateGame)
+				return mockRepository
+			},
+			err: repository.ErrUpdateGame,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			l := &Logic{logger: zerolog.Nop(), repo: tc.mockRepo(ctrl)}
+
+			assert.ErrorIs(t, l.updateGameSeason(tc.game, tc.week), tc.err)
+		})
+	}
+}
+
+func TestLogic_updateFinalEntry(t *testing.T) {
+	linescores := func(scores ...string) []scraper.Linescores {
+		ls := make([]scraper.Linescores, 0, len(scores))
+		for _, s := range scores {
+			ls = append(ls, scraper.Linescores{DisplayValue: s})
+		}
+		return ls
+	}
+
+	testCases := map[string]struct {
+		tms         []scraper.Tms
+		mockRepo    func(ctrl *gomock.Controller) *repository.MockRepository
+		expectedErr bool
+	}{
+		"should write the final score and line score": {
+			tms: []scraper.Tms{
+				{Abbrev: "BUF", IsHome: true, Score: "20", Linescores: linescores("7", "3", "0", "10")},
+				{Abbrev: "KC", Score: "17", Linescores: linescores("0", "7", "7", "3")},
+			},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpsertFinalEntry(repository.FinalEntry{
+					GameID: "123",
+					Title:  "Final: KC 17 @ BUF 20",
+					LineScore: "* * * * * * * * * * * * *\n" +
+						"* Q    1  2  3  4       *\n" +
+						"* KC   0  7  7  3   17  *\n" +
+						"* QF             Final  *\n" +
+						"* BUF  7  3  0 10   20  *\n" +
+						"* * * * * * * * * * * * *",
+				}).Return(nil)
+				return mockRepository
+			},
+		},
+		"should mark overtime finals": {
+			tms: []scraper.Tms{
+				{Abbrev: "BUF", Score: "16", Linescores: linescores("0", "13", "0", "3", "0")},
+				{Abbrev: "NYJ", IsHome: true, Score: "22", Linescores: linescores("3", "7", "0", "6", "6")},
+			},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				mockRepository := repository.NewMockRepository(ctrl)
+				mockRepository.EXPECT().UpsertFinalEntry(gomock.Cond(func(x any) bool {
+					return x.(repository.FinalEntry).Title == "Final/OT: BUF 16 @ NYJ 22"
+				})).Return(nil)
+				return mockRepository
+			},
+		},
+		"should return error when teams are missing": {
+			tms: []scraper.Tms{{Abbrev: "BUF"}},
+			mockRepo: func(ctrl *gomock.Controller) *repository.MockRepository {
+				return repository.NewMockRepository(ctrl)
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			l := &Logic{
+				logger: zerolog.Nop(),
+				repo:   tc.mockRepo(ctrl),
+			}
+
+			err := l.updateFinalEntry(scraper.GameInfo{GameID: "123", Tms: tc.tms})
+
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
 		})
 	}
 }
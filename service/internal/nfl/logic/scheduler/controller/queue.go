@@ -0,0 +1,85 @@
+package controller
+
+import "sync"
+
+// gameQueues run the work of each game one at a time in the order it is queued, on one goroutine per game that
+// exits when the game's queue is empty. Work of different games runs concurrently.
+type gameQueues struct {
+	lock   sync.Mutex
+	queues map[string][]func()
+}
+
+func newGameQueues() *gameQueues {
+	return &gameQueues{queues: make(map[string][]func())}
+}
+
+// run queues work for gameID, starting the game's goroutine unless it is running.
+func (q *gameQueues) run(gameID string, work func()) {
+	q.lock.Lock()
+	queue, running := q.queues[gameID]
+	q.queues[gameID] = append(queue, work)
+	q.lock.Unlock()
+
+	if !running {
+		go q.drain(gameID)
+	}
+}
+
+func (q *gameQueues) drain(gameID string) {
+	for {
+		q.lock.Lock()
+		queue := q.queues[gameID]
+		if len(queue) == 0 {
+			delete(q.queues, gameID)
+			q.lock.Unlock()
+			return
+		}
+		work := queue[0]
+		q.queues[gameID] = queue[1:]
+		q.lock.Unlock()
+
+		work()
+	}
+}
+
+// gameRuns run the latest work of each game one at a time, on one goroutine per game that exits when the game has
+// no work waiting. Work queued for a game replaces its work that has not started, so however often a game is
+// queued at most one run waits behind the one running.
+type gameRuns struct {
+	lock    sync.Mutex
+	running map[string]bool
+	waiting map[string]func()
+}
+
+func newGameRuns() *gameRuns {
+	return &gameRuns{running: make(map[string]bool), waiting: make(map[string]func())}
+}
+
+// run makes work the next work of gameID, starting the game's goroutine unless it is running.
+func (r *gameRuns) run(gameID string, work func()) {
+	r.lock.Lock()
+	r.waiting[gameID] = work
+	running := r.running[gameID]
+	r.running[gameID] = true
+	r.lock.Unlock()
+
+	if !running {
+		go r.drain(gameID)
+	}
+}
+
+func (r *gameRuns) drain(gameID string) {
+	for {
+		r.lock.Lock()
+		work, ok := r.waiting[gameID]
+		if !ok {
+			delete(r.running, gameID)
+			r.lock.Unlock()
+			return
+		}
+		delete(r.waiting, gameID)
+		r.lock.Unlock()
+
+		work()
+	}
+}
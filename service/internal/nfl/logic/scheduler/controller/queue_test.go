@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGameQueues_run(t *testing.T) {
+	q := newGameQueues()
+	var (
+		lock sync.Mutex
+		ran  = make(map[string][]int)
+		wg   sync.WaitGroup
+	)
+	release := make(chan struct{})
+
+	for _, gameID := range []string{"1", "2"} {
+		gameID := gameID
+		for i := 0; i < 50; i++ {
+			i := i
+			wg.Add(1)
+			q.run(gameID, func() {
+				defer wg.Done()
+				if i == 0 {
+					<-release
+				}
+				lock.Lock()
+				defer lock.Unlock()
+				ran[gameID] = append(ran[gameID], i)
+			})
+		}
+	}
+	close(release)
+	wg.Wait()
+
+	for _, gameID := range []string{"1", "2"} {
+		assert.Len(t, ran[gameID], 50)
+		for i, n := range ran[gameID] {
+			assert.Equal(t, i, n, "game %s ran out of order", gameID)
+		}
+	}
+	waitForQueues(t, q)
+}
+
+// waitForQueues waits until every queued work has run.
+func waitForQueues(t *testing.T, q *gameQueues) {
+	assert.Eventually(t, func() bool {
+		q.lock.Lock()
+		defer q.lock.Unlock()
+		return len(q.queues) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestGameRuns_run(t *testing.T) {
+	r := newGameRuns()
+	var (
+		lock sync.Mutex
+		ran  []int
+	)
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	r.run("1", func() {
+		close(started)
+		<-release
+		lock.Lock()
+		defer lock.Unlock()
+		ran = append(ran, 0)
+	})
+	<-started
+	for i := 1; i <= 50; i++ {
+		i := i
+		r.run("1", func() {
+			lock.Lock()
+			defer lock.Unlock()
+			ran = append(ran, i)
+		})
+	}
+	close(release)
+
+	assert.Eventually(t, func() bool {
+		r.lock.Lock()
+		defer r.lock.Unlock()
+		return len(r.running) == 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, []int{0, 50}, ran, "work queued while a run is going should be replaced by the latest")
+}
@@ -0,0 +1,147 @@
+package controller
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/http/scraper"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"strconv"
+	"time"
+)
+
+type (
+	// Dispatcher sends webhook events to the webhooks they match.
+	Dispatcher interface {
+		Dispatch(event webhook.Event)
+	}
+
+	// gameState is what webhooks were last sent about a game. scoreChanges counts the score changes sent so a
+	// score that is taken back and reached again gets a new event id.
+	gameState struct {
+		period               int
+		awayScore, homeScore int
+		scoreChanges         int
+	}
+)
+
+// notifyChanges sends webhooks for what has changed in a game since it was last seen. A game seen for the first
+// time has kicked off when it is scoreless in the first quarter, otherwise the scheduler started during the game
+// and only later changes are sent. A quarter is sent as ended once the next quarter has started. The changes are
+// found as the scheduler polls, one poll of a game at a time, and sent on the game's webhook queue.
+func (l *Logic) notifyChanges(info scraper.GameInfo) {
+	if l.dispatcher == nil {
+		return
+	}
+	state, ok := stateFromGameInfo(info)
+	if !ok {
+		return
+	}
+
+	l.webhookCacheLock.Lock()
+	last, seen := l.webhookCache[info.GameID]
+	state.scoreChanges = last.scoreChanges
+	if seen && (state.awayScore != last.awayScore || state.homeScore != last.homeScore) {
+		state.scoreChanges++
+	}
+	l.webhookCache[info.GameID] = state
+	l.webhookCacheLock.Unlock()
+
+	var events []webhook.Event
+	switch {
+	case !seen:
+		if state.period <= 1 && state.awayScore == 0 && state.homeScore == 0 {
+			events = append(events, gameEvent(info, state, webhook.Kickoff, "kickoff"))
+		}
+	default:
+		for period := last.period; period > 0 && period < state.period; period++ {
+			ended := state
+			ended.period = period
+			events = append(events, gameEvent(info, ended, webhook.QuarterEnd, fmt.Sprintf("quarter-%d-end", period)))
+		}
+		if state.scoreChanges != last.scoreChanges {
+			events = append(events, gameEvent(info, state, webhook.ScoreChange,
+				fmt.Sprintf("score-%d-%d-%d", state.scoreChanges, state.awayScore, state.homeScore)))
+		}
+	}
+	l.dispatch(info.GameID, events...)
+}
+
+// notifyFinal sends the final webhook of a game that was seen in progress. Games that were already final when the
+// scheduler started are not sent again.
+func (l *Logic) notifyFinal(info scraper.GameInfo) {
+	if l.dispatcher == nil {
+		return
+	}
+
+	l.webhookCacheLock.Lock()
+	_, seen := l.webhookCache[info.GameID]
+	delete(l.webhookCache, info.GameID)
+	l.webhookCacheLock.Unlock()
+
+	state, ok := stateFromGameInfo(info)
+	if !seen || !ok {
+		return
+	}
+	l.dispatch(info.GameID, gameEvent(info, state, webhook.Final, "final"))
+}
+
+// dispatch notifies servers listening for changes that the game changed and queues its events to be sent after
+// the events queued before them, so deliveries of a game never overlap or arrive out of order while a slow
+// receiver is retried.
+func (l *Logic) dispatch(gameID string, events ...webhook.Event) {
+	if len(events) == 0 {
+		return
+	}
+	if err := l.repo.NotifyGameChange(gameID); err != nil {
+		l.logger.Error().Err(err).Msgf("while notifying change of game %s", gameID)
+	}
+	l.webhookQueues.run(gameID, func() {
+		for _, event := range events {
+			l.dispatcher.Dispatch(event)
+		}
+	})
+}
+
+func stateFromGameInfo(info scraper.GameInfo) (gameState, bool) {
+	if len(info.Tms) < 2 {
+		return gameState{}, false
+	}
+	state := gameState{}
+	for _, tm := range info.Tms {
+		score, err := strconv.Atoi(tm.Score)
+		if err != nil {
+			score = 0
+		}
+		if tm.IsHome {
+			state.homeScore = score
+		} else {
+			state.awayScore = score
+		}
+		if len(tm.Linescores) > state.period {
+			state.period = len(tm.Linescores)
+		}
+	}
+	return state, true
+}
+
+// gameEvent is the webhook event of a game. The id is made from the game and what changed so the same change
+// always has the same id.
+func gameEvent(info scraper.GameInfo, state gameState, eventType webhook.EventType, change string) webhook.Event {
+	event := webhook.Event{
+		ID:         info.GameID + "-" + change,
+		Sport:      webhook.SportNFL,
+		Type:       eventType,
+		GameID:     info.GameID,
+		AwayScore:  state.awayScore,
+		HomeScore:  state.homeScore,
+		Period:     state.period,
+		OccurredAt: time.Now().UTC(),
+	}
+	for _, tm := range info.Tms {
+		if tm.IsHome {
+			event.HomeTeam = tm.Abbrev
+		} else {
+			event.AwayTeam = tm.Abbrev
+		}
+	}
+	return event
+}
@@ -0,0 +1,107 @@
+package controller
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/db/repository"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/internal/data-access/http/scraper"
+	"github.com/rmarken5/mini-score/service/internal/webhook"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"sync"
+	"testing"
+)
+
+type recordingDispatcher struct {
+	lock   sync.Mutex
+	events []webhook.Event
+}
+
+func (r *recordingDispatcher) Dispatch(event webhook.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, event)
+}
+
+func gameInfo(quarters int, awayScore, homeScore string) scraper.GameInfo {
+	return scraper.GameInfo{GameID: "123", Tms: []scraper.Tms{
+		{Abbrev: "KC", Score: awayScore, Linescores: make([]scraper.Linescores, quarters)},
+		{Abbrev: "BUF", IsHome: true, Score: homeScore, Linescores: make([]scraper.Linescores, quarters)},
+	}}
+}
+
+func TestLogic_notifyChanges(t *testing.T) {
+	testCases := map[string]struct {
+		updates     []scraper.GameInfo
+		final       bool
+		expectedIDs []string
+		// notified counts the polls that changed the game
+		notified int
+	}{
+		"should send kickoff of a game first seen scoreless": {
+			updates:     []scraper.GameInfo{gameInfo(1, "0", "0")},
+			expectedIDs: []string{"123-kickoff"},
+			notified:    1,
+		},
+		"should not send kickoff of a game first seen after it started": {
+			updates: []scraper.GameInfo{gameInfo(2, "7", "0")},
+		},
+		"should send score changes once": {
+			updates:     []scraper.GameInfo{gameInfo(1, "0", "0"), gameInfo(1, "7", "0"), gameInfo(1, "7", "0"), gameInfo(1, "7", "3")},
+			expectedIDs: []string{"123-kickoff", "123-score-1-7-0", "123-score-2-7-3"},
+			notified:    3,
+		},
+		"should send a score reached again after it was taken back with a new id": {
+			updates:     []scraper.GameInfo{gameInfo(1, "0", "0"), gameInfo(1, "7", "0"), gameInfo(1, "0", "0"), gameInfo(1, "7", "0")},
+			expectedIDs: []string{"123-kickoff", "123-score-1-7-0", "123-score-2-0-0", "123-score-3-7-0"},
+			notified:    4,
+		},
+		"should send quarter ends as the next quarter starts": {
+			updates:     []scraper.GameInfo{gameInfo(1, "0", "0"), gameInfo(1, "0", "0"), gameInfo(3, "0", "3")},
+			expectedIDs: []string{"123-kickoff", "123-quarter-1-end", "123-quarter-2-end", "123-score-1-0-3"},
+			notified:    2,
+		},
+		"should send the final of a game seen in progress": {
+			updates:     []scraper.GameInfo{gameInfo(4, "17", "20")},
+			final:       true,
+			expectedIDs: []string{"123-final"},
+			notified:    1,
+		},
+		"should not send the final of a game not seen in progress": {
+			final: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			dispatcher := &recordingDispatcher{}
+			mockRepository := repository.NewMockRepository(gomock.NewController(t))
+			mockRepository.EXPECT().NotifyGameChange("123").Return(nil).Times(tc.notified)
+			l := &Logic{
+				logger:        zerolog.Nop(),
+				repo:          mockRepository,
+				dispatcher:    dispatcher,
+				webhookCache:  make(map[string]gameState),
+				webhookQueues: newGameQueues(),
+			}
+
+			for _, info := range tc.updates {
+				l.notifyChanges(info)
+			}
+			if tc.final {
+				l.notifyFinal(gameInfo(4, "17", "20"))
+			}
+			waitForQueues(t, l.webhookQueues)
+
+			ids := make([]string, 0, len(dispatcher.events))
+			for _, event := range dispatcher.events {
+				assert.Equal(t, webhook.SportNFL, event.Sport)
+				assert.Equal(t, "KC", event.AwayTeam)
+				assert.Equal(t, "BUF", event.HomeTeam)
+				ids = append(ids, event.ID)
+			}
+			assert.Equal(t, append([]string{}, tc.expectedIDs...), ids)
+		})
+	}
+}
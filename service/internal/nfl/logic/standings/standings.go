@@ -0,0 +1,289 @@
+package standings
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+type (
+	Team struct {
+		Abbreviation string
+		Conference   string
+		Division     string
+	}
+
+	// Result is the final score of a game between teams by abbreviation.
+	Result struct {
+		GameTime  time.Time
+		AwayTeam  string
+		HomeTeam  string
+		AwayScore int
+		HomeScore int
+	}
+
+	Record struct {
+		Wins, Losses, Ties int
+	}
+
+	Standing struct {
+		Team          Team
+		Overall       Record
+		Division      Record
+		Conference    Record
+		PointsFor     int
+		PointsAgainst int
+		Streak        Streak
+	}
+
+	// Streak is the current run of wins, losses or ties. Outcome is W, L or T, empty before the first game.
+	Streak struct {
+		Outcome string
+		Length  int
+	}
+
+	// Division is the standings of a division, best team first.
+	Division struct {
+		Conference string
+		Name       string
+		Standings  []Standing
+	}
+
+	// tieBreaker scores a team tied with others, higher is better.
+	tieBreaker func(s *Standing, tied []*Standing, results []Result) float64
+)
+
+// tieBreakers separate teams of a division with the same win percentage. They are a subset of the NFL
+// division tie-breaking procedure, applied in order:
+//
+//  1. Head-to-head win percentage in games among the tied teams, when each has played one of the others.
+//  2. Win percentage in division games.
+//  3. Win percentage in conference games.
+//  4. Net points in all games.
+//  5. Abbreviation, standing in for the coin toss.
+//
+// Common games and strength of victory or schedule are not applied. When a step separates some of the teams the
+// best of them are broken again from the first step, and once the best team is placed the rest start over.
+var tieBreakers = []tieBreaker{
+	headToHead,
+	func(s *Standing, _ []*Standing, _ []Result) float64 { return s.Division.Pct() },
+	func(s *Standing, _ []*Standing, _ []Result) float64 { return s.Conference.Pct() },
+	func(s *Standing, _ []*Standing, _ []Result) float64 { return float64(s.Net()) },
+}
+
+// Compute returns the standings of each division from the final scores of a season, ordered by conference and
+// division. Results with teams that are not in teams, like all-star games, are ignored.
+func Compute(teams []Team, results []Result) []Division {
+	standings := make(map[string]*Standing, len(teams))
+	for _, team := range teams {
+		standings[team.Abbreviation] = &Standing{Team: team}
+	}
+
+	sorted := make([]Result, 0, len(results))
+	for _, result := range results {
+		if standings[result.AwayTeam] != nil && standings[result.HomeTeam] != nil {
+			sorted = append(sorted, result)
+		}
+	}
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].GameTime.Before(sorted[j].GameTime) })
+
+	for _, result := range sorted {
+		away, home := standings[result.AwayTeam], standings[result.HomeTeam]
+		away.add(home.Team, result.AwayScore, result.HomeScore)
+		home.add(away.Team, result.HomeScore, result.AwayScore)
+	}
+
+	grouped := make(map[string][]*Standing)
+	for _, team := range teams {
+		key := team.Conference + " " + team.Division
+		grouped[key] = append(grouped[key], standings[team.Abbreviation])
+	}
+
+	divisions := make([]Division, 0, len(grouped))
+	for _, group := range grouped {
+		division := Division{Conference: group[0].Team.Conference, Name: group[0].Team.Division}
+		for _, s := range rank(group, sorted) {
+			division.Standings = append(division.Standings, *s)
+		}
+		divisions = append(divisions, division)
+	}
+	sort.Slice(divisions, func(i, j int) bool {
+		if divisions[i].Conference != divisions[j].Conference {
+			return divisions[i].Conference < divisions[j].Conference
+		}
+		return divisions[i].Name < divisions[j].Name
+	})
+	return divisions
+}
+
+// Pct is the win percentage counting ties as half a win, 0 before the first game.
+func (r Record) Pct() float64 {
+	games := r.Wins + r.Losses + r.Ties
+	if games == 0 {
+		return 0
+	}
+	return (float64(r.Wins) + float64(r.Ties)/2) / float64(games)
+}
+
+// String formats a record like 10-6-1, leaving out ties when there are none.
+func (r Record) String() string {
+	if r.Ties == 0 {
+		return fmt.Sprintf("%d-%d", r.Wins, r.Losses)
+	}
+	return fmt.Sprintf("%d-%d-%d", r.Wins, r.Losses, r.Ties)
+}
+
+// Net is the point differential.
+func (s Standing) Net() int {
+	return s.PointsFor - s.PointsAgainst
+}
+
+// add counts a game against opponent.
+func (s *Standing) add(opponent Team, pointsFor, pointsAgainst int) {
+	s.PointsFor += pointsFor
+	s.PointsAgainst += pointsAgainst
+
+	inConference := opponent.Conference == s.Team.Conference
+	inDivision := inConference && opponent.Division == s.Team.Division
+	records := []*Record{&s.Overall}
+	if inConference {
+		records = append(records, &s.Conference)
+	}
+	if inDivision {
+		records = append(records, &s.Division)
+	}
+
+	outcome := "T"
+	switch {
+	case pointsFor > pointsAgainst:
+		outcome = "W"
+	case pointsFor < pointsAgainst:
+		outcome = "L"
+	}
+	for _, r := range records {
+		switch outcome {
+		case "W":
+			r.Wins++
+		case "L":
+			r.Losses++
+		default:
+			r.Ties++
+		}
+	}
+
+	if s.Streak.Outcome != outcome {
+		s.Streak = Streak{Outcome: outcome}
+	}
+	s.Streak.Length++
+}
+
+// String formats a streak like W3.
+func (s Streak) String() string {
+	if s.Outcome == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s%d", s.Outcome, s.Length)
+}
+
+// rank orders the teams of a division by win percentage, breaking ties with tieBreakers.
+func rank(teams []*Standing, results []Result) []*Standing {
+	sorted := append([]*Standing(nil), teams...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Overall.Pct() > sorted[j].Overall.Pct() })
+
+	ranked := make([]*Standing, 0, len(sorted))
+	for start := 0; start < len(sorted); {
+		end := start + 1
+		for end < len(sorted) && sorted[end].Overall.Pct() == sorted[start].Overall.Pct() {
+			end++
+		}
+		ranked = append(ranked, breakTie(sorted[start:end], results)...)
+		start = end
+	}
+	return ranked
+}
+
+// breakTie orders teams with the same win percentage by placing the best of them, then starting over with the
+// rest.
+func breakTie(tied []*Standing, results []Result) []*Standing {
+	remaining := append([]*Standing(nil), tied...)
+	ordered := make([]*Standing, 0, len(tied))
+	for len(remaining) > 1 {
+		best := bestOf(remaining, results)
+		ordered = append(ordered, best)
+		for i, s := range remaining {
+			if s == best {
+				remaining = append(remaining[:i], remaining[i+1:]...)
+				break
+			}
+		}
+	}
+	return append(ordered, remaining...)
+}
+
+// bestOf returns the team that wins the tie between tied.
+func bestOf(tied []*Standing, results []Result) *Standing {
+	for _, breaker := range tieBreakers {
+		best := make([]*Standing, 0, len(tied))
+		top := 0.0
+		for _, s := range tied {
+			value := breaker(s, tied, results)
+			switch {
+			case len(best) == 0 || value > top:
+				best, top = []*Standing{s}, value
+			case value == top:
+				best = append(best, s)
+			}
+		}
+		if len(best) == 1 {
+			return best[0]
+		}
+		if len(best) < len(tied) {
+			return bestOf(best, results)
+		}
+	}
+
+	sorted := append([]*Standing(nil), tied...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Team.Abbreviation < sorted[j].Team.Abbreviation })
+	return sorted[0]
+}
+
+// headToHead is the win percentage of s in games against the other tied teams. Every team scores the same when a
+// tied team has not played any of the others, so the step is skipped.
+func headToHead(s *Standing, tied []*Standing, results []Result) float64 {
+	records := make(map[string]*Record, len(tied))
+	for _, t := range tied {
+		records[t.Team.Abbreviation] = &Record{}
+	}
+	for _, result := range results {
+		away, home := records[result.AwayTeam], records[result.HomeTeam]
+		if away == nil || home == nil {
+			continue
+		}
+		switch {
+		case result.AwayScore > result.HomeScore:
+			away.Wins++
+			home.Losses++
+		case result.AwayScore < result.HomeScore:
+			away.Losses++
+			home.Wins++
+		default:
+			away.Ties++
+			home.Ties++
+		}
+	}
+	for _, r := range records {
+		if r.Wins+r.Losses+r.Ties == 0 {
+			return 0
+		}
+	}
+	return records[s.Team.Abbreviation].Pct()
+}
+
+// Season returns the season being played or last played at t. Seasons start in September and run into February
+// of the next year.
+func Season(t time.Time) int {
+	if t.Month() < time.September {
+		return t.Year() - 1
+	}
+	return t.Year()
+}
@@ -0,0 +1,221 @@
+package standings
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+var (
+	buf = Team{Abbreviation: "BUF", Conference: "AFC", Division: "East"}
+	mia = Team{Abbreviation: "MIA", Conference: "AFC", Division: "East"}
+	nyj = Team{Abbreviation: "NYJ", Conference: "AFC", Division: "East"}
+	ne  = Team{Abbreviation: "NE", Conference: "AFC", Division: "East"}
+	pit = Team{Abbreviation: "PIT", Conference: "AFC", Division: "North"}
+	dal = Team{Abbreviation: "DAL", Conference: "NFC", Division: "East"}
+)
+
+var kickoff = time.Date(2023, 9, 7, 0, 20, 0, 0, time.UTC)
+
+func result(week int, away string, awayScore int, home string, homeScore int) Result {
+	return Result{GameTime: kickoff.AddDate(0, 0, 7*week), AwayTeam: away, HomeTeam: home, AwayScore: awayScore, HomeScore: homeScore}
+}
+
+// filler returns games of team against opponent that team wins 20-10 or loses 10-20, played before week 1.
+func filler(team, opponent string, wins, losses int) []Result {
+	results := make([]Result, 0, wins+losses)
+	for i := 0; i < wins; i++ {
+		results = append(results, result(-20+i, team, 20, opponent, 10))
+	}
+	for i := 0; i < losses; i++ {
+		results = append(results, result(-20+wins+i, team, 10, opponent, 20))
+	}
+	return results
+}
+
+// afcEast2023 returns the 2023 AFC East division games with their final scores. Games outside the division are
+// stand-ins that give each team its final record: BUF 11-6, MIA 11-6, NYJ 7-10 and NE 4-13.
+func afcEast2023() []Result {
+	results := []Result{
+		result(1, "BUF", 16, "NYJ", 22),
+		result(2, "MIA", 24, "NE", 17),
+		result(3, "NE", 15, "NYJ", 10),
+		result(4, "MIA", 20, "BUF", 48),
+		result(7, "BUF", 25, "NE", 29),
+		result(8, "NE", 17, "MIA", 31),
+		result(11, "NYJ", 6, "BUF", 32),
+		result(12, "MIA", 34, "NYJ", 13),
+		result(15, "NYJ", 0, "MIA", 30),
+		result(17, "NE", 21, "BUF", 27),
+		result(18, "BUF", 21, "MIA", 14),
+		result(18, "NE", 3, "NYJ", 17),
+	}
+	results = append(results, filler("BUF", "PIT", 5, 2)...)
+	results = append(results, filler("BUF", "DAL", 2, 2)...)
+	results = append(results, filler("MIA", "PIT", 4, 2)...)
+	results = append(results, filler("MIA", "DAL", 3, 2)...)
+	results = append(results, filler("NYJ", "PIT", 3, 3)...)
+	results = append(results, filler("NYJ", "DAL", 2, 3)...)
+	results = append(results, filler("NE", "PIT", 1, 5)...)
+	results = append(results, filler("NE", "DAL", 1, 4)...)
+	return results
+}
+
+func TestCompute(t *testing.T) {
+	teams := []Team{nyj, ne, mia, buf, pit, dal}
+
+	division := Compute(teams, afcEast2023())[0]
+
+	assert.Equal(t, "AFC", division.Conference)
+	assert.Equal(t, "East", division.Name)
+	require.Len(t, division.Standings, 4)
+
+	order := make([]string, 0, 4)
+	for _, s := range division.Standings {
+		order = append(order, s.Team.Abbreviation)
+	}
+	assert.Equal(t, []string{"BUF", "MIA", "NYJ", "NE"}, order, "BUF wins the division on head-to-head")
+
+	bills := division.Standings[0]
+	assert.Equal(t, Record{Wins: 11, Losses: 6}, bills.Overall)
+	assert.Equal(t, Record{Wins: 4, Losses: 2}, bills.Division)
+	assert.Equal(t, Record{Wins: 9, Losses: 4}, bills.Conference)
+	assert.Equal(t, 349, bills.PointsFor)
+	assert.Equal(t, 262, bills.PointsAgainst)
+	assert.Equal(t, 87, bills.Net())
+	assert.Equal(t, "W3", bills.Streak.String())
+
+	assert.Equal(t, Record{Wins: 11, Losses: 6}, division.Standings[1].Overall)
+	assert.Equal(t, Record{Wins: 7, Losses: 10}, division.Standings[2].Overall)
+	assert.Equal(t, Record{Wins: 4, Losses: 13}, division.Standings[3].Overall)
+}
+
+func TestCompute_Divisions(t *testing.T) {
+	divisions := Compute([]Team{dal, pit, buf}, nil)
+
+	names := make([]string, 0, len(divisions))
+	for _, d := range divisions {
+		names = append(names, d.Conference+" "+d.Name)
+		for _, s := range d.Standings {
+			assert.Equal(t, Record{}, s.Overall)
+			assert.Empty(t, s.Streak.String())
+		}
+	}
+	assert.Equal(t, []string{"AFC East", "AFC North", "NFC East"}, names)
+}
+
+func TestCompute_TieBreakers(t *testing.T) {
+	testCases := map[string]struct {
+		results       []Result
+		expectedOrder []string
+	}{
+		"should rank by win percentage counting ties as half a win": {
+			results: []Result{
+				result(1, "BUF", 20, "PIT", 20),
+				result(2, "MIA", 10, "PIT", 20),
+			},
+			expectedOrder: []string{"BUF", "NE", "MIA"},
+		},
+		"should break a tie on head-to-head": {
+			results: []Result{
+				result(1, "MIA", 20, "BUF", 10),
+				result(2, "BUF", 20, "DAL", 10),
+				result(3, "NE", 20, "PIT", 10),
+				result(4, "NE", 10, "PIT", 20),
+				result(5, "NE", 10, "PIT", 20),
+				result(6, "MIA", 10, "DAL", 20),
+			},
+			expectedOrder: []string{"MIA", "BUF", "NE"},
+		},
+		"should break a split head-to-head on division record": {
+			results: []Result{
+				result(1, "MIA", 20, "BUF", 10),
+				result(2, "BUF", 20, "MIA", 10),
+				result(3, "BUF", 20, "NE", 10),
+				result(4, "MIA", 10, "NE", 20),
+				result(5, "BUF", 10, "PIT", 20),
+				result(6, "MIA", 20, "PIT", 10),
+				result(7, "NE", 10, "PIT", 20),
+			},
+			expectedOrder: []string{"BUF", "MIA", "NE"},
+		},
+		"should break a tie on conference record when the teams have not met": {
+			results: []Result{
+				result(1, "BUF", 20, "PIT", 10),
+				result(2, "BUF", 10, "DAL", 20),
+				result(3, "MIA", 20, "DAL", 10),
+				result(4, "MIA", 10, "PIT", 20),
+			},
+			expectedOrder: []string{"BUF", "MIA", "NE"},
+		},
+		"should break a tie on net points": {
+			results: []Result{
+				result(1, "BUF", 20, "PIT", 10),
+				result(2, "MIA", 40, "PIT", 10),
+			},
+			expectedOrder: []string{"MIA", "BUF", "NE"},
+		},
+		"should break a tie on abbreviation when nothing else separates the teams": {
+			results:       []Result{},
+			expectedOrder: []string{"BUF", "MIA", "NE"},
+		},
+		"should place the best of three tied teams and start over with the rest": {
+			results: []Result{
+				result(1, "BUF", 20, "MIA", 10),
+				result(2, "BUF", 20, "NE", 10),
+				result(3, "NE", 30, "MIA", 10),
+				result(4, "MIA", 20, "PIT", 10),
+				result(5, "MIA", 20, "DAL", 10),
+				result(6, "NE", 20, "PIT", 10),
+				result(7, "BUF", 10, "PIT", 20),
+				result(8, "NE", 10, "DAL", 20),
+				result(9, "BUF", 10, "DAL", 20),
+			},
+			expectedOrder: []string{"BUF", "NE", "MIA"},
+		},
+		"should ignore games against unknown teams": {
+			results: []Result{
+				result(1, "MIA", 20, "AFC", 10),
+			},
+			expectedOrder: []string{"BUF", "MIA", "NE"},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			division := Compute([]Team{buf, mia, ne, pit, dal}, tc.results)[0]
+
+			order := make([]string, 0, len(division.Standings))
+			for _, s := range division.Standings {
+				order = append(order, s.Team.Abbreviation)
+			}
+			assert.Equal(t, tc.expectedOrder, order)
+		})
+	}
+}
+
+func TestStanding_Streak(t *testing.T) {
+	results := []Result{
+		result(1, "BUF", 10, "MIA", 20),
+		result(2, "BUF", 20, "NE", 10),
+		result(3, "BUF", 20, "PIT", 20),
+		result(4, "BUF", 20, "DAL", 10),
+		result(5, "PIT", 10, "BUF", 20),
+	}
+
+	standings := make(map[string]Standing)
+	for _, d := range Compute([]Team{buf, mia, ne, pit, dal}, results) {
+		for _, s := range d.Standings {
+			standings[s.Team.Abbreviation] = s
+		}
+	}
+
+	assert.Equal(t, "W2", standings["BUF"].Streak.String())
+	assert.Equal(t, Record{Wins: 3, Losses: 1, Ties: 1}, standings["BUF"].Overall)
+	assert.Equal(t, "3-1-1", standings["BUF"].Overall.String())
+	assert.Equal(t, "W1", standings["MIA"].Streak.String())
+	assert.Equal(t, "L1", standings["PIT"].Streak.String())
+}
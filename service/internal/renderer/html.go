@@ -0,0 +1,73 @@
+package renderer
+
+import (
+	"embed"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
+	"html/template"
+	"io"
+	"time"
+)
+
+//go:embed templates/board.gotmpl templates/tables.gotmpl
+var templates embed.FS
+
+var boardTemplate = template.Must(template.New("board.gotmpl").Funcs(template.FuncMap{
+	"periods": func(g Game) []int {
+		periods := make([]int, g.Periods)
+		for i := range periods {
+			periods[i] = i + 1
+		}
+		return periods
+	},
+	"totalLabels": totalLabels,
+	"status":      statusText,
+	"startTime":   startText,
+	"datetime": func(t time.Time) string {
+		return t.Format(time.RFC3339)
+	},
+	"list": func(teams ...Team) []Team {
+		return teams
+	},
+}).ParseFS(templates, "templates/board.gotmpl"))
+
+var tablesTemplate = template.Must(template.ParseFS(templates, "templates/tables.gotmpl"))
+
+type (
+	HTMLOptions struct {
+		// Refresh is the number of seconds before browsers reload the page. Zero means the page is not reloaded.
+		Refresh int
+	}
+
+	// HTML writes boards as a page of tables for browsers and screen readers. Pages have no scripts so games in
+	// progress are kept current with a meta refresh.
+	HTML struct {
+		Options HTMLOptions
+	}
+
+	htmlBoard struct {
+		Board
+		Heading string
+		Banner  string
+		Refresh int
+	}
+)
+
+func (h HTML) ContentType() string {
+	return "text/html; charset=UTF-8"
+}
+
+func (h HTML) Render(w io.Writer, board Board) error {
+	page := htmlBoard{Board: board, Heading: board.Date.Format(headingTimeFormat), Refresh: h.Options.Refresh}
+	if !board.AsOf.IsZero() {
+		page.Banner = lastgood.Banner(board.AsOf.In(board.Date.Location()))
+	}
+	return boardTemplate.Execute(w, page)
+}
+
+// startText returns the start time shown for a scheduled game, the clock when one is set and the detail otherwise.
+func startText(g Game) string {
+	if g.Status.Clock != "" {
+		return g.Status.Clock
+	}
+	return g.Status.Detail
+}
@@ -0,0 +1,149 @@
+package renderer
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+type (
+	// JSON writes boards as JSON for scripts and other clients.
+	JSON struct{}
+
+	jsonBoard struct {
+		Date  string     `json:"date"`
+		AsOf  *time.Time `json:"asOf,omitempty"`
+		Games []jsonGame `json:"games"`
+	}
+
+	jsonGame struct {
+		ID          string       `json:"id,omitempty"`
+		State       string       `json:"state"`
+		Detail      string       `json:"detail,omitempty"`
+		Clock       string       `json:"clock,omitempty"`
+		Start       *time.Time   `json:"start,omitempty"`
+		PeriodLabel string       `json:"periodLabel,omitempty"`
+		Periods     int          `json:"periods"`
+		TotalLabels []string     `json:"totalLabels"`
+		Away        jsonTeam     `json:"away"`
+		Home        jsonTeam     `json:"home"`
+		Details     []jsonDetail `json:"details,omitempty"`
+		LastPlay    string       `json:"lastPlay,omitempty"`
+	}
+
+	jsonDetail struct {
+		Label string `json:"label"`
+		Value string `json:"value"`
+	}
+
+	jsonTeam struct {
+		Name    string   `json:"name"`
+		Color   string   `json:"color,omitempty"`
+		Periods []string `json:"periods"`
+		Totals  []string `json:"totals"`
+	}
+)
+
+func (j JSON) ContentType() string {
+	return "application/json; charset=UTF-8"
+}
+
+func (j JSON) Render(w io.Writer, board Board) error {
+	b := jsonBoard{
+		Date:  board.Date.Format("2006-01-02"),
+		Games: make([]jsonGame, 0, len(board.Games)),
+	}
+	if !board.AsOf.IsZero() {
+		asOf := board.AsOf
+		b.AsOf = &asOf
+	}
+	for _, g := range board.Games {
+		game := jsonGame{
+			ID:          g.ID,
+			State:       g.Status.State.String(),
+			Detail:      g.Status.Detail,
+			Clock:       g.Status.Clock,
+			PeriodLabel: g.PeriodLabel,
+			Periods:     g.Periods,
+			TotalLabels: nonNil(g.TotalLabels),
+			Away:        toJSONTeam(g.Away),
+			Home:        toJSONTeam(g.Home),
+			LastPlay:    g.LastPlay,
+		}
+		for _, d := range g.Details {
+			game.Details = append(game.Details, jsonDetail{Label: d.Label, Value: d.Value})
+		}
+		if !g.Status.Start.IsZero() {
+			start := g.Status.Start
+			game.Start = &start
+		}
+		b.Games = append(b.Games, game)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(b)
+}
+
+// DecodeJSON reads a board written by JSON, for clients that draw boards themselves. The date is read in loc.
+func DecodeJSON(r io.Reader, loc *time.Location) (Board, error) {
+	var b jsonBoard
+	if err := json.NewDecoder(r).Decode(&b); err != nil {
+		return Board{}, err
+	}
+	date, err := time.ParseInLocation("2006-01-02", b.Date, loc)
+	if err != nil {
+		return Board{}, err
+	}
+
+	board := Board{Date: date, Games: make([]Game, 0, len(b.Games))}
+	if b.AsOf != nil {
+		board.AsOf = *b.AsOf
+	}
+	for _, g := range b.Games {
+		game := Game{
+			ID:          g.ID,
+			PeriodLabel: g.PeriodLabel,
+			Periods:     g.Periods,
+			TotalLabels: g.TotalLabels,
+			Away:        fromJSONTeam(g.Away),
+			Home:        fromJSONTeam(g.Home),
+			Status:      Status{State: parseState(g.State), Detail: g.Detail, Clock: g.Clock},
+			LastPlay:    g.LastPlay,
+		}
+		for _, d := range g.Details {
+			game.Details = append(game.Details, Detail{Label: d.Label, Value: d.Value})
+		}
+		if g.Start != nil {
+			game.Status.Start = *g.Start
+		}
+		board.Games = append(board.Games, game)
+	}
+	return board, nil
+}
+
+func parseState(s string) State {
+	switch s {
+	case Live.String():
+		return Live
+	case Final.String():
+		return Final
+	default:
+		return Scheduled
+	}
+}
+
+func fromJSONTeam(t jsonTeam) Team {
+	return Team{Name: t.Name, Color: t.Color, Periods: t.Periods, Totals: t.Totals}
+}
+
+func toJSONTeam(t Team) jsonTeam {
+	return jsonTeam{Name: t.Name, Color: t.Color, Periods: nonNil(t.Periods), Totals: nonNil(t.Totals)}
+}
+
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
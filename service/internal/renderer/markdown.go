@@ -0,0 +1,91 @@
+package renderer
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
+	"io"
+	"strconv"
+	"strings"
+)
+
+type (
+	// Markdown writes boards as Markdown tables for chat and issue trackers.
+	Markdown struct{}
+)
+
+func (m Markdown) ContentType() string {
+	return "text/markdown; charset=UTF-8"
+}
+
+func (m Markdown) Render(w io.Writer, board Board) error {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("## %s\n", board.Date.Format(headingTimeFormat)))
+	if !board.AsOf.IsZero() {
+		sb.WriteString(fmt.Sprintf("\n_%s_\n", lastgood.Banner(board.AsOf.In(board.Date.Location()))))
+	}
+
+	for _, g := range board.Games {
+		sb.WriteString("\n")
+		header := []string{escapeMarkdown(g.PeriodLabel)}
+		align := []string{":--"}
+		for i := 0; i < g.Periods; i++ {
+			header = append(header, strconv.Itoa(i+1))
+			align = append(align, "--:")
+		}
+		for _, label := range totalLabels(g) {
+			header = append(header, escapeMarkdown(label))
+			align = append(align, "--:")
+		}
+		sb.WriteString(markdownRow(header))
+		sb.WriteString(markdownRow(align))
+		sb.WriteString(markdownRow(markdownTeamRow(g, g.Away)))
+		sb.WriteString(markdownRow(markdownTeamRow(g, g.Home)))
+		sb.WriteString("\n" + escapeMarkdown(statusText(g)) + "\n")
+		if g.LastPlay != "" {
+			sb.WriteString("\nLast play: " + escapeMarkdown(g.LastPlay) + "\n")
+		}
+		if len(g.Details) > 0 {
+			sb.WriteString("\n")
+		}
+		for _, d := range g.Details {
+			sb.WriteString(fmt.Sprintf("- **%s:** %s\n", escapeMarkdown(d.Label), escapeMarkdown(d.Value)))
+		}
+	}
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+func markdownTeamRow(g Game, t Team) []string {
+	row := []string{"**" + escapeMarkdown(t.Name) + "**"}
+	for i := 0; i < g.Periods; i++ {
+		row = append(row, escapeMarkdown(t.PeriodScore(i)))
+	}
+	for i := range totalLabels(g) {
+		row = append(row, escapeMarkdown(t.Total(i)))
+	}
+	return row
+}
+
+func markdownRow(cells []string) string {
+	return "| " + strings.Join(cells, " | ") + " |\n"
+}
+
+var markdownEscaper = strings.NewReplacer(`\`, `\\`, "|", `\|`, "*", `\*`, "_", `\_`, "`", "\\`")
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// totalLabels returns the labels of the total columns. A single total is the score and is labeled T.
+func totalLabels(g Game) []string {
+	if len(g.TotalLabels) == 0 {
+		return []string{"T"}
+	}
+	return g.TotalLabels
+}
+
+// statusText describes the state of a game in one line, like Q4 05:43.
+func statusText(g Game) string {
+	return strings.TrimSpace(g.Status.Detail + " " + g.Status.Clock)
+}
@@ -0,0 +1,144 @@
+package renderer
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+const (
+	Scheduled State = iota
+	Live
+	Final
+)
+
+const (
+	FormatText     = "text"
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+	FormatJSON     = "json"
+)
+
+type (
+	// State is where a game is between being scheduled and being final.
+	State int
+
+	// Board is a day of games for a sport.
+	Board struct {
+		Date time.Time
+		// AsOf is when stale data on the board was current. Zero means the board is current.
+		AsOf  time.Time
+		Games []Game
+	}
+
+	// Game is the score of a game broken into periods, innings for baseball and quarters for football.
+	Game struct {
+		ID string
+		// PeriodLabel heads the period columns, like Q for quarters.
+		PeriodLabel string
+		// Periods is the number of period columns to show. Teams may have scores for fewer periods.
+		Periods int
+		// TotalLabels name the total columns, like R, H and E. The first total decides who is leading.
+		TotalLabels []string
+		Away, Home  Team
+		Status      Status
+		// Details describe the game beyond the line score, like the count or down and distance. Boards leave them
+		// empty and game pages fill them in.
+		Details []Detail
+		// LastPlay describes the last play of a game in progress, empty when unknown.
+		LastPlay string
+	}
+
+	Detail struct {
+		Label string
+		Value string
+	}
+
+	Team struct {
+		Name string
+		// Color is the team color as RRGGBB, empty when unknown.
+		Color   string
+		Periods []string
+		Totals  []string
+	}
+
+	Status struct {
+		State State
+		// Detail is the period or state of the game, like Q4, Top 5th or Final.
+		Detail string
+		// Clock is the game clock or start time.
+		Clock string
+		// Start is when the game starts.
+		Start time.Time
+	}
+
+	// Options are the options of each format.
+	Options struct {
+		Text TextOptions
+		HTML HTMLOptions
+	}
+
+	// Renderer writes boards in a format.
+	Renderer interface {
+		Render(w io.Writer, board Board) error
+		ContentType() string
+	}
+)
+
+// New returns the renderer for format drawn according to opts.
+func New(format string, opts Options) (Renderer, error) {
+	switch format {
+	case "", FormatText:
+		return Text{Options: opts.Text}, nil
+	case FormatMarkdown, "md":
+		return Markdown{}, nil
+	case FormatHTML:
+		return HTML{Options: opts.HTML}, nil
+	case FormatJSON:
+		return JSON{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+}
+
+func (s State) String() string {
+	switch s {
+	case Live:
+		return "live"
+	case Final:
+		return "final"
+	default:
+		return "scheduled"
+	}
+}
+
+// Leading reports which team is ahead on the first total. Both are false when the game is tied.
+func (g Game) Leading() (away bool, home bool) {
+	awayScore, homeScore := g.Away.score(), g.Home.score()
+	return awayScore > homeScore, homeScore > awayScore
+}
+
+// PeriodScore returns the score of period i, blank when the team has no score for it.
+func (t Team) PeriodScore(i int) string {
+	if i < len(t.Periods) {
+		return t.Periods[i]
+	}
+	return ""
+}
+
+// Total returns total i, blank when the team has no such total.
+func (t Team) Total(i int) string {
+	if i < len(t.Totals) {
+		return t.Totals[i]
+	}
+	return ""
+}
+
+func (t Team) score() int {
+	score, err := strconv.Atoi(t.Total(0))
+	if err != nil {
+		return 0
+	}
+	return score
+}
@@ -0,0 +1,200 @@
+package renderer
+
+import (
+	"bytes"
+	"flag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func testBoard() Board {
+	loc, _ := time.LoadLocation("America/New_York")
+	return Board{
+		Date: time.Date(2023, 9, 10, 0, 0, 0, 0, loc),
+		AsOf: time.Date(2023, 9, 10, 19, 4, 0, 0, time.UTC),
+		Games: []Game{
+			{
+				ID:          "401547353",
+				PeriodLabel: "Q",
+				Periods:     4,
+				TotalLabels: []string{"T"},
+				Away:        Team{Name: "PIT", Color: "ffb612", Periods: []string{"14", "7", "10", "7"}, Totals: []string{"38"}},
+				Home:        Team{Name: "SF", Color: "aa0000", Periods: []string{"10", "10", "3", "10"}, Totals: []string{"33"}},
+				Status:      Status{State: Live, Detail: "Q4", Clock: "05:43"},
+				LastPlay:    "B.Purdy pass short right to G.Kittle to PIT 32 for 8 yards",
+			},
+			{
+				ID:          "717465",
+				Periods:     10,
+				TotalLabels: []string{"R", "H", "E"},
+				Away:        Team{Name: "NYY", Periods: []string{"0", "1", "0", "0", "2", "0", "0", "0", "0", "0"}, Totals: []string{"3", "8", "1"}},
+				Home:        Team{Name: "BOS", Periods: []string{"0", "0", "0", "3", "0", "0", "0", "0", "0", "1"}, Totals: []string{"4", "9", "0"}},
+				Status:      Status{State: Final, Detail: "Final"},
+			},
+			{
+				ID:          "717466",
+				Periods:     9,
+				TotalLabels: []string{"R", "H", "E"},
+				Away:        Team{Name: "AZ"},
+				Home:        Team{Name: "WSH"},
+				Status:      Status{State: Scheduled, Detail: "7:05 PM", Start: time.Date(2023, 9, 10, 23, 5, 0, 0, time.UTC)},
+			},
+		},
+	}
+}
+
+// testGame returns a board of one game with details, as shown on game pages.
+func testGame() Board {
+	board := testBoard()
+	game := board.Games[0]
+	game.Details = []Detail{
+		{Label: "Possession", Value: "SF"},
+		{Label: "Down", Value: "3rd & 4 at PIT 40"},
+		{Label: "Timeouts", Value: "PIT 2, SF 3"},
+	}
+	return Board{Date: board.Date, Games: []Game{game}}
+}
+
+func TestRenderers(t *testing.T) {
+	testCases := map[string]struct {
+		renderer Renderer
+		empty    bool
+		game     bool
+		golden   string
+	}{
+		"text":          {renderer: Text{Options: TextOptions{PerLine: 2}}, golden: "board.txt"},
+		"colored text":  {renderer: Text{Options: TextOptions{Width: 80, Color: true}}, golden: "board.color.txt"},
+		"markdown":      {renderer: Markdown{}, golden: "board.md"},
+		"html":          {renderer: HTML{}, golden: "board.html"},
+		"html refresh":  {renderer: HTML{Options: HTMLOptions{Refresh: 60}}, empty: true, golden: "empty.refresh.html"},
+		"json":          {renderer: JSON{}, golden: "board.json"},
+		"empty text":    {renderer: Text{Options: TextOptions{PerLine: 3}}, empty: true, golden: "empty.txt"},
+		"empty json":    {renderer: JSON{}, empty: true, golden: "empty.json"},
+		"empty html":    {renderer: HTML{}, empty: true, golden: "empty.html"},
+		"empty md":      {renderer: Markdown{}, empty: true, golden: "empty.md"},
+		"text game":     {renderer: Text{Options: TextOptions{PerLine: 1}}, game: true, golden: "game.txt"},
+		"markdown game": {renderer: Markdown{}, game: true, golden: "game.md"},
+		"html game":     {renderer: HTML{}, game: true, golden: "game.html"},
+		"json game":     {renderer: JSON{}, game: true, golden: "game.json"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			board := testBoard()
+			if tc.empty {
+				board = Board{Date: board.Date}
+			}
+			if tc.game {
+				board = testGame()
+			}
+
+			buf := bytes.Buffer{}
+			require.NoError(t, tc.renderer.Render(&buf, board))
+
+			golden := filepath.Join("test-data", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	testCases := map[string]struct {
+		format              string
+		expectedContentType string
+		expectErr           bool
+	}{
+		"should default to text":  {format: "", expectedContentType: "text/plain; charset=UTF-8"},
+		"should support markdown": {format: "md", expectedContentType: "text/markdown; charset=UTF-8"},
+		"should support html":     {format: "html", expectedContentType: "text/html; charset=UTF-8"},
+		"should support json":     {format: "json", expectedContentType: "application/json; charset=UTF-8"},
+		"should reject unknown":   {format: "xml", expectErr: true},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			r, err := New(tc.format, Options{})
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedContentType, r.ContentType())
+		})
+	}
+}
+
+func TestText_LineScore(t *testing.T) {
+	testCases := map[string]struct {
+		options TextOptions
+	}{
+		"should draw the board box":       {},
+		"should draw without ansi colors": {options: TextOptions{Color: true}},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			game := testBoard().Games[1]
+
+			lineScore := Text{Options: tc.options}.LineScore(game)
+
+			assert.Equal(t, strings.Join(Text{}.box(game), "\n"), lineScore)
+			assert.NotContains(t, lineScore, "\x1b")
+		})
+	}
+}
+
+func TestDecodeJSON(t *testing.T) {
+	loc, _ := time.LoadLocation("America/New_York")
+
+	testCases := map[string]struct {
+		json    string
+		perLine int
+		golden  string
+	}{
+		"should decode board that draws as the original": {json: "board.json", perLine: 2, golden: "board.txt"},
+		"should decode game with details":                {json: "game.json", perLine: 1, golden: "game.txt"},
+		"should decode empty board":                      {json: "empty.json", perLine: 3, golden: "empty.txt"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			f, err := os.Open(filepath.Join("test-data", tc.json))
+			require.NoError(t, err)
+			defer f.Close()
+
+			board, err := DecodeJSON(f, loc)
+			require.NoError(t, err)
+
+			buf := bytes.Buffer{}
+			require.NoError(t, Text{Options: TextOptions{PerLine: tc.perLine}}.Render(&buf, board))
+			expected, err := os.ReadFile(filepath.Join("test-data", tc.golden))
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
+
+func TestDecodeJSON_Invalid(t *testing.T) {
+	_, err := DecodeJSON(strings.NewReader(`{"date":"Sunday"}`), time.UTC)
+	assert.Error(t, err)
+}
@@ -0,0 +1,207 @@
+package renderer
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
+	"github.com/rmarken5/mini-score/service/internal/layout"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	_ TableRenderer = Text{}
+	_ TableRenderer = Markdown{}
+	_ TableRenderer = HTML{}
+	_ TableRenderer = JSON{}
+)
+
+type (
+	// Table is a titled table, like the standings of a division. The first cell of each row names the row and
+	// sits under the title, the rest sit under Columns.
+	Table struct {
+		Title   string
+		Columns []string
+		Rows    [][]string
+	}
+
+	// Tables is a page of tables under a heading.
+	Tables struct {
+		Heading string
+		// AsOf is when stale tables were current. Zero means the tables are current.
+		AsOf time.Time
+		// Location is the timezone AsOf is shown in, like the date of a board. AsOf is shown as it is when nil.
+		Location *time.Location
+		Tables   []Table
+	}
+
+	// TableRenderer writes pages of tables in a format.
+	TableRenderer interface {
+		RenderTables(w io.Writer, tables Tables) error
+		ContentType() string
+	}
+
+	jsonTables struct {
+		Heading string      `json:"heading"`
+		AsOf    *time.Time  `json:"asOf,omitempty"`
+		Tables  []jsonTable `json:"tables"`
+	}
+
+	htmlTables struct {
+		Heading string
+		Banner  string
+		Tables  []Table
+	}
+
+	jsonTable struct {
+		Title   string     `json:"title"`
+		Columns []string   `json:"columns"`
+		Rows    [][]string `json:"rows"`
+	}
+)
+
+// RenderTables draws each table as a box of stars, laid out like the boxes of a board.
+func (t Text) RenderTables(w io.Writer, tables Tables) error {
+	sb := strings.Builder{}
+	sb.WriteString(tables.Heading + "\n")
+	if !tables.AsOf.IsZero() {
+		sb.WriteString(tables.banner() + "\n")
+	}
+
+	boxes := make([]layout.Box, 0, len(tables.Tables))
+	for _, table := range tables.Tables {
+		boxes = append(boxes, tableBox(table))
+	}
+	l := layout.Layout{Width: t.Options.Width, PerLine: t.Options.PerLine, Gap: " "}
+	sb.WriteString(strings.Join(l.Render(boxes), "\n"))
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// RenderTables writes tables with their rows as arrays of cells.
+func (j JSON) RenderTables(w io.Writer, tables Tables) error {
+	t := jsonTables{Heading: tables.Heading, Tables: make([]jsonTable, 0, len(tables.Tables))}
+	if !tables.AsOf.IsZero() {
+		asOf := tables.AsOf
+		t.AsOf = &asOf
+	}
+	for _, table := range tables.Tables {
+		rows := make([][]string, 0, len(table.Rows))
+		for _, row := range table.Rows {
+			rows = append(rows, nonNil(row))
+		}
+		t.Tables = append(t.Tables, jsonTable{Title: table.Title, Columns: nonNil(table.Columns), Rows: rows})
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(t)
+}
+
+// RenderTables writes a page of tables, with the row names as row headers.
+func (h HTML) RenderTables(w io.Writer, tables Tables) error {
+	page := htmlTables{Heading: tables.Heading, Tables: tables.Tables}
+	if !tables.AsOf.IsZero() {
+		page.Banner = tables.banner()
+	}
+	return tablesTemplate.Execute(w, page)
+}
+
+// RenderTables writes each table as a Markdown table with the title heading the row names.
+func (m Markdown) RenderTables(w io.Writer, tables Tables) error {
+	sb := strings.Builder{}
+	sb.WriteString(fmt.Sprintf("## %s\n", escapeMarkdown(tables.Heading)))
+	if !tables.AsOf.IsZero() {
+		sb.WriteString(fmt.Sprintf("\n_%s_\n", tables.banner()))
+	}
+
+	for _, table := range tables.Tables {
+		header := []string{escapeMarkdown(table.Title)}
+		align := []string{":--"}
+		for _, column := range table.Columns {
+			header = append(header, escapeMarkdown(column))
+			align = append(align, "--:")
+		}
+		sb.WriteString("\n")
+		sb.WriteString(markdownRow(header))
+		sb.WriteString(markdownRow(align))
+		for _, row := range table.Rows {
+			cells := make([]string, len(header))
+			for i := range cells {
+				if i < len(row) {
+					cells[i] = escapeMarkdown(row[i])
+				}
+			}
+			if cells[0] != "" {
+				cells[0] = "**" + cells[0] + "**"
+			}
+			sb.WriteString(markdownRow(cells))
+		}
+	}
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// banner says when stale tables were current, in the timezone of the tables.
+func (t Tables) banner() string {
+	asOf := t.AsOf
+	if t.Location != nil {
+		asOf = asOf.In(t.Location)
+	}
+	return lastgood.Banner(asOf)
+}
+
+// tableBox draws a table with the title and row names on the left and the other columns right aligned.
+func tableBox(table Table) layout.Box {
+	widths := make([]int, len(table.Columns)+1)
+	widths[0] = len(table.Title)
+	for i, column := range table.Columns {
+		widths[i+1] = len(column)
+	}
+	for _, row := range table.Rows {
+		for i, cell := range row {
+			if i < len(widths) && len(cell) > widths[i] {
+				widths[i] = len(cell)
+			}
+		}
+	}
+
+	lines := make([]string, 0, len(table.Rows)+1)
+	lines = append(lines, tableLine(widths, append([]string{table.Title}, table.Columns...)))
+	for _, row := range table.Rows {
+		lines = append(lines, tableLine(widths, row))
+	}
+
+	// borders are drawn two characters at a time so lines are padded to an odd length to meet the closing star
+	lineLen := len(lines[0]) + 4
+	if lineLen%2 == 0 {
+		lineLen++
+	}
+	border := strings.Repeat("* ", lineLen/2) + "*"
+
+	box := layout.Box{border}
+	for _, line := range lines {
+		box = append(box, fmt.Sprintf("* %-"+strconv.Itoa(lineLen-4)+"s *", line))
+	}
+	return append(box, border)
+}
+
+func tableLine(widths []int, cells []string) string {
+	sb := strings.Builder{}
+	for i, width := range widths {
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		if i == 0 {
+			sb.WriteString(fmt.Sprintf("%-"+strconv.Itoa(width)+"s", cell))
+			continue
+		}
+		sb.WriteString(fmt.Sprintf("  %"+strconv.Itoa(width)+"s", cell))
+	}
+	return sb.String()
+}
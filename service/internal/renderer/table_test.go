@@ -0,0 +1,98 @@
+package renderer
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testTables() Tables {
+	columns := []string{"W", "L", "T", "PCT", "STRK"}
+	return Tables{
+		Heading: "2023 NFL Standings",
+		Tables: []Table{
+			{
+				Title:   "AFC East",
+				Columns: columns,
+				Rows: [][]string{
+					{"BUF", "11", "6", "0", ".647", "W5"},
+					{"MIA", "11", "6", "0", ".647", "L2"},
+				},
+			},
+			{
+				Title:   "AFC North",
+				Columns: columns,
+				Rows: [][]string{
+					{"BAL", "13", "4", "0", ".765", "L1"},
+				},
+			},
+		},
+	}
+}
+
+func TestTableRenderers(t *testing.T) {
+	testCases := map[string]struct {
+		renderer TableRenderer
+		empty    bool
+		stale    bool
+		golden   string
+	}{
+		"text":       {renderer: Text{Options: TextOptions{PerLine: 2}}, golden: "tables.txt"},
+		"empty text": {renderer: Text{}, empty: true, golden: "tables.empty.txt"},
+		"json":       {renderer: JSON{}, golden: "tables.json"},
+		"empty json": {renderer: JSON{}, empty: true, golden: "tables.empty.json"},
+		"html":       {renderer: HTML{}, golden: "tables.html"},
+		"empty html": {renderer: HTML{}, empty: true, golden: "tables.empty.html"},
+		"markdown":   {renderer: Markdown{}, golden: "tables.md"},
+		"empty md":   {renderer: Markdown{}, empty: true, golden: "tables.empty.md"},
+		"stale text": {renderer: Text{}, empty: true, stale: true, golden: "tables.stale.txt"},
+		"stale html": {renderer: HTML{}, empty: true, stale: true, golden: "tables.stale.html"},
+		"stale md":   {renderer: Markdown{}, empty: true, stale: true, golden: "tables.stale.md"},
+		"stale json": {renderer: JSON{}, empty: true, stale: true, golden: "tables.stale.json"},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tables := testTables()
+			if tc.empty {
+				tables = Tables{Heading: tables.Heading}
+			}
+			if tc.stale {
+				tables.AsOf = time.Date(2023, 9, 10, 13, 5, 0, 0, time.UTC)
+			}
+
+			buf := bytes.Buffer{}
+			require.NoError(t, tc.renderer.RenderTables(&buf, tables))
+
+			golden := filepath.Join("test-data", tc.golden)
+			if *update {
+				require.NoError(t, os.WriteFile(golden, buf.Bytes(), 0o644))
+			}
+			expected, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), buf.String())
+		})
+	}
+}
+
+func TestTables_BannerLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	tables := Tables{Heading: "2023 NFL Standings", AsOf: time.Date(2023, 9, 10, 13, 5, 0, 0, time.UTC), Location: newYork}
+
+	for name, r := range map[string]TableRenderer{"text": Text{}, "html": HTML{}, "markdown": Markdown{}} {
+		name := name
+		r := r
+		t.Run(name, func(t *testing.T) {
+			buf := bytes.Buffer{}
+			require.NoError(t, r.RenderTables(&buf, tables))
+			assert.Contains(t, buf.String(), "as of 09:05, updates delayed", "the banner should be shown in the viewer's timezone")
+		})
+	}
+}
@@ -0,0 +1,173 @@
+package renderer
+
+import (
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/rmarken5/mini-score/service/internal/lastgood"
+	"github.com/rmarken5/mini-score/service/internal/layout"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const headingTimeFormat = "Jan, 02 2006"
+
+type (
+	TextOptions struct {
+		// Width is the number of columns boxes are fit to. Zero means PerLine boxes are placed on a line.
+		Width   int
+		PerLine int
+		// Color draws the board with ANSI colors.
+		Color bool
+	}
+
+	// Text draws games as boxes of plain text for terminals and small screens.
+	Text struct {
+		Options TextOptions
+	}
+)
+
+func (t Text) ContentType() string {
+	return "text/plain; charset=UTF-8"
+}
+
+func (t Text) Render(w io.Writer, board Board) error {
+	sb := strings.Builder{}
+
+	sb.WriteString(fmt.Sprintf("%s\n", board.Date.Format(headingTimeFormat)))
+	if !board.AsOf.IsZero() {
+		sb.WriteString(lastgood.Banner(board.AsOf.In(board.Date.Location())) + "\n")
+	}
+
+	boxes := make([]layout.Box, 0, len(board.Games))
+	for _, game := range board.Games {
+		box := t.box(game)
+		if game.LastPlay != "" {
+			box = append(box, shorten(game.LastPlay, layout.Width(box[0])))
+		}
+		boxes = append(boxes, box)
+	}
+	l := layout.Layout{Width: t.Options.Width, PerLine: t.Options.PerLine, Gap: " "}
+	sb.WriteString(strings.Join(l.Render(boxes), "\n"))
+	for _, game := range board.Games {
+		if len(game.Details) > 0 {
+			sb.WriteString("\n" + textDetails(game.Details))
+		}
+	}
+
+	_, err := w.Write([]byte(sb.String()))
+	return err
+}
+
+// LineScore draws a game as the box it has on the board, without color, for places that only show plain text.
+func (t Text) LineScore(g Game) string {
+	t.Options.Color = false
+	return strings.Join(t.box(g), "\n")
+}
+
+// box draws a game as a box of stars with a line of period numbers, a line for each team and a status line
+// between them, like the boards in test-data/board.txt.
+// Borders are drawn two characters at a time so the closing star moves to line up with the border.
+func (t Text) box(g Game) layout.Box {
+	totals := len(g.TotalLabels)
+	if totals < 1 {
+		totals = 1
+	}
+	lineLen := 7 + 3*g.Periods + 3*totals
+	border := strings.Repeat("* ", lineLen/2) + "* *"
+	closing := fmt.Sprintf("%"+strconv.Itoa(len(border)-lineLen)+"s", "*")
+
+	header := fmt.Sprintf("* %-3s", g.PeriodLabel)
+	for i := 0; i < g.Periods; i++ {
+		header += fmt.Sprintf("%3d", i+1)
+	}
+	for i := 0; i < totals; i++ {
+		label := ""
+		if len(g.TotalLabels) > 1 {
+			label = g.TotalLabels[i]
+		}
+		header += fmt.Sprintf(totalFormat(i), label)
+	}
+	header += closing
+
+	away := t.teamLine(g, g.Away, totals) + closing
+	home := t.teamLine(g, g.Home, totals) + closing
+
+	detail := fmt.Sprintf("%-3s", g.Status.Detail)
+	status := "* " + detail + fmt.Sprintf("%"+strconv.Itoa(lineLen-2-len(detail))+"s", g.Status.Clock) + closing
+
+	if !t.Options.Color {
+		return layout.Box{border, header, away, status, home, border}
+	}
+
+	awayLeads, homeLeads := g.Leading()
+	switch g.Status.State {
+	case Final:
+		border, header, status = ansi.Dim(border), ansi.Dim(header), ansi.Dim(status)
+		if awayLeads {
+			away, home = ansi.Bold(away), ansi.Dim(home)
+		} else if homeLeads {
+			away, home = ansi.Dim(away), ansi.Bold(home)
+		}
+	case Live:
+		border, status = ansi.Highlight(border), ansi.Highlight(status)
+		if awayLeads {
+			away = ansi.Bold(away)
+		} else if homeLeads {
+			home = ansi.Bold(home)
+		}
+	}
+	return layout.Box{border, header, away, status, home, border}
+}
+
+func (t Text) teamLine(g Game, team Team, totals int) string {
+	name := fmt.Sprintf("%-3s", team.Name)
+	if t.Options.Color && team.Color != "" {
+		name = ansi.Hex(team.Color, team.Name) + strings.TrimPrefix(name, team.Name)
+	}
+
+	line := "* " + name
+	for i := 0; i < g.Periods; i++ {
+		line += fmt.Sprintf("%3s", team.PeriodScore(i))
+	}
+	for i := 0; i < totals; i++ {
+		line += fmt.Sprintf(totalFormat(i), team.Total(i))
+	}
+	return line
+}
+
+// totalFormat leaves a wider gap before the first total to set the totals apart from the periods.
+func totalFormat(i int) string {
+	if i == 0 {
+		return "%5s"
+	}
+	return "%3s"
+}
+
+// shorten cuts s to width runes, ending it with ... when it is cut.
+func shorten(s string, width int) string {
+	runes := []rune(s)
+	if len(runes) <= width {
+		return s
+	}
+	if width <= 3 {
+		return string(runes[:width])
+	}
+	return string(runes[:width-3]) + "..."
+}
+
+// textDetails lists details one to a line with the values lined up.
+func textDetails(details []Detail) string {
+	width := 0
+	for _, d := range details {
+		if len(d.Label) > width {
+			width = len(d.Label)
+		}
+	}
+
+	sb := strings.Builder{}
+	for _, d := range details {
+		sb.WriteString(fmt.Sprintf("\n%-"+strconv.Itoa(width)+"s  %s", d.Label, d.Value))
+	}
+	return sb.String()
+}
@@ -0,0 +1,49 @@
+package color
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"strings"
+)
+
+const colorParam = "color"
+
+type (
+	colorKey string
+)
+
+var (
+	colorContextKey colorKey = "color"
+)
+
+// HandleColor is a middleware function that decides if boards are drawn with ANSI colors and sets it on
+// request context.
+//
+// ?color=1 turns colors on and ?color=0 turns them off. Without it colors are used for curl, which is
+// almost always run in a terminal, and plain text is used for everything else.
+func HandleColor(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		enabled := isCurl(c.Request().UserAgent())
+		switch c.QueryParam(colorParam) {
+		case "1", "true", "on":
+			enabled = true
+		case "0", "false", "off":
+			enabled = false
+		}
+
+		ctx := context.WithValue(c.Request().Context(), colorContextKey, enabled)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+}
+
+// Enabled reports if ANSI colors were requested on ctx. Plain text is the default.
+func Enabled(ctx context.Context) bool {
+	enabled, ok := ctx.Value(colorContextKey).(bool)
+	return ok && enabled
+}
+
+func isCurl(userAgent string) bool {
+	return strings.HasPrefix(strings.ToLower(userAgent), "curl/")
+}
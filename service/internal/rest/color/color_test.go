@@ -0,0 +1,55 @@
+package color
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleColor(t *testing.T) {
+	testCases := map[string]struct {
+		target    string
+		userAgent string
+		expected  bool
+	}{
+		"should be plain for browsers": {
+			target:    "/nfl",
+			userAgent: "Mozilla/5.0 (X11; Linux x86_64)",
+		},
+		"should color curl": {
+			target:    "/nfl",
+			userAgent: "curl/8.4.0",
+			expected:  true,
+		},
+		"should color when requested": {
+			target:    "/nfl?color=1",
+			userAgent: "Wget/1.21",
+			expected:  true,
+		},
+		"should not color curl when turned off": {
+			target:    "/nfl?color=0",
+			userAgent: "curl/8.4.0",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			req.Header.Set("User-Agent", tc.userAgent)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var enabled bool
+			err := HandleColor(func(c echo.Context) error {
+				enabled = Enabled(c.Request().Context())
+				return nil
+			})(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, enabled)
+		})
+	}
+}
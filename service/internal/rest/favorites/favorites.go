@@ -0,0 +1,195 @@
+package favorites
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	NFL Sport = "nfl"
+	MLB Sport = "mlb"
+
+	HideCookie     = "favorites_hide"
+	cookiePrefix   = "favorites_"
+	cookieSep      = "."
+	teamsParam     = "teams"
+	cookieLifetime = 365 * 24 * time.Hour
+)
+
+type (
+	Sport string
+
+	favoritesKey string
+
+	// Selection describes which teams a board should show first and if other games are hidden.
+	Selection struct {
+		Teams      []string
+		HideOthers bool
+	}
+
+	// Team is a team that can be picked as a favorite.
+	Team struct {
+		Abbreviation string
+		Name         string
+	}
+
+	preferences struct {
+		filter    []string
+		hasFilter bool
+		favorites map[Sport][]string
+		hide      bool
+	}
+)
+
+var (
+	favoritesContextKey favoritesKey = "favorites"
+	Sports                           = []Sport{NFL, MLB}
+)
+
+// HandleFavorites is a middleware function that reads the teams query parameter and favorite team cookies and
+// sets them on request context.
+//
+// ?teams=KC,BUF shows only the listed teams. Without it, favorites from the cookie for the board's sport are
+// shown first and other games are hidden when the hide cookie is set.
+func HandleFavorites(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		prefs := preferences{}
+		prefs.favorites, prefs.hide = FavoritesFrom(c.Request())
+		if values, ok := c.QueryParams()[teamsParam]; ok {
+			prefs.hasFilter = true
+			prefs.filter = ParseTeams(strings.Join(values, ","), ",")
+		}
+
+		ctx := context.WithValue(c.Request().Context(), favoritesContextKey, prefs)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+}
+
+// SelectionFor returns the team selection for sport set on ctx by HandleFavorites.
+func SelectionFor(ctx context.Context, sport Sport) Selection {
+	prefs, ok := ctx.Value(favoritesContextKey).(preferences)
+	if !ok {
+		return Selection{}
+	}
+	if prefs.hasFilter {
+		return Selection{Teams: prefs.filter, HideOthers: len(prefs.filter) > 0}
+	}
+	return Selection{Teams: prefs.favorites[sport], HideOthers: prefs.hide && len(prefs.favorites[sport]) > 0}
+}
+
+// WithTeams returns a copy of ctx that shows only teams, as ?teams= does, for callers that do not take the
+// selection from the query.
+func WithTeams(ctx context.Context, teams []string) context.Context {
+	prefs, _ := ctx.Value(favoritesContextKey).(preferences)
+	prefs.hasFilter = true
+	prefs.filter = teams
+	return context.WithValue(ctx, favoritesContextKey, prefs)
+}
+
+// Cookies returns the cookies storing the favorites of each sport and the hide option.
+func Cookies(favorites map[Sport][]string, hide bool) []*http.Cookie {
+	expires := time.Now().Add(cookieLifetime)
+	cookies := make([]*http.Cookie, 0, len(Sports)+1)
+	for _, sport := range Sports {
+		cookies = append(cookies, newCookie(cookieName(sport), strings.Join(favorites[sport], cookieSep), expires))
+	}
+	hideValue := "0"
+	if hide {
+		hideValue = "1"
+	}
+	return append(cookies, newCookie(HideCookie, hideValue, expires))
+}
+
+// FavoritesFrom returns the favorites for each sport stored in the request cookies.
+func FavoritesFrom(r *http.Request) (map[Sport][]string, bool) {
+	favorites := make(map[Sport][]string)
+	for _, sport := range Sports {
+		if cookie, err := r.Cookie(cookieName(sport)); err == nil {
+			favorites[sport] = ParseTeams(cookie.Value, cookieSep)
+		}
+	}
+	hide := false
+	if cookie, err := r.Cookie(HideCookie); err == nil {
+		hide = cookie.Value == "1"
+	}
+	return favorites, hide
+}
+
+// ParseTeams splits value on sep into upper case team abbreviations.
+func ParseTeams(value, sep string) []string {
+	var teams []string
+	for _, team := range strings.Split(value, sep) {
+		team = strings.ToUpper(strings.TrimSpace(team))
+		if team != "" && isAbbreviation(team) {
+			teams = append(teams, team)
+		}
+	}
+	return teams
+}
+
+// Contains reports if team is one of the selected teams.
+func (s Selection) Contains(team string) bool {
+	for _, t := range s.Teams {
+		if strings.EqualFold(t, team) {
+			return true
+		}
+	}
+	return false
+}
+
+// Order returns the indexes of the n games to show, games with a selected team first.
+// teams returns the abbreviations of the teams playing in game i.
+func (s Selection) Order(n int, teams func(i int) []string) []int {
+	favorites := make([]int, 0, n)
+	others := make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		isFavorite := false
+		for _, team := range teams(i) {
+			if s.Contains(team) {
+				isFavorite = true
+				break
+			}
+		}
+		if isFavorite {
+			favorites = append(favorites, i)
+		} else {
+			others = append(others, i)
+		}
+	}
+	if s.HideOthers {
+		return favorites
+	}
+	return append(favorites, others...)
+}
+
+func cookieName(sport Sport) string {
+	return cookiePrefix + string(sport)
+}
+
+func newCookie(name, value string, expires time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Expires:  expires,
+		HttpOnly: true,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+func isAbbreviation(team string) bool {
+	if len(team) > 4 {
+		return false
+	}
+	for _, r := range team {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package favorites
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleFavorites(t *testing.T) {
+	testCases := map[string]struct {
+		target      string
+		cookies     []*http.Cookie
+		sport       Sport
+		expectedSel Selection
+	}{
+		"should not select teams without query or cookie": {
+			target:      "/nfl",
+			sport:       NFL,
+			expectedSel: Selection{},
+		},
+		"should filter to teams in query": {
+			target:      "/nfl?teams=kc,BUF",
+			sport:       NFL,
+			expectedSel: Selection{Teams: []string{"KC", "BUF"}, HideOthers: true},
+		},
+		"should prefer query over favorites": {
+			target:      "/mlb?teams=NYY",
+			cookies:     Cookies(map[Sport][]string{MLB: {"BOS"}}, false),
+			sport:       MLB,
+			expectedSel: Selection{Teams: []string{"NYY"}, HideOthers: true},
+		},
+		"should show favorites first for the board sport": {
+			target:      "/mlb",
+			cookies:     Cookies(map[Sport][]string{MLB: {"BOS", "NYY"}, NFL: {"KC"}}, false),
+			sport:       MLB,
+			expectedSel: Selection{Teams: []string{"BOS", "NYY"}},
+		},
+		"should hide other games when set": {
+			target:      "/nfl",
+			cookies:     Cookies(map[Sport][]string{NFL: {"KC"}}, true),
+			sport:       NFL,
+			expectedSel: Selection{Teams: []string{"KC"}, HideOthers: true},
+		},
+		"should not hide every game when there are no favorites": {
+			target:      "/nfl",
+			cookies:     Cookies(map[Sport][]string{MLB: {"NYY"}}, true),
+			sport:       NFL,
+			expectedSel: Selection{},
+		},
+		"should drop values that are not abbreviations": {
+			target:      "/nfl?teams=KC,<script>,",
+			sport:       NFL,
+			expectedSel: Selection{Teams: []string{"KC"}, HideOthers: true},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			for _, cookie := range tc.cookies {
+				req.AddCookie(cookie)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var sel Selection
+			err := HandleFavorites(func(c echo.Context) error {
+				sel = SelectionFor(c.Request().Context(), tc.sport)
+				return nil
+			})(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSel, sel)
+		})
+	}
+}
+
+func TestSelection_Order(t *testing.T) {
+	games := [][]string{{"NYY", "BOS"}, {"AZ", "WSH"}, {"TB", "KC"}}
+	teams := func(i int) []string { return games[i] }
+
+	testCases := map[string]struct {
+		sel      Selection
+		expected []int
+	}{
+		"should keep order without a selection": {
+			sel:      Selection{},
+			expected: []int{0, 1, 2},
+		},
+		"should put favorites first": {
+			sel:      Selection{Teams: []string{"KC", "WSH"}},
+			expected: []int{1, 2, 0},
+		},
+		"should hide other games": {
+			sel:      Selection{Teams: []string{"kc"}, HideOthers: true},
+			expected: []int{2},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.sel.Order(len(games), teams))
+		})
+	}
+}
+
+func TestWithTeams(t *testing.T) {
+	testCases := map[string]struct {
+		teams       []string
+		expectedSel Selection
+	}{
+		"should filter to teams": {
+			teams:       []string{"KC"},
+			expectedSel: Selection{Teams: []string{"KC"}, HideOthers: true},
+		},
+		"should show every game without teams": {
+			expectedSel: Selection{},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/nfl", nil)
+			req.AddCookie(Cookies(map[Sport][]string{NFL: {"BUF"}}, true)[0])
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var sel Selection
+			err := HandleFavorites(func(c echo.Context) error {
+				sel = SelectionFor(WithTeams(c.Request().Context(), tc.teams), NFL)
+				return nil
+			})(c)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedSel, sel)
+		})
+	}
+}
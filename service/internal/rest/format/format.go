@@ -0,0 +1,79 @@
+package format
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/color"
+	"github.com/rmarken5/mini-score/service/internal/rest/terminal"
+	"github.com/rmarken5/mini-score/service/internal/rest/user-agent"
+	"net/http"
+	"strconv"
+)
+
+const (
+	formatParam  = "format"
+	refreshParam = "refresh"
+	minRefresh   = 10
+	maxRefresh   = 3600
+)
+
+type (
+	formatKey string
+)
+
+var (
+	formatContextKey  formatKey = "format"
+	refreshContextKey formatKey = "refresh"
+)
+
+// HandleFormat is a middleware function that reads the board format from the format query parameter and the
+// seconds between page reloads from the refresh query parameter and sets them on request context. Text is the
+// default format.
+func HandleFormat(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		format := c.QueryParam(formatParam)
+		if _, err := renderer.New(format, renderer.Options{}); err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+
+		refresh := 0
+		if value := c.QueryParam(refreshParam); value != "" {
+			seconds, err := strconv.Atoi(value)
+			if err != nil || seconds < minRefresh || seconds > maxRefresh {
+				return echo.NewHTTPError(http.StatusBadRequest,
+					"refresh must be a number of seconds from "+strconv.Itoa(minRefresh)+" to "+strconv.Itoa(maxRefresh))
+			}
+			refresh = seconds
+		}
+
+		ctx := context.WithValue(c.Request().Context(), formatContextKey, format)
+		ctx = context.WithValue(ctx, refreshContextKey, refresh)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+}
+
+// Renderer returns the renderer for the format set on ctx. Text is fit to the terminal width and colored as
+// requested, falling back to one game per line on mobile and three otherwise.
+func Renderer(ctx context.Context) renderer.Renderer {
+	opts := renderer.Options{
+		Text: renderer.TextOptions{
+			Width:   terminal.Columns(ctx),
+			PerLine: 1,
+			Color:   color.Enabled(ctx),
+		},
+	}
+	if !user_agent.IsMobile(ctx) {
+		opts.Text.PerLine = 3
+	}
+	opts.HTML.Refresh, _ = ctx.Value(refreshContextKey).(int)
+
+	format, _ := ctx.Value(formatContextKey).(string)
+	r, err := renderer.New(format, opts)
+	if err != nil {
+		return renderer.Text{Options: opts.Text}
+	}
+	return r
+}
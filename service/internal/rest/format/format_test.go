@@ -0,0 +1,62 @@
+package format
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleFormat(t *testing.T) {
+	testCases := map[string]struct {
+		target    string
+		expected  renderer.Renderer
+		expectErr bool
+	}{
+		"should default to text": {
+			target:   "/nfl",
+			expected: renderer.Text{Options: renderer.TextOptions{PerLine: 1}},
+		},
+		"should use json": {
+			target:   "/nfl?format=json",
+			expected: renderer.JSON{},
+		},
+		"should refresh html": {
+			target:   "/nfl?format=html&refresh=30",
+			expected: renderer.HTML{Options: renderer.HTMLOptions{Refresh: 30}},
+		},
+		"should reject refresh out of range": {
+			target:    "/nfl?format=html&refresh=1",
+			expectErr: true,
+		},
+		"should reject unknown formats": {
+			target:    "/nfl?format=xml",
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var r renderer.Renderer
+			err := HandleFormat(func(c echo.Context) error {
+				r = Renderer(c.Request().Context())
+				return nil
+			})(c)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, r)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rs/zerolog"
+	"html/template"
+	"net/http"
+)
+
+type (
+	// DigestHandler manages digest subscriptions: a JSON admin API to subscribe and the page unsubscribe links
+	// point to.
+	DigestHandler struct {
+		logger   zerolog.Logger
+		registry nflfacade.DigestRegistry
+	}
+
+	unsubscribePage struct {
+		Token string
+		Done  bool
+	}
+)
+
+func NewDigestHandler(logger zerolog.Logger, registry nflfacade.DigestRegistry) *DigestHandler {
+	return &DigestHandler{
+		logger:   logger.With().Str("service", "DigestHandler").Logger(),
+		registry: registry,
+	}
+}
+
+// Subscribe subscribes the email in the request body to the digest of its teams.
+func (h *DigestHandler) Subscribe(c echo.Context) error {
+	var subscription nflfacade.DigestSubscription
+	if err := c.Bind(&subscription); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid digest subscription")
+	}
+
+	s, err := h.registry.SubscribeDigest(subscription)
+	if err != nil {
+		if errors.Is(err, nflfacade.ErrInvalidDigestSubscription) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+	return c.JSON(http.StatusCreated, s)
+}
+
+// ListSubscriptions lists the digest subscriptions.
+func (h *DigestHandler) ListSubscriptions(c echo.Context) error {
+	subscriptions, err := h.registry.GetDigestSubscriptions()
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, subscriptions)
+}
+
+// ShowUnsubscribe asks to confirm unsubscribing, so mail scanners following the link do not unsubscribe anyone.
+func (h *DigestHandler) ShowUnsubscribe(c echo.Context) error {
+	return h.renderUnsubscribe(c, unsubscribePage{Token: c.QueryParam("token")})
+}
+
+// Unsubscribe unsubscribes the subscription with the token query parameter. It is also posted to by mail clients
+// offering one click unsubscribing.
+func (h *DigestHandler) Unsubscribe(c echo.Context) error {
+	if err := h.registry.UnsubscribeDigest(c.QueryParam("token")); err != nil {
+		if errors.Is(err, nflfacade.ErrNoDigestSubscription) {
+			return echo.NewHTTPError(http.StatusNotFound, "no subscription for this link, it may already be unsubscribed")
+		}
+		return err
+	}
+	return h.renderUnsubscribe(c, unsubscribePage{Done: true})
+}
+
+func (h *DigestHandler) renderUnsubscribe(c echo.Context, page unsubscribePage) error {
+	file, err := template.ParseFS(files, "templates/unsubscribe.gotmpl")
+	if err != nil {
+		h.logger.Error().Err(err).Msg("while parsing unsubscribe template")
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
+	return file.Execute(c.Response(), page)
+}
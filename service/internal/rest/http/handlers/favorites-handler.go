@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"github.com/rmarken5/mini-score/service/internal/mlb/fetcher"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"html/template"
+	"net/http"
+	"sort"
+)
+
+const (
+	hideParam = "hide"
+	// csrfParam is the form field the favorites form sends its CSRF token in.
+	csrfParam = "csrf"
+)
+
+type (
+	FavoritesHandler struct {
+		logger      zerolog.Logger
+		teamFetcher fetcher.TeamFetcher
+		nflFacade   nflfacade.ScoreboardFacade
+	}
+
+	favoritesPage struct {
+		Sports []sportFavorites
+		Hide   bool
+		CSRF   string
+	}
+
+	sportFavorites struct {
+		Sport favorites.Sport
+		Teams []teamOption
+	}
+
+	teamOption struct {
+		favorites.Team
+		Checked bool
+	}
+)
+
+func NewFavoritesHandler(logger zerolog.Logger, teamFetcher fetcher.TeamFetcher, nflFacade nflfacade.ScoreboardFacade) *FavoritesHandler {
+	return &FavoritesHandler{
+		logger:      logger.With().Str("service", "FavoritesHandler").Logger(),
+		teamFetcher: teamFetcher,
+		nflFacade:   nflFacade,
+	}
+}
+
+// FavoritesCSRF guards the favorites form with a token that must match a cookie, so other sites can not post the
+// form on behalf of a visitor. Both the form and its post go through it.
+func FavoritesCSRF() echo.MiddlewareFunc {
+	return middleware.CSRFWithConfig(middleware.CSRFConfig{
+		TokenLookup:    "form:" + csrfParam,
+		CookiePath:     "/favorites",
+		CookieHTTPOnly: true,
+		CookieSameSite: http.SameSiteStrictMode,
+	})
+}
+
+// ShowFavorites renders a form to pick favorite teams for each sport, checked with the current favorites.
+func (h *FavoritesHandler) ShowFavorites(c echo.Context) error {
+	file, err := template.ParseFS(files, "templates/favorites.gotmpl")
+	if err != nil {
+		h.logger.Error().Err(err).Msg("while parsing favorites template")
+		return err
+	}
+
+	current, hide := favorites.FavoritesFrom(c.Request())
+	page := favoritesPage{Hide: hide}
+	page.CSRF, _ = c.Get(middleware.DefaultCSRFConfig.ContextKey).(string)
+	for _, sport := range favorites.Sports {
+		sel := favorites.Selection{Teams: current[sport]}
+		options := make([]teamOption, 0)
+		for _, t := range h.teams(sport) {
+			options = append(options, teamOption{Team: t, Checked: sel.Contains(t.Abbreviation)})
+		}
+		page.Sports = append(page.Sports, sportFavorites{Sport: sport, Teams: options})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, echo.MIMETextHTMLCharsetUTF8)
+	return file.Execute(c.Response(), page)
+}
+
+// SaveFavorites stores the teams picked on the favorites form in cookies.
+func (h *FavoritesHandler) SaveFavorites(c echo.Context) error {
+	form, err := c.FormParams()
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid favorites form")
+	}
+
+	picked := make(map[favorites.Sport][]string)
+	for _, sport := range favorites.Sports {
+		for _, value := range form[string(sport)] {
+			picked[sport] = append(picked[sport], favorites.ParseTeams(value, ",")...)
+		}
+	}
+
+	for _, cookie := range favorites.Cookies(picked, form.Get(hideParam) != "") {
+		c.SetCookie(cookie)
+	}
+	return c.Redirect(http.StatusSeeOther, "/favorites")
+}
+
+// teams returns the teams that can be picked for sport. Teams are left out when they can not be loaded so the
+// rest of the form is still usable.
+func (h *FavoritesHandler) teams(sport favorites.Sport) []favorites.Team {
+	logger := h.logger.With().Str("method", "teams").Str("sport", string(sport)).Logger()
+	teams := make([]favorites.Team, 0)
+
+	switch sport {
+	case favorites.MLB:
+		mlbTeams, err := h.teamFetcher.FetchTeams()
+		if err != nil {
+			logger.Error().Err(err).Msg("while fetching teams")
+			return teams
+		}
+		for _, t := range mlbTeams {
+			teams = append(teams, favorites.Team{Abbreviation: t.Abbreviation, Name: t.Name})
+		}
+	case favorites.NFL:
+		nflTeams, err := h.nflFacade.GetTeams()
+		if err != nil {
+			logger.Error().Err(err).Msg("while getting teams")
+			return teams
+		}
+		for _, t := range nflTeams {
+			teams = append(teams, favorites.Team{Abbreviation: t.Abbreviation, Name: t.Name})
+		}
+	}
+
+	sort.Slice(teams, func(i, j int) bool { return teams[i].Abbreviation < teams[j].Abbreviation })
+	return teams
+}
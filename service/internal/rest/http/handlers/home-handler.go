@@ -7,7 +7,7 @@ import (
 	"log"
 )
 
-//go:embed templates/index.gotmpl
+//go:embed templates/*.gotmpl
 var files embed.FS
 
 type (
@@ -1,11 +1,22 @@
 package handlers
 
 import (
+	"errors"
+	"fmt"
 	"github.com/labstack/echo/v4"
+	"github.com/rmarken5/mini-score/service/internal/calendar"
+	"github.com/rmarken5/mini-score/service/internal/feed"
 	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	mlbstandings "github.com/rmarken5/mini-score/service/internal/mlb/standings"
 	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
-	user_agent "github.com/rmarken5/mini-score/service/internal/rest/user-agent"
+	"github.com/rmarken5/mini-score/service/internal/nfl/logic/standings"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rest/format"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,19 +27,21 @@ type (
 	}
 )
 
-const layout = "2006-01-02"
+const (
+	layout = "2006-01-02"
+	// firstNFLSeason and firstMLBSeason are the first seasons standings can be asked for.
+	firstNFLSeason = 1920
+	firstMLBSeason = 1901
+	// calendarSuffix asks for a team page as a calendar.
+	calendarSuffix = ".ics"
+)
 
 func NewServer(mlbFacade mlbfacade.ScoreFacade, scoreboard nflfacade.ScoreboardFacade) *Server {
 	return &Server{mlbFacade: mlbFacade, nflFacade: scoreboard}
 }
 
 func (s *Server) PrintBaseballGames(c echo.Context) error {
-	date := c.Param("date")
-	if date == "" {
-		date = time.Now().Format(layout)
-	}
-
-	dateObj, err := time.Parse(layout, date)
+	dateObj, err := requestDate(c)
 	if err != nil {
 		return err
 	}
@@ -38,23 +51,39 @@ func (s *Server) PrintBaseballGames(c echo.Context) error {
 		return err
 	}
 
-	c.Set("Content-Type", "text/plain")
-	return c.String(http.StatusOK, scores)
+	return c.Blob(http.StatusOK, format.Renderer(c.Request().Context()).ContentType(), []byte(scores))
 
 }
 
-func (s *Server) PrintFootballGames(c echo.Context) error {
+// IsHistoricalCacheMiss reports if the request is for a past date whose scores must be fetched from statsapi.
+func (s *Server) IsHistoricalCacheMiss(c echo.Context) bool {
 	date := c.Param("date")
 	if date == "" {
-		date = time.Now().Format(layout)
+		return false
 	}
 
-	gamesPerLine := 1
-	if !user_agent.IsMobile(c.Request().Context()) {
-		gamesPerLine = 3
+	loc := timezone.Location(c.Request().Context())
+	dateObj, err := time.ParseInLocation(layout, date, loc)
+	if err != nil {
+		return false
 	}
 
-	dateObj, err := time.Parse(layout, date)
+	today := time.Now().In(loc).Format(layout)
+	return date < today && !s.mlbFacade.IsCached(dateObj)
+}
+
+// IsGameCacheMiss reports if the request is for a game whose score is not kept from a board or an earlier page, so it
+// is counted like a past date.
+func (s *Server) IsGameCacheMiss(c echo.Context) bool {
+	gamePk, err := strconv.Atoi(c.Param("gamePk"))
+	if err != nil {
+		return false
+	}
+	return !s.mlbFacade.IsGameCached(gamePk)
+}
+
+func (s *Server) PrintFootballGames(c echo.Context) error {
+	dateObj, err := requestDate(c)
 	if err != nil {
 		return err
 	}
@@ -63,11 +92,267 @@ func (s *Server) PrintFootballGames(c echo.Context) error {
 	if err != nil {
 		return err
 	}
+	scores = scores.Favorites(favorites.SelectionFor(c.Request().Context(), favorites.NFL))
+
+	r := format.Renderer(c.Request().Context())
+	c.Response().Header().Set(echo.HeaderContentType, r.ContentType())
+	return scores.Render(c.Response(), dateObj, r)
+}
+
+// PrintBaseballGame writes the page of the game in the gamePk path parameter.
+func (s *Server) PrintBaseballGame(c echo.Context) error {
+	gamePk, err := strconv.Atoi(c.Param("gamePk"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "gamePk must be a number")
+	}
+
+	page, err := mlbfacade.ProcessGame(s.mlbFacade, c.Request().Context(), gamePk)
+	if err != nil {
+		if errors.Is(err, mlbfacade.ErrNoGame) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	return c.Blob(http.StatusOK, format.Renderer(c.Request().Context()).ContentType(), []byte(page))
+}
+
+// PrintFootballGame writes the page of the game in the id path parameter.
+func (s *Server) PrintFootballGame(c echo.Context) error {
+	ctx := c.Request().Context()
+	game, err := s.nflFacade.GetGame(c.Param("id"), timezone.Location(ctx))
+	if err != nil {
+		if errors.Is(err, nflfacade.ErrNoGame) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	r := format.Renderer(ctx)
+	c.Response().Header().Set(echo.HeaderContentType, r.ContentType())
+	return game.Render(c.Response(), r)
+}
+
+// PrintBaseballStandings writes the division standings and wildcard races of the season in the season query
+// parameter, or of the current season.
+func (s *Server) PrintBaseballStandings(c echo.Context) error {
+	ctx := c.Request().Context()
+	season, err := requestSeason(c, firstMLBSeason, mlbstandings.Season(time.Now().In(timezone.Location(ctx))))
+	if err != nil {
+		return err
+	}
+
+	page, err := mlbfacade.ProcessStandings(s.mlbFacade, ctx, season)
+	if err != nil {
+		if errors.Is(err, mlbfacade.ErrNoTables) {
+			return echo.NewHTTPError(http.StatusNotAcceptable, err.Error())
+		}
+		return err
+	}
+
+	return c.Blob(http.StatusOK, format.Renderer(ctx).ContentType(), []byte(page))
+}
+
+// PrintFootballStandings writes the division standings of the season in the season query parameter, or of the
+// current season.
+func (s *Server) PrintFootballStandings(c echo.Context) error {
+	ctx := c.Request().Context()
+	season, err := requestSeason(c, firstNFLSeason, standings.Season(time.Now().In(timezone.Location(ctx))))
+	if err != nil {
+		return err
+	}
+
+	r, ok := format.Renderer(ctx).(renderer.TableRenderer)
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotAcceptable, "standings are not available in this format")
+	}
+
+	page, err := s.nflFacade.GetStandings(season)
+	if err != nil {
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, r.ContentType())
+	return page.Render(c.Response(), r)
+}
+
+// PrintBaseballTeam writes the schedule and results of the team in the abbr path parameter for the season in the
+// season query parameter, or the current season.
+func (s *Server) PrintBaseballTeam(c echo.Context) error {
+	if abbreviation, ok := strings.CutSuffix(c.Param("abbr"), calendarSuffix); ok {
+		return s.printBaseballCalendar(c, []string{strings.ToUpper(abbreviation)})
+	}
+
+	ctx := c.Request().Context()
+	season, err := requestSeason(c, firstMLBSeason, mlbstandings.Season(time.Now().In(timezone.Location(ctx))))
+	if err != nil {
+		return err
+	}
+
+	page, err := mlbfacade.ProcessTeamSchedule(s.mlbFacade, ctx, strings.ToUpper(c.Param("abbr")), season)
+	if err != nil {
+		switch {
+		case errors.Is(err, mlbfacade.ErrNoTeam):
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		case errors.Is(err, mlbfacade.ErrNoTables):
+			return echo.NewHTTPError(http.StatusNotAcceptable, err.Error())
+		}
+		return err
+	}
+
+	return c.Blob(http.StatusOK, format.Renderer(ctx).ContentType(), []byte(page))
+}
+
+// PrintFootballTeam writes the schedule and results of the team in the abbr path parameter for the season in the
+// season query parameter, or the current season.
+func (s *Server) PrintFootballTeam(c echo.Context) error {
+	if abbreviation, ok := strings.CutSuffix(c.Param("abbr"), calendarSuffix); ok {
+		return s.printFootballCalendar(c, []string{strings.ToUpper(abbreviation)})
+	}
 
-	c.Response().Header().Set("Content-Type", "text/plain")
-	err = scores.PrintScoreboard(c.Response(), dateObj, gamesPerLine)
+	ctx := c.Request().Context()
+	loc := timezone.Location(ctx)
+	season, err := requestSeason(c, firstNFLSeason, standings.Season(time.Now().In(loc)))
 	if err != nil {
 		return err
 	}
-	return nil
+
+	r, ok := format.Renderer(ctx).(renderer.TableRenderer)
+	if !ok {
+		return echo.NewHTTPError(http.StatusNotAcceptable, "schedules are not available in this format")
+	}
+
+	schedule, err := s.nflFacade.GetTeamSchedule(strings.ToUpper(c.Param("abbr")), season, loc)
+	if err != nil {
+		if errors.Is(err, nflfacade.ErrNoTeam) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, r.ContentType())
+	return schedule.Render(c.Response(), r)
+}
+
+// PrintBaseballCalendar writes the games of the teams in the teams query parameter as a calendar.
+func (s *Server) PrintBaseballCalendar(c echo.Context) error {
+	abbreviations, err := requestTeams(c)
+	if err != nil {
+		return err
+	}
+	return s.printBaseballCalendar(c, abbreviations)
+}
+
+// PrintFootballCalendar writes the games of the teams in the teams query parameter as a calendar.
+func (s *Server) PrintFootballCalendar(c echo.Context) error {
+	abbreviations, err := requestTeams(c)
+	if err != nil {
+		return err
+	}
+	return s.printFootballCalendar(c, abbreviations)
+}
+
+func (s *Server) printBaseballCalendar(c echo.Context, abbreviations []string) error {
+	season, err := requestSeason(c, firstMLBSeason, mlbstandings.Season(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	ics, err := mlbfacade.ProcessCalendar(s.mlbFacade, abbreviations, season)
+	if err != nil {
+		if errors.Is(err, mlbfacade.ErrNoTeam) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		if errors.Is(err, mlbfacade.ErrTooManyTeams) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+
+	return c.Blob(http.StatusOK, calendar.ContentType, []byte(ics))
+}
+
+func (s *Server) printFootballCalendar(c echo.Context, abbreviations []string) error {
+	season, err := requestSeason(c, firstNFLSeason, standings.Season(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	cal, err := s.nflFacade.GetCalendar(abbreviations, season)
+	if err != nil {
+		if errors.Is(err, nflfacade.ErrNoTeam) {
+			return echo.NewHTTPError(http.StatusNotFound, err.Error())
+		}
+		return err
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, calendar.ContentType)
+	return cal.Write(c.Response(), time.Now())
+}
+
+// PrintBaseballFeed writes the feed of the most recent MLB finals.
+func (s *Server) PrintBaseballFeed(c echo.Context) error {
+	atom, err := mlbfacade.ProcessFeed(s.mlbFacade, siteURL(c, "/mlb"), siteURL(c, c.Request().URL.Path))
+	if err != nil {
+		return err
+	}
+
+	return c.Blob(http.StatusOK, feed.ContentType, []byte(atom))
+}
+
+// PrintFootballFeed writes the feed of the most recent NFL finals.
+func (s *Server) PrintFootballFeed(c echo.Context) error {
+	f, err := s.nflFacade.GetFeed()
+	if err != nil {
+		return err
+	}
+	f.Link, f.Self = siteURL(c, "/nfl"), siteURL(c, c.Request().URL.Path)
+
+	c.Response().Header().Set(echo.HeaderContentType, feed.ContentType)
+	return f.Write(c.Response(), time.Now())
+}
+
+// siteURL returns the absolute URL of path on the host the request was made to, as feeds must link.
+func siteURL(c echo.Context, path string) string {
+	return c.Scheme() + "://" + c.Request().Host + path
+}
+
+// requestTeams returns the team abbreviations in the comma separated teams query parameter.
+func requestTeams(c echo.Context) ([]string, error) {
+	abbreviations := make([]string, 0)
+	for _, abbreviation := range strings.Split(c.QueryParam("teams"), ",") {
+		if abbreviation = strings.TrimSpace(abbreviation); abbreviation != "" {
+			abbreviations = append(abbreviations, strings.ToUpper(abbreviation))
+		}
+	}
+	if len(abbreviations) == 0 {
+		return nil, echo.NewHTTPError(http.StatusBadRequest, "teams must list team abbreviations separated by commas")
+	}
+	return abbreviations, nil
+}
+
+// requestSeason returns the season in the season query parameter, or current when there is none. Seasons before
+// first or after current are rejected.
+func requestSeason(c echo.Context, first, current int) (int, error) {
+	param := c.QueryParam("season")
+	if param == "" {
+		return current, nil
+	}
+
+	season, err := strconv.Atoi(param)
+	if err != nil || season < first || season > current {
+		return 0, echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("season must be a year from %d to %d", first, current))
+	}
+	return season, nil
+}
+
+// requestDate returns the date requested in the date path parameter, or today, in the request timezone.
+func requestDate(c echo.Context) (time.Time, error) {
+	loc := timezone.Location(c.Request().Context())
+	date := c.Param("date")
+	if date == "" {
+		date = time.Now().In(loc).Format(layout)
+	}
+
+	return time.ParseInLocation(layout, date, loc)
 }
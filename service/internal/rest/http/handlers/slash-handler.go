@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rest/format"
+	"github.com/rmarken5/mini-score/service/internal/rest/slash"
+	"github.com/rmarken5/mini-score/service/internal/rest/timezone"
+	"github.com/rs/zerolog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type (
+	// SlashHandler answers Slack and Mattermost style slash commands like /score nfl KC with the text board.
+	SlashHandler struct {
+		logger    zerolog.Logger
+		mlbFacade mlbfacade.ScoreFacade
+		nflFacade nflfacade.ScoreboardFacade
+	}
+)
+
+func NewSlashHandler(logger zerolog.Logger, mlbFacade mlbfacade.ScoreFacade, nflFacade nflfacade.ScoreboardFacade) *SlashHandler {
+	return &SlashHandler{
+		logger:    logger.With().Str("service", "SlashHandler").Logger(),
+		mlbFacade: mlbFacade,
+		nflFacade: nflFacade,
+	}
+}
+
+// HandleCommand replies to the command in the text form field. Chat clients show any status but 200 as a
+// failure, so commands that can not be answered are replied to with the reason, only to whoever typed them.
+func (h *SlashHandler) HandleCommand(c echo.Context) error {
+	logger := h.logger.With().Str("method", "HandleCommand").Logger()
+	ctx := c.Request().Context()
+
+	cmd, err := slash.Parse(c.FormValue("text"), time.Now(), timezone.Location(ctx))
+	if err != nil {
+		return c.JSON(http.StatusOK, slash.Ephemeral(err.Error()+"\n"+slash.Usage))
+	}
+	if len(cmd.Teams) > 0 {
+		ctx = favorites.WithTeams(ctx, cmd.Teams)
+	}
+
+	board, err := h.board(ctx, cmd)
+	if err != nil {
+		logger.Error().Err(err).Msgf("while getting %s board", cmd.Sport)
+		return c.JSON(http.StatusOK, slash.Ephemeral("Scores are not available right now, try again soon."))
+	}
+	return c.JSON(http.StatusOK, slash.InChannel(strings.TrimRight(board, "\n")))
+}
+
+// board draws the board for the command as the rest of the site would for ctx.
+func (h *SlashHandler) board(ctx context.Context, cmd slash.Command) (string, error) {
+	if cmd.Sport == favorites.MLB {
+		return mlbfacade.ProcessScores(h.mlbFacade, ctx, cmd.Date)
+	}
+
+	scores, err := h.nflFacade.GetScoreboardForDate(cmd.Date)
+	if err != nil {
+		return "", err
+	}
+	scores = scores.Favorites(favorites.SelectionFor(ctx, favorites.NFL))
+
+	sb := strings.Builder{}
+	if err := scores.Render(&sb, cmd.Date, format.Renderer(ctx)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
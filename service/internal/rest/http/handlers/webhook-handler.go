@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"errors"
+	"github.com/labstack/echo/v4"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rs/zerolog"
+	"net/http"
+)
+
+type (
+	// WebhookHandler is the JSON admin API for registering webhooks.
+	WebhookHandler struct {
+		logger   zerolog.Logger
+		registry nflfacade.WebhookRegistry
+	}
+)
+
+func NewWebhookHandler(logger zerolog.Logger, registry nflfacade.WebhookRegistry) *WebhookHandler {
+	return &WebhookHandler{
+		logger:   logger.With().Str("service", "WebhookHandler").Logger(),
+		registry: registry,
+	}
+}
+
+// RegisterWebhook registers the webhook in the request body. The response has the secret deliveries are signed
+// with, which is not shown again.
+func (h *WebhookHandler) RegisterWebhook(c echo.Context) error {
+	var registration nflfacade.WebhookRegistration
+	if err := c.Bind(&registration); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid webhook registration")
+	}
+
+	w, err := h.registry.RegisterWebhook(registration)
+	if err != nil {
+		if errors.Is(err, nflfacade.ErrInvalidWebhook) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return err
+	}
+	return c.JSON(http.StatusCreated, w)
+}
+
+// ListWebhooks lists the registered webhooks.
+func (h *WebhookHandler) ListWebhooks(c echo.Context) error {
+	webhooks, err := h.registry.GetWebhooks()
+	if err != nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, webhooks)
+}
+
+// DeleteWebhook unregisters the webhook with the id in the path.
+func (h *WebhookHandler) DeleteWebhook(c echo.Context) error {
+	if err := h.registry.DeleteWebhook(c.Param("id")); err != nil {
+		if errors.Is(err, nflfacade.ErrNoWebhook) {
+			return echo.NewHTTPError(http.StatusNotFound, "no webhook with that id")
+		}
+		return err
+	}
+	return c.NoContent(http.StatusNoContent)
+}
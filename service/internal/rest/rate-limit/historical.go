@@ -0,0 +1,91 @@
+package rate_limit
+
+import (
+	"context"
+	"errors"
+	"github.com/labstack/echo/v4"
+	"golang.org/x/time/rate"
+	"sync"
+	"time"
+)
+
+// ErrTooManyDates is returned when a client has used its budget of past dates that are not cached.
+var ErrTooManyDates = errors.New("too many past dates asked for, try again later")
+
+type (
+	clientKey string
+
+	// Historical limits each client to a Budget of past dates that are not already cached, counting every date
+	// loaded. It is shared by the routes and protocols that load past dates so a client has one budget across
+	// them.
+	Historical struct {
+		budget   Budget
+		now      func() time.Time
+		lock     sync.Mutex
+		visitors map[string]*visitor
+		cleaned  time.Time
+	}
+
+	visitor struct {
+		limiter *rate.Limiter
+		seen    time.Time
+	}
+)
+
+var (
+	clientContextKey clientKey = "client"
+)
+
+func NewHistorical(budget Budget) *Historical {
+	return &Historical{budget: budget, now: time.Now, visitors: make(map[string]*visitor)}
+}
+
+// Allow reports if client may load dates more past dates that are not cached, taking them from its budget when
+// it may.
+func (h *Historical) Allow(client string, dates int) bool {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	now := h.now()
+	if now.Sub(h.cleaned) >= visitorExpiry {
+		for c, v := range h.visitors {
+			if now.Sub(v.seen) >= visitorExpiry {
+				delete(h.visitors, c)
+			}
+		}
+		h.cleaned = now
+	}
+
+	v, ok := h.visitors[client]
+	if !ok {
+		v = &visitor{limiter: rate.NewLimiter(rate.Limit(h.budget.Rate), h.budget.Burst)}
+		h.visitors[client] = v
+	}
+	v.seen = now
+	return v.limiter.AllowN(now, dates)
+}
+
+// RetryAfter is the time for a client that has used its budget to be allowed another date.
+func (h *Historical) RetryAfter() time.Duration {
+	return retryAfter(h.budget)
+}
+
+// HandleClient is a middleware function that sets the client IP on request context, so past dates loaded below
+// the handlers are counted against the client.
+func HandleClient(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		c.SetRequest(c.Request().WithContext(WithClient(c.Request().Context(), c.RealIP())))
+		return next(c)
+	}
+}
+
+// WithClient returns a copy of ctx whose past dates are counted against client.
+func WithClient(ctx context.Context, client string) context.Context {
+	return context.WithValue(ctx, clientContextKey, client)
+}
+
+// Client returns the client set on ctx, or an empty string when there is none.
+func Client(ctx context.Context) string {
+	client, _ := ctx.Value(clientContextKey).(string)
+	return client
+}
@@ -0,0 +1,172 @@
+package rate_limit
+
+import (
+	"fmt"
+	"github.com/labstack/echo/v4"
+	"github.com/labstack/echo/v4/middleware"
+	"golang.org/x/time/rate"
+	"math"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderXForwardedFor = "X-Forwarded-For"
+	HeaderXRealIP       = "X-Real-IP"
+
+	visitorExpiry = 10 * time.Minute
+)
+
+type (
+	// Budget is a token bucket refilled at Rate tokens per second holding at most Burst tokens.
+	Budget struct {
+		Rate  float64
+		Burst int
+	}
+
+	Config struct {
+		// Default applies to every request.
+		Default Budget
+		// Historical additionally applies to requests for past dates that are not already cached.
+		Historical Budget
+		// TrustedProxyHeader is the header holding the client IP when running behind a proxy.
+		// Either HeaderXForwardedFor or HeaderXRealIP. Empty means the connection address is used.
+		TrustedProxyHeader string
+		// TrustedProxyRanges are CIDR ranges of proxies allowed to set TrustedProxyHeader, in addition to
+		// loopback and private ranges.
+		TrustedProxyRanges []string
+	}
+)
+
+// IPExtractor returns the echo.IPExtractor used to identify clients according to cfg.
+func IPExtractor(cfg Config) (echo.IPExtractor, error) {
+	ranges := make([]*net.IPNet, 0, len(cfg.TrustedProxyRanges))
+	options := make([]echo.TrustOption, 0, len(cfg.TrustedProxyRanges))
+	for _, cidr := range cfg.TrustedProxyRanges {
+		_, ipRange, err := net.ParseCIDR(strings.TrimSpace(cidr))
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q: %w", cidr, err)
+		}
+		ranges = append(ranges, ipRange)
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+
+	switch http.CanonicalHeaderKey(cfg.TrustedProxyHeader) {
+	case "":
+		return echo.ExtractIPDirect(), nil
+	case http.CanonicalHeaderKey(HeaderXForwardedFor):
+		return echo.ExtractIPFromXFFHeader(options...), nil
+	case http.CanonicalHeaderKey(HeaderXRealIP):
+		return extractIPFromRealIPHeader(ranges), nil
+	default:
+		return nil, fmt.Errorf("unsupported trusted proxy header %q", cfg.TrustedProxyHeader)
+	}
+}
+
+// extractIPFromRealIPHeader uses X-Real-IP only when the connection comes from a trusted proxy.
+// echo.ExtractIPFromRealIPHeader checks the header value rather than the proxy so is not used.
+func extractIPFromRealIPHeader(ranges []*net.IPNet) echo.IPExtractor {
+	direct := echo.ExtractIPDirect()
+	return func(req *http.Request) string {
+		remote := direct(req)
+		realIP := strings.Trim(req.Header.Get(HeaderXRealIP), "[]")
+		if realIP == "" || net.ParseIP(realIP) == nil || !isTrustedProxy(net.ParseIP(remote), ranges) {
+			return remote
+		}
+		return realIP
+	}
+}
+
+func isTrustedProxy(ip net.IP, ranges []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() {
+		return true
+	}
+	for _, r := range ranges {
+		if r.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// Limit is a middleware function that limits every client to budget.
+func Limit(budget Budget) echo.MiddlewareFunc {
+	return limit(budget, middleware.DefaultSkipper)
+}
+
+// LimitHistorical is a middleware function that takes a date from the budget of historical for requests where
+// isCacheMiss is true. It is meant for routes where a past date fans out to upstream requests that can not be served
+// from cache.
+func LimitHistorical(historical *Historical, isCacheMiss func(c echo.Context) bool) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isCacheMiss(c) && !historical.Allow(c.RealIP(), 1) {
+				return TooManyRequests(c, historical.RetryAfter())
+			}
+			return next(c)
+		}
+	}
+}
+
+func limit(budget Budget, skipper middleware.Skipper) echo.MiddlewareFunc {
+	store := middleware.NewRateLimiterMemoryStoreWithConfig(middleware.RateLimiterMemoryStoreConfig{
+		Rate:      rate.Limit(budget.Rate),
+		Burst:     budget.Burst,
+		ExpiresIn: visitorExpiry,
+	})
+	return middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
+		Skipper: skipper,
+		Store:   store,
+		IdentifierExtractor: func(c echo.Context) (string, error) {
+			return c.RealIP(), nil
+		},
+		DenyHandler: func(c echo.Context, identifier string, err error) error {
+			return TooManyRequests(c, retryAfter(budget))
+		},
+	})
+}
+
+// TooManyRequests writes a 429 response as a plain text box in the style of the scoreboards.
+func TooManyRequests(c echo.Context, retryIn time.Duration) error {
+	seconds := int(math.Ceil(retryIn.Seconds()))
+	c.Response().Header().Set("Retry-After", fmt.Sprintf("%d", seconds))
+	return c.String(http.StatusTooManyRequests, textBox(
+		"Too many requests",
+		fmt.Sprintf("try again in %ds", seconds),
+	))
+}
+
+// retryAfter is the time for a single token to be added to an empty bucket.
+func retryAfter(budget Budget) time.Duration {
+	if budget.Rate <= 0 {
+		return time.Minute
+	}
+	return time.Duration(float64(time.Second) / budget.Rate)
+}
+
+func textBox(lines ...string) string {
+	width := 0
+	for _, line := range lines {
+		if len(line) > width {
+			width = len(line)
+		}
+	}
+	// borders are drawn two characters at a time so keep the inner width odd for the corners to line up
+	if width%2 == 0 {
+		width++
+	}
+
+	border := strings.TrimSpace(strings.Repeat("* ", (width+5)/2))
+	sb := strings.Builder{}
+	sb.WriteString(border + "\n")
+	for _, line := range lines {
+		sb.WriteString(fmt.Sprintf("* %-"+fmt.Sprintf("%d", width)+"s *\n", line))
+	}
+	sb.WriteString(border + "\n")
+	return sb.String()
+}
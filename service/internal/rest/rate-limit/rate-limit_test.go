@@ -0,0 +1,181 @@
+package rate_limit
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T, cfg Config, isCacheMiss func(c echo.Context) bool) *echo.Echo {
+	ipExtractor, err := IPExtractor(cfg)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.IPExtractor = ipExtractor
+	e.Use(Limit(cfg.Default))
+	e.GET("/mlb/:date", func(c echo.Context) error {
+		return c.String(http.StatusOK, "board")
+	}, LimitHistorical(NewHistorical(cfg.Historical), isCacheMiss))
+	return e
+}
+
+func doRequest(e *echo.Echo, remoteAddr string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodGet, "/mlb/2023-06-22", nil)
+	req.RemoteAddr = remoteAddr
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestLimit(t *testing.T) {
+	cfg := Config{
+		Default:    Budget{Rate: 0.5, Burst: 2},
+		Historical: Budget{Rate: 0.5, Burst: 100},
+	}
+	e := newTestServer(t, cfg, func(c echo.Context) bool { return false })
+
+	assert.Equal(t, http.StatusOK, doRequest(e, "203.0.113.1:1234", nil).Code)
+	assert.Equal(t, http.StatusOK, doRequest(e, "203.0.113.1:1234", nil).Code)
+
+	rec := doRequest(e, "203.0.113.1:1234", nil)
+	assert.Equal(t, http.StatusTooManyRequests, rec.Code)
+	assert.Equal(t, "2", rec.Header().Get("Retry-After"))
+	assert.Equal(t, `* * * * * * * * * * *
+* Too many requests *
+* try again in 2s   *
+* * * * * * * * * * *
+`, rec.Body.String())
+
+	assert.Equal(t, http.StatusOK, doRequest(e, "203.0.113.2:1234", nil).Code, "other clients should have their own budget")
+}
+
+func TestLimitHistorical(t *testing.T) {
+	testCases := map[string]struct {
+		isCacheMiss    bool
+		expectedStatus int
+	}{
+		"should limit historical requests that miss the cache": {
+			isCacheMiss:    true,
+			expectedStatus: http.StatusTooManyRequests,
+		},
+		"should not limit historical requests served from cache": {
+			isCacheMiss:    false,
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cfg := Config{
+				Default:    Budget{Rate: 10, Burst: 100},
+				Historical: Budget{Rate: 0.1, Burst: 1},
+			}
+			e := newTestServer(t, cfg, func(c echo.Context) bool { return tc.isCacheMiss })
+
+			assert.Equal(t, http.StatusOK, doRequest(e, "203.0.113.1:1234", nil).Code)
+			assert.Equal(t, tc.expectedStatus, doRequest(e, "203.0.113.1:1234", nil).Code)
+		})
+	}
+}
+
+func TestHistorical_Allow(t *testing.T) {
+	now := time.Date(2023, 6, 22, 12, 0, 0, 0, time.UTC)
+	h := NewHistorical(Budget{Rate: 1, Burst: 5})
+	h.now = func() time.Time { return now }
+
+	assert.True(t, h.Allow("203.0.113.1", 3))
+	assert.False(t, h.Allow("203.0.113.1", 3), "every date should be counted")
+	assert.True(t, h.Allow("203.0.113.1", 2))
+	assert.True(t, h.Allow("203.0.113.2", 5), "other clients should have their own budget")
+
+	now = now.Add(2 * time.Second)
+	assert.True(t, h.Allow("203.0.113.1", 2))
+	assert.False(t, h.Allow("203.0.113.1", 1))
+
+	now = now.Add(visitorExpiry)
+	assert.True(t, h.Allow("203.0.113.3", 1))
+	assert.Len(t, h.visitors, 1, "clients not seen since the expiry should be forgotten")
+}
+
+func TestIPExtractor(t *testing.T) {
+	testCases := map[string]struct {
+		cfg        Config
+		remoteAddr string
+		headers    map[string]string
+		expectedIP string
+		expectErr  bool
+	}{
+		"should use the connection address without a trusted header": {
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string]string{HeaderXForwardedFor: "198.51.100.7"},
+			expectedIP: "10.0.0.1",
+		},
+		"should use X-Forwarded-For from a private proxy": {
+			cfg:        Config{TrustedProxyHeader: HeaderXForwardedFor},
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string]string{HeaderXForwardedFor: "198.51.100.7"},
+			expectedIP: "198.51.100.7",
+		},
+		"should ignore X-Forwarded-For from an untrusted proxy": {
+			cfg:        Config{TrustedProxyHeader: HeaderXForwardedFor},
+			remoteAddr: "203.0.113.9:1234",
+			headers:    map[string]string{HeaderXForwardedFor: "198.51.100.7"},
+			expectedIP: "203.0.113.9",
+		},
+		"should use X-Forwarded-For from a configured proxy range": {
+			cfg:        Config{TrustedProxyHeader: HeaderXForwardedFor, TrustedProxyRanges: []string{"203.0.113.0/24"}},
+			remoteAddr: "203.0.113.9:1234",
+			headers:    map[string]string{HeaderXForwardedFor: "198.51.100.7"},
+			expectedIP: "198.51.100.7",
+		},
+		"should use X-Real-IP when configured": {
+			cfg:        Config{TrustedProxyHeader: "x-real-ip"},
+			remoteAddr: "10.0.0.1:1234",
+			headers:    map[string]string{HeaderXRealIP: "198.51.100.8"},
+			expectedIP: "198.51.100.8",
+		},
+		"should ignore X-Real-IP from an untrusted proxy": {
+			cfg:        Config{TrustedProxyHeader: HeaderXRealIP},
+			remoteAddr: "203.0.113.9:1234",
+			headers:    map[string]string{HeaderXRealIP: "198.51.100.8"},
+			expectedIP: "203.0.113.9",
+		},
+		"should reject unknown headers": {
+			cfg:       Config{TrustedProxyHeader: "Forwarded"},
+			expectErr: true,
+		},
+		"should reject invalid ranges": {
+			cfg:       Config{TrustedProxyHeader: HeaderXForwardedFor, TrustedProxyRanges: []string{"not-a-range"}},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			extractor, err := IPExtractor(tc.cfg)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			assert.Equal(t, tc.expectedIP, extractor(req))
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package slash
+
+import (
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"strings"
+	"time"
+)
+
+const dateLayout = "2006-01-02"
+
+// Usage is the reply to a command that can not be parsed.
+const Usage = "Usage: /score <nfl|mlb> [today|yesterday|tomorrow|YYYY-MM-DD] [TEAM ...]\n" +
+	"e.g. /score nfl KC or /score mlb yesterday NYY BOS"
+
+// ErrUsage is returned for command text that is not a score command.
+var ErrUsage = errors.New("not a score command")
+
+type (
+	// Command asks for the board of a sport on a date, only showing Teams when there are any.
+	Command struct {
+		Sport favorites.Sport
+		Date  time.Time
+		Teams []string
+	}
+)
+
+// Parse reads the text of a command, like "nfl KC" or "mlb today". The date defaults to today in loc and the
+// remaining words are team abbreviations.
+func Parse(text string, now time.Time, loc *time.Location) (Command, error) {
+	words := strings.Fields(text)
+	if len(words) == 0 {
+		return Command{}, ErrUsage
+	}
+
+	cmd := Command{Sport: favorites.Sport(strings.ToLower(words[0]))}
+	if cmd.Sport != favorites.NFL && cmd.Sport != favorites.MLB {
+		return Command{}, fmt.Errorf("%w: unknown sport %q", ErrUsage, words[0])
+	}
+
+	today := now.In(loc)
+	cmd.Date = time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, loc)
+	dated := false
+	for _, word := range words[1:] {
+		if date, ok := parseDate(word, cmd.Date, loc); ok && !dated {
+			cmd.Date, dated = date, true
+			continue
+		}
+		teams := favorites.ParseTeams(word, ",")
+		if len(teams) == 0 {
+			return Command{}, fmt.Errorf("%w: unknown team %q", ErrUsage, word)
+		}
+		cmd.Teams = append(cmd.Teams, teams...)
+	}
+	return cmd, nil
+}
+
+// parseDate reads a date word relative to today.
+func parseDate(word string, today time.Time, loc *time.Location) (time.Time, bool) {
+	switch strings.ToLower(word) {
+	case "today":
+		return today, true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	}
+	date, err := time.ParseInLocation(dateLayout, word, loc)
+	return date, err == nil
+}
@@ -0,0 +1,78 @@
+package slash
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+	// 01:30 UTC is still the evening before in New York.
+	now := time.Date(2023, 9, 12, 1, 30, 0, 0, time.UTC)
+	today := time.Date(2023, 9, 11, 0, 0, 0, 0, loc)
+
+	testCases := map[string]struct {
+		text            string
+		expectedCommand Command
+		expectedErr     error
+	}{
+		"should default to today": {
+			text:            "nfl",
+			expectedCommand: Command{Sport: favorites.NFL, Date: today},
+		},
+		"should read teams": {
+			text:            "NFL kc buf",
+			expectedCommand: Command{Sport: favorites.NFL, Date: today, Teams: []string{"KC", "BUF"}},
+		},
+		"should read comma separated teams": {
+			text:            "mlb NYY,BOS",
+			expectedCommand: Command{Sport: favorites.MLB, Date: today, Teams: []string{"NYY", "BOS"}},
+		},
+		"should read today": {
+			text:            "mlb today",
+			expectedCommand: Command{Sport: favorites.MLB, Date: today},
+		},
+		"should read yesterday and teams": {
+			text:            "mlb yesterday NYY",
+			expectedCommand: Command{Sport: favorites.MLB, Date: today.AddDate(0, 0, -1), Teams: []string{"NYY"}},
+		},
+		"should read tomorrow after teams": {
+			text:            "nfl KC tomorrow",
+			expectedCommand: Command{Sport: favorites.NFL, Date: today.AddDate(0, 0, 1), Teams: []string{"KC"}},
+		},
+		"should read date": {
+			text:            "nfl 2023-09-07",
+			expectedCommand: Command{Sport: favorites.NFL, Date: time.Date(2023, 9, 7, 0, 0, 0, 0, loc)},
+		},
+		"should reject empty text": {
+			text:        " ",
+			expectedErr: ErrUsage,
+		},
+		"should reject unknown sport": {
+			text:        "nhl",
+			expectedErr: ErrUsage,
+		},
+		"should reject word that is not a team": {
+			text:        "nfl chiefs",
+			expectedErr: ErrUsage,
+		},
+		"should reject second date": {
+			text:        "nfl today tomorrow",
+			expectedErr: ErrUsage,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			cmd, err := Parse(tc.text, now, loc)
+
+			assert.ErrorIs(t, err, tc.expectedErr)
+			assert.Equal(t, tc.expectedCommand, cmd)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package slash
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"github.com/labstack/echo/v4"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const (
+	// HeaderSlackSignature and HeaderSlackTimestamp sign Slack requests.
+	HeaderSlackSignature = "X-Slack-Signature"
+	HeaderSlackTimestamp = "X-Slack-Request-Timestamp"
+
+	// ResponseInChannel shows the reply to everyone in the channel, ResponseEphemeral only to whoever typed
+	// the command.
+	ResponseInChannel = "in_channel"
+	ResponseEphemeral = "ephemeral"
+
+	slackVersion = "v0"
+	tokenField   = "token"
+	tolerance    = 5 * time.Minute
+	maxBody      = 16 << 10
+)
+
+type (
+	// Config holds the secrets commands are checked with. Slack requests are signed with SigningSecret and
+	// Mattermost requests carry Token. A request passes when it satisfies either one that is set.
+	Config struct {
+		SigningSecret string
+		Token         string
+	}
+
+	// Response is the reply to a command, understood by both Slack and Mattermost.
+	Response struct {
+		ResponseType string `json:"response_type"`
+		Text         string `json:"text"`
+	}
+)
+
+// Verify is a middleware function that rejects commands that are not signed with the Slack signing secret or do
+// not carry the Mattermost token of cfg.
+func Verify(cfg Config) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			body, err := io.ReadAll(io.LimitReader(req.Body, maxBody))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "unreadable command")
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			if !verified(cfg, req, body, time.Now()) {
+				return echo.NewHTTPError(http.StatusUnauthorized, "command is not signed")
+			}
+			return next(c)
+		}
+	}
+}
+
+// InChannel returns the reply that shows text to the channel in a code block, keeping a board lined up.
+func InChannel(text string) Response {
+	return Response{ResponseType: ResponseInChannel, Text: "```\n" + text + "\n```"}
+}
+
+// Ephemeral returns the reply that shows text only to whoever typed the command.
+func Ephemeral(text string) Response {
+	return Response{ResponseType: ResponseEphemeral, Text: text}
+}
+
+// Sign returns the Slack signature of a request made at timestamp with body.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(slackVersion + ":" + strconv.FormatInt(timestamp.Unix(), 10) + ":"))
+	mac.Write(body)
+	return slackVersion + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func verified(cfg Config, req *http.Request, body []byte, now time.Time) bool {
+	if cfg.SigningSecret != "" && slackSigned(cfg.SigningSecret, req.Header, body, now) {
+		return true
+	}
+	if cfg.Token != "" {
+		form, err := url.ParseQuery(string(body))
+		return err == nil && subtle.ConstantTimeCompare([]byte(form.Get(tokenField)), []byte(cfg.Token)) == 1
+	}
+	return false
+}
+
+func slackSigned(secret string, header http.Header, body []byte, now time.Time) bool {
+	seconds, err := strconv.ParseInt(header.Get(HeaderSlackTimestamp), 10, 64)
+	if err != nil {
+		return false
+	}
+	timestamp := time.Unix(seconds, 0)
+	if age := now.Sub(timestamp); age > tolerance || age < -tolerance {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(header.Get(HeaderSlackSignature)))
+}
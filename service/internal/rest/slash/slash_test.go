@@ -0,0 +1,115 @@
+package slash
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	const body = "token=mm-token&command=%2Fscore&text=nfl+KC"
+	now := time.Now()
+	cfg := Config{SigningSecret: "slack-secret", Token: "mm-token"}
+
+	testCases := map[string]struct {
+		cfg          Config
+		body         string
+		header       map[string]string
+		expectedCode int
+	}{
+		"should pass request signed for slack": {
+			cfg:  Config{SigningSecret: "slack-secret"},
+			body: "text=nfl+KC",
+			header: map[string]string{
+				HeaderSlackTimestamp: strconv.FormatInt(now.Unix(), 10),
+				HeaderSlackSignature: Sign("slack-secret", now, []byte("text=nfl+KC")),
+			},
+			expectedCode: http.StatusOK,
+		},
+		"should reject request signed with another secret": {
+			cfg:  Config{SigningSecret: "slack-secret"},
+			body: "text=nfl+KC",
+			header: map[string]string{
+				HeaderSlackTimestamp: strconv.FormatInt(now.Unix(), 10),
+				HeaderSlackSignature: Sign("other", now, []byte("text=nfl+KC")),
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		"should reject old signed request": {
+			cfg:  Config{SigningSecret: "slack-secret"},
+			body: "text=nfl+KC",
+			header: map[string]string{
+				HeaderSlackTimestamp: strconv.FormatInt(now.Add(-10*time.Minute).Unix(), 10),
+				HeaderSlackSignature: Sign("slack-secret", now.Add(-10*time.Minute), []byte("text=nfl+KC")),
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		"should reject signed request with changed body": {
+			cfg:  Config{SigningSecret: "slack-secret"},
+			body: "text=mlb",
+			header: map[string]string{
+				HeaderSlackTimestamp: strconv.FormatInt(now.Unix(), 10),
+				HeaderSlackSignature: Sign("slack-secret", now, []byte("text=nfl+KC")),
+			},
+			expectedCode: http.StatusUnauthorized,
+		},
+		"should pass request with mattermost token": {
+			cfg:          cfg,
+			body:         body,
+			expectedCode: http.StatusOK,
+		},
+		"should reject request with wrong token": {
+			cfg:          cfg,
+			body:         "token=guess&text=nfl",
+			expectedCode: http.StatusUnauthorized,
+		},
+		"should reject token when only slack is configured": {
+			cfg:          Config{SigningSecret: "slack-secret"},
+			body:         body,
+			expectedCode: http.StatusUnauthorized,
+		},
+		"should reject everything when nothing is configured": {
+			body:         "token=&text=nfl",
+			expectedCode: http.StatusUnauthorized,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/slash/score", strings.NewReader(tc.body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			var read string
+			err := Verify(tc.cfg)(func(c echo.Context) error {
+				b, err := io.ReadAll(c.Request().Body)
+				read = string(b)
+				return err
+			})(c)
+
+			if tc.expectedCode == http.StatusOK {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.body, read)
+				return
+			}
+			var httpErr *echo.HTTPError
+			assert.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, tc.expectedCode, httpErr.Code)
+		})
+	}
+}
+
+func TestInChannel(t *testing.T) {
+	assert.Equal(t, Response{ResponseType: ResponseInChannel, Text: "```\nKC 17\n```"}, InChannel("KC 17"))
+}
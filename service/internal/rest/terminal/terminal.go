@@ -0,0 +1,57 @@
+package terminal
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// HeaderColumns lets curl users send their terminal width, e.g. curl -H "Columns: $COLUMNS".
+	HeaderColumns = "Columns"
+
+	colsParam  = "cols"
+	minColumns = 20
+	maxColumns = 1000
+)
+
+type (
+	columnsKey string
+)
+
+var (
+	columnsContextKey columnsKey = "columns"
+)
+
+// HandleColumns is a middleware function that reads the terminal width from the cols query parameter or the
+// Columns header and sets it on request context.
+func HandleColumns(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		columns := 0
+		if value := c.QueryParam(colsParam); value != "" {
+			cols, err := strconv.Atoi(value)
+			if err != nil || cols < minColumns || cols > maxColumns {
+				return echo.NewHTTPError(http.StatusBadRequest,
+					"cols must be a number from "+strconv.Itoa(minColumns)+" to "+strconv.Itoa(maxColumns))
+			}
+			columns = cols
+		} else if cols, err := strconv.Atoi(c.Request().Header.Get(HeaderColumns)); err == nil && cols >= minColumns && cols <= maxColumns {
+			columns = cols
+		}
+
+		ctx := context.WithValue(c.Request().Context(), columnsContextKey, columns)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+}
+
+// Columns returns the terminal width set on ctx by HandleColumns, or 0 when the width is unknown.
+func Columns(ctx context.Context) int {
+	columns, ok := ctx.Value(columnsContextKey).(int)
+	if !ok {
+		return 0
+	}
+	return columns
+}
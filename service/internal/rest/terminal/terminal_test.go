@@ -0,0 +1,66 @@
+package terminal
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleColumns(t *testing.T) {
+	testCases := map[string]struct {
+		target          string
+		header          string
+		expectedColumns int
+		expectErr       bool
+	}{
+		"should be unknown without query or header": {
+			target: "/mlb",
+		},
+		"should use query parameter": {
+			target:          "/mlb?cols=80",
+			header:          "120",
+			expectedColumns: 80,
+		},
+		"should use header": {
+			target:          "/mlb",
+			header:          "120",
+			expectedColumns: 120,
+		},
+		"should ignore invalid header": {
+			target: "/mlb",
+			header: "wide",
+		},
+		"should reject invalid query parameter": {
+			target:    "/mlb?cols=5",
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.header != "" {
+				req.Header.Set(HeaderColumns, tc.header)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			columns := -1
+			err := HandleColumns(func(c echo.Context) error {
+				columns = Columns(c.Request().Context())
+				return nil
+			})(c)
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedColumns, columns)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package timezone
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"net/http"
+	"time"
+)
+
+const (
+	// HeaderTimeZone lets clients send an IANA timezone name, as with the GitHub API.
+	HeaderTimeZone = "Time-Zone"
+
+	cookieName     = "tz"
+	tzParam        = "tz"
+	cookieLifetime = 365 * 24 * time.Hour
+)
+
+type (
+	locationKey string
+)
+
+var (
+	locationContextKey locationKey = "location"
+)
+
+// HandleTimezone is a middleware function that resolves the timezone to show game times in and sets it on
+// request context.
+//
+// The timezone is taken from the tz query parameter, then the tz cookie, then the Time-Zone header. A tz query
+// parameter is also stored in the cookie so later requests keep it.
+func HandleTimezone(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		loc := time.Local
+
+		if name := c.QueryParam(tzParam); name != "" {
+			queryLoc, err := time.LoadLocation(name)
+			if err != nil || name == "Local" {
+				return echo.NewHTTPError(http.StatusBadRequest, "unknown timezone "+name)
+			}
+			loc = queryLoc
+			c.SetCookie(&http.Cookie{
+				Name:     cookieName,
+				Value:    name,
+				Path:     "/",
+				Expires:  time.Now().Add(cookieLifetime),
+				HttpOnly: true,
+				SameSite: http.SameSiteLaxMode,
+			})
+		} else if cookie, err := c.Cookie(cookieName); err == nil {
+			loc = loadOrDefault(cookie.Value, loc)
+		} else if name := c.Request().Header.Get(HeaderTimeZone); name != "" {
+			loc = loadOrDefault(name, loc)
+		}
+
+		ctx := context.WithValue(c.Request().Context(), locationContextKey, loc)
+		c.SetRequest(c.Request().WithContext(ctx))
+
+		return next(c)
+	}
+}
+
+// Location returns the timezone set on ctx by HandleTimezone, or time.Local when there is none.
+func Location(ctx context.Context) *time.Location {
+	loc, ok := ctx.Value(locationContextKey).(*time.Location)
+	if !ok {
+		return time.Local
+	}
+	return loc
+}
+
+// WithLocation returns a copy of ctx that shows game times in loc, for callers that do not take the timezone from
+// a request.
+func WithLocation(ctx context.Context, loc *time.Location) context.Context {
+	return context.WithValue(ctx, locationContextKey, loc)
+}
+
+// loadOrDefault loads the named timezone, returning def when name is unknown. Stored and sent timezones are
+// not rejected since the client may not be able to fix them.
+func loadOrDefault(name string, def *time.Location) *time.Location {
+	if name == "Local" {
+		return def
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return def
+	}
+	return loc
+}
@@ -0,0 +1,95 @@
+package timezone
+
+import (
+	"context"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHandleTimezone(t *testing.T) {
+	testCases := map[string]struct {
+		target         string
+		cookie         string
+		header         string
+		expectedLoc    string
+		expectedCookie bool
+		expectedStatus int
+	}{
+		"should default to local time": {
+			target:      "/nfl",
+			expectedLoc: time.Local.String(),
+		},
+		"should use query parameter and store it": {
+			target:         "/nfl?tz=America/Los_Angeles",
+			cookie:         "Europe/London",
+			header:         "Europe/Paris",
+			expectedLoc:    "America/Los_Angeles",
+			expectedCookie: true,
+		},
+		"should use cookie before header": {
+			target:      "/nfl",
+			cookie:      "Europe/London",
+			header:      "Europe/Paris",
+			expectedLoc: "Europe/London",
+		},
+		"should use header": {
+			target:      "/nfl",
+			header:      "Europe/Paris",
+			expectedLoc: "Europe/Paris",
+		},
+		"should ignore unknown header": {
+			target:      "/nfl",
+			header:      "Mars/Olympus_Mons",
+			expectedLoc: time.Local.String(),
+		},
+		"should reject unknown query parameter": {
+			target:         "/nfl?tz=Mars/Olympus_Mons",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.target, nil)
+			if tc.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: cookieName, Value: tc.cookie})
+			}
+			if tc.header != "" {
+				req.Header.Set(HeaderTimeZone, tc.header)
+			}
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			var loc *time.Location
+			err := HandleTimezone(func(c echo.Context) error {
+				loc = Location(c.Request().Context())
+				return nil
+			})(c)
+
+			if tc.expectedStatus != 0 {
+				var httpErr *echo.HTTPError
+				require.ErrorAs(t, err, &httpErr)
+				assert.Equal(t, tc.expectedStatus, httpErr.Code)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLoc, loc.String())
+			assert.Equal(t, tc.expectedCookie, rec.Header().Get("Set-Cookie") != "")
+		})
+	}
+}
+
+func TestWithLocation(t *testing.T) {
+	loc, err := time.LoadLocation("America/Denver")
+	require.NoError(t, err)
+
+	assert.Equal(t, time.Local, Location(context.Background()))
+	assert.Equal(t, loc, Location(WithLocation(context.Background(), loc)))
+}
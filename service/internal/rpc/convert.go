@@ -0,0 +1,46 @@
+package rpc
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rpc/scorespb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// toGame maps a game of a board or game page to its message.
+func toGame(sport scorespb.Sport, g renderer.Game) *scorespb.Game {
+	game := &scorespb.Game{
+		Id:          g.ID,
+		Sport:       sport,
+		State:       toState(g.Status.State),
+		Detail:      g.Status.Detail,
+		Clock:       g.Status.Clock,
+		PeriodLabel: g.PeriodLabel,
+		Periods:     int32(g.Periods),
+		TotalLabels: g.TotalLabels,
+		Away:        toTeam(g.Away),
+		Home:        toTeam(g.Home),
+		LastPlay:    g.LastPlay,
+	}
+	if !g.Status.Start.IsZero() {
+		game.StartTime = timestamppb.New(g.Status.Start)
+	}
+	for _, d := range g.Details {
+		game.Details = append(game.Details, &scorespb.Detail{Label: d.Label, Value: d.Value})
+	}
+	return game
+}
+
+func toTeam(t renderer.Team) *scorespb.Team {
+	return &scorespb.Team{Name: t.Name, Color: t.Color, Periods: t.Periods, Totals: t.Totals}
+}
+
+func toState(state renderer.State) scorespb.GameState {
+	switch state {
+	case renderer.Live:
+		return scorespb.GameState_GAME_STATE_LIVE
+	case renderer.Final:
+		return scorespb.GameState_GAME_STATE_FINAL
+	default:
+		return scorespb.GameState_GAME_STATE_SCHEDULED
+	}
+}
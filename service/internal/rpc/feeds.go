@@ -0,0 +1,123 @@
+package rpc
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"sync"
+	"time"
+)
+
+// changedInterval is how often NFL boards are polled when they are refreshed as the scheduler changes games, to
+// catch game clocks which change without a change event.
+const changedInterval = time.Minute
+
+// feed polls today's board of a sport in a timezone once for every stream watching it. Watchers are woken when
+// the board is loaded and read it with latest.
+type feed struct {
+	sport    favorites.Sport
+	loc      *time.Location
+	lock     sync.Mutex
+	board    renderer.Board
+	loaded   bool
+	watchers map[chan struct{}]bool
+	// refresh polls the board before the interval is over.
+	refresh chan struct{}
+	stop    func()
+}
+
+// watch adds wake to the watchers of the feed of sport in loc, starting the feed when it is the first watcher.
+// wake is sent to without blocking when the board is loaded, the returned func stops watching.
+func (s *Server) watch(sport favorites.Sport, loc *time.Location, wake chan struct{}) (*feed, func()) {
+	key := string(sport) + "/" + loc.String()
+
+	s.feedsLock.Lock()
+	defer s.feedsLock.Unlock()
+	f, ok := s.feeds[key]
+	if !ok {
+		ctx, cancel := context.WithCancel(context.Background())
+		f = &feed{sport: sport, loc: loc, watchers: make(map[chan struct{}]bool), refresh: make(chan struct{}, 1), stop: cancel}
+		s.feeds[key] = f
+		go s.poll(ctx, f)
+	}
+	f.lock.Lock()
+	f.watchers[wake] = true
+	if f.loaded {
+		notify(wake)
+	}
+	f.lock.Unlock()
+
+	return f, func() {
+		s.feedsLock.Lock()
+		defer s.feedsLock.Unlock()
+		f.lock.Lock()
+		defer f.lock.Unlock()
+		delete(f.watchers, wake)
+		if len(f.watchers) == 0 {
+			f.stop()
+			delete(s.feeds, key)
+		}
+	}
+}
+
+// poll loads the board of the feed until ctx is done. Boards that can not be loaded are tried again the next
+// interval, the last board loaded is kept.
+func (s *Server) poll(ctx context.Context, f *feed) {
+	interval := s.interval
+	if f.sport == favorites.NFL && s.changes != nil {
+		interval = changedInterval
+	}
+
+	for {
+		board, err := s.source.Board(ctx, f.sport, s.today(f.loc), nil)
+		if err != nil {
+			s.logger.Warn().Err(err).Str("sport", string(f.sport)).Msg("while watching games")
+		} else {
+			f.set(board)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-s.after(interval):
+		case <-f.refresh:
+		}
+	}
+}
+
+// refreshNFL polls the NFL feeds again each time the scheduler changes a game, until changes is closed.
+func (s *Server) refreshNFL() {
+	for range s.changes {
+		s.feedsLock.Lock()
+		for _, f := range s.feeds {
+			if f.sport == favorites.NFL {
+				notify(f.refresh)
+			}
+		}
+		s.feedsLock.Unlock()
+	}
+}
+
+func (f *feed) set(board renderer.Board) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.board, f.loaded = board, true
+	for wake := range f.watchers {
+		notify(wake)
+	}
+}
+
+// latest returns the last board loaded, false when none has been.
+func (f *feed) latest() (renderer.Board, bool) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return f.board, f.loaded
+}
+
+// notify sends to c unless a send is already waiting.
+func notify(c chan struct{}) {
+	select {
+	case c <- struct{}{}:
+	default:
+	}
+}
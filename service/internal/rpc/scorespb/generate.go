@@ -0,0 +1,4 @@
+// Package scorespb holds the protobuf messages and gRPC service generated from scores.proto.
+package scorespb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative scores.proto
@@ -0,0 +1,1259 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        (unknown)
+// source: scores.proto
+
+package scorespb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Sport int32
+
+const (
+	Sport_SPORT_UNSPECIFIED Sport = 0
+	Sport_SPORT_NFL         Sport = 1
+	Sport_SPORT_MLB         Sport = 2
+)
+
+// Enum value maps for Sport.
+var (
+	Sport_name = map[int32]string{
+		0: "SPORT_UNSPECIFIED",
+		1: "SPORT_NFL",
+		2: "SPORT_MLB",
+	}
+	Sport_value = map[string]int32{
+		"SPORT_UNSPECIFIED": 0,
+		"SPORT_NFL":         1,
+		"SPORT_MLB":         2,
+	}
+)
+
+func (x Sport) Enum() *Sport {
+	p := new(Sport)
+	*p = x
+	return p
+}
+
+func (x Sport) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Sport) Descriptor() protoreflect.EnumDescriptor {
+	return file_scores_proto_enumTypes[0].Descriptor()
+}
+
+func (Sport) Type() protoreflect.EnumType {
+	return &file_scores_proto_enumTypes[0]
+}
+
+func (x Sport) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Sport.Descriptor instead.
+func (Sport) EnumDescriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{0}
+}
+
+type GameState int32
+
+const (
+	GameState_GAME_STATE_UNSPECIFIED GameState = 0
+	GameState_GAME_STATE_SCHEDULED   GameState = 1
+	GameState_GAME_STATE_LIVE        GameState = 2
+	GameState_GAME_STATE_FINAL       GameState = 3
+)
+
+// Enum value maps for GameState.
+var (
+	GameState_name = map[int32]string{
+		0: "GAME_STATE_UNSPECIFIED",
+		1: "GAME_STATE_SCHEDULED",
+		2: "GAME_STATE_LIVE",
+		3: "GAME_STATE_FINAL",
+	}
+	GameState_value = map[string]int32{
+		"GAME_STATE_UNSPECIFIED": 0,
+		"GAME_STATE_SCHEDULED":   1,
+		"GAME_STATE_LIVE":        2,
+		"GAME_STATE_FINAL":       3,
+	}
+)
+
+func (x GameState) Enum() *GameState {
+	p := new(GameState)
+	*p = x
+	return p
+}
+
+func (x GameState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GameState) Descriptor() protoreflect.EnumDescriptor {
+	return file_scores_proto_enumTypes[1].Descriptor()
+}
+
+func (GameState) Type() protoreflect.EnumType {
+	return &file_scores_proto_enumTypes[1]
+}
+
+func (x GameState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GameState.Descriptor instead.
+func (GameState) EnumDescriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{1}
+}
+
+type PlayKind int32
+
+const (
+	PlayKind_PLAY_KIND_UNSPECIFIED     PlayKind = 0
+	PlayKind_PLAY_KIND_SCORING_PLAY    PlayKind = 1
+	PlayKind_PLAY_KIND_HOME_RUN        PlayKind = 2
+	PlayKind_PLAY_KIND_PITCHING_CHANGE PlayKind = 3
+)
+
+// Enum value maps for PlayKind.
+var (
+	PlayKind_name = map[int32]string{
+		0: "PLAY_KIND_UNSPECIFIED",
+		1: "PLAY_KIND_SCORING_PLAY",
+		2: "PLAY_KIND_HOME_RUN",
+		3: "PLAY_KIND_PITCHING_CHANGE",
+	}
+	PlayKind_value = map[string]int32{
+		"PLAY_KIND_UNSPECIFIED":     0,
+		"PLAY_KIND_SCORING_PLAY":    1,
+		"PLAY_KIND_HOME_RUN":        2,
+		"PLAY_KIND_PITCHING_CHANGE": 3,
+	}
+)
+
+func (x PlayKind) Enum() *PlayKind {
+	p := new(PlayKind)
+	*p = x
+	return p
+}
+
+func (x PlayKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PlayKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_scores_proto_enumTypes[2].Descriptor()
+}
+
+func (PlayKind) Type() protoreflect.EnumType {
+	return &file_scores_proto_enumTypes[2]
+}
+
+func (x PlayKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PlayKind.Descriptor instead.
+func (PlayKind) EnumDescriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{2}
+}
+
+type GetScoreboardRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport Sport `protobuf:"varint,1,opt,name=sport,proto3,enum=miniscore.v1.Sport" json:"sport,omitempty"`
+	// date is the day as YYYY-MM-DD, today when empty.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// teams are team abbreviations like KC. Only their games are returned when there are any.
+	Teams []string `protobuf:"bytes,3,rep,name=teams,proto3" json:"teams,omitempty"`
+	// time_zone is the IANA timezone of the day and game times, the server's when empty.
+	TimeZone string `protobuf:"bytes,4,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetScoreboardRequest) Reset() {
+	*x = GetScoreboardRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetScoreboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetScoreboardRequest) ProtoMessage() {}
+
+func (x *GetScoreboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetScoreboardRequest.ProtoReflect.Descriptor instead.
+func (*GetScoreboardRequest) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetScoreboardRequest) GetSport() Sport {
+	if x != nil {
+		return x.Sport
+	}
+	return Sport_SPORT_UNSPECIFIED
+}
+
+func (x *GetScoreboardRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetScoreboardRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *GetScoreboardRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type GetGameRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport Sport `protobuf:"varint,1,opt,name=sport,proto3,enum=miniscore.v1.Sport" json:"sport,omitempty"`
+	// id is the game pk of an MLB game or the id of an NFL game.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// time_zone is the IANA timezone of game times, the server's when empty.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *GetGameRequest) Reset() {
+	*x = GetGameRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGameRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGameRequest) ProtoMessage() {}
+
+func (x *GetGameRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGameRequest.ProtoReflect.Descriptor instead.
+func (*GetGameRequest) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetGameRequest) GetSport() Sport {
+	if x != nil {
+		return x.Sport
+	}
+	return Sport_SPORT_UNSPECIFIED
+}
+
+func (x *GetGameRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetGameRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type WatchGamesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sports are the sports to watch, every sport when empty.
+	Sports []Sport `protobuf:"varint,1,rep,packed,name=sports,proto3,enum=miniscore.v1.Sport" json:"sports,omitempty"`
+	// teams are team abbreviations like KC. Only their games are watched when there are any.
+	Teams []string `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	// time_zone is the IANA timezone of today and game times, the server's when empty.
+	TimeZone string `protobuf:"bytes,3,opt,name=time_zone,json=timeZone,proto3" json:"time_zone,omitempty"`
+}
+
+func (x *WatchGamesRequest) Reset() {
+	*x = WatchGamesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchGamesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchGamesRequest) ProtoMessage() {}
+
+func (x *WatchGamesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchGamesRequest.ProtoReflect.Descriptor instead.
+func (*WatchGamesRequest) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WatchGamesRequest) GetSports() []Sport {
+	if x != nil {
+		return x.Sports
+	}
+	return nil
+}
+
+func (x *WatchGamesRequest) GetTeams() []string {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+func (x *WatchGamesRequest) GetTimeZone() string {
+	if x != nil {
+		return x.TimeZone
+	}
+	return ""
+}
+
+type Scoreboard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport Sport `protobuf:"varint,1,opt,name=sport,proto3,enum=miniscore.v1.Sport" json:"sport,omitempty"`
+	// date is the day of the games as YYYY-MM-DD.
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// as_of is when stale scores on the board were current, unset when the board is current.
+	AsOf  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"`
+	Games []*Game                `protobuf:"bytes,4,rep,name=games,proto3" json:"games,omitempty"`
+}
+
+func (x *Scoreboard) Reset() {
+	*x = Scoreboard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Scoreboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Scoreboard) ProtoMessage() {}
+
+func (x *Scoreboard) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Scoreboard.ProtoReflect.Descriptor instead.
+func (*Scoreboard) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Scoreboard) GetSport() Sport {
+	if x != nil {
+		return x.Sport
+	}
+	return Sport_SPORT_UNSPECIFIED
+}
+
+func (x *Scoreboard) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Scoreboard) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+func (x *Scoreboard) GetGames() []*Game {
+	if x != nil {
+		return x.Games
+	}
+	return nil
+}
+
+type Game struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sport Sport     `protobuf:"varint,2,opt,name=sport,proto3,enum=miniscore.v1.Sport" json:"sport,omitempty"`
+	State GameState `protobuf:"varint,3,opt,name=state,proto3,enum=miniscore.v1.GameState" json:"state,omitempty"`
+	// detail is the period or state of the game, like Q4, Top 5th or Final.
+	Detail string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`
+	// clock is the game clock or start time.
+	Clock     string                 `protobuf:"bytes,5,opt,name=clock,proto3" json:"clock,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// period_label heads the periods, like Q for quarters.
+	PeriodLabel string `protobuf:"bytes,7,opt,name=period_label,json=periodLabel,proto3" json:"period_label,omitempty"`
+	// periods is the number of periods shown. Teams may have scores for fewer periods.
+	Periods int32 `protobuf:"varint,8,opt,name=periods,proto3" json:"periods,omitempty"`
+	// total_labels name the totals, like R, H and E. The first total is the score.
+	TotalLabels []string `protobuf:"bytes,9,rep,name=total_labels,json=totalLabels,proto3" json:"total_labels,omitempty"`
+	Away        *Team    `protobuf:"bytes,10,opt,name=away,proto3" json:"away,omitempty"`
+	Home        *Team    `protobuf:"bytes,11,opt,name=home,proto3" json:"home,omitempty"`
+	// details describe a game in progress beyond its line score, like the count or down and distance. Only
+	// GetGame fills them in.
+	Details []*Detail `protobuf:"bytes,12,rep,name=details,proto3" json:"details,omitempty"`
+	// last_play describes the last play of a game in progress, empty when unknown.
+	LastPlay string `protobuf:"bytes,13,opt,name=last_play,json=lastPlay,proto3" json:"last_play,omitempty"`
+}
+
+func (x *Game) Reset() {
+	*x = Game{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Game) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Game) ProtoMessage() {}
+
+func (x *Game) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Game.ProtoReflect.Descriptor instead.
+func (*Game) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Game) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Game) GetSport() Sport {
+	if x != nil {
+		return x.Sport
+	}
+	return Sport_SPORT_UNSPECIFIED
+}
+
+func (x *Game) GetState() GameState {
+	if x != nil {
+		return x.State
+	}
+	return GameState_GAME_STATE_UNSPECIFIED
+}
+
+func (x *Game) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *Game) GetClock() string {
+	if x != nil {
+		return x.Clock
+	}
+	return ""
+}
+
+func (x *Game) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Game) GetPeriodLabel() string {
+	if x != nil {
+		return x.PeriodLabel
+	}
+	return ""
+}
+
+func (x *Game) GetPeriods() int32 {
+	if x != nil {
+		return x.Periods
+	}
+	return 0
+}
+
+func (x *Game) GetTotalLabels() []string {
+	if x != nil {
+		return x.TotalLabels
+	}
+	return nil
+}
+
+func (x *Game) GetAway() *Team {
+	if x != nil {
+		return x.Away
+	}
+	return nil
+}
+
+func (x *Game) GetHome() *Team {
+	if x != nil {
+		return x.Home
+	}
+	return nil
+}
+
+func (x *Game) GetDetails() []*Detail {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *Game) GetLastPlay() string {
+	if x != nil {
+		return x.LastPlay
+	}
+	return ""
+}
+
+type Team struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// color is the team color as RRGGBB, empty when unknown.
+	Color string `protobuf:"bytes,2,opt,name=color,proto3" json:"color,omitempty"`
+	// periods are the scores of each period, blank for periods without one.
+	Periods []string `protobuf:"bytes,3,rep,name=periods,proto3" json:"periods,omitempty"`
+	Totals  []string `protobuf:"bytes,4,rep,name=totals,proto3" json:"totals,omitempty"`
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Team) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Team) GetColor() string {
+	if x != nil {
+		return x.Color
+	}
+	return ""
+}
+
+func (x *Team) GetPeriods() []string {
+	if x != nil {
+		return x.Periods
+	}
+	return nil
+}
+
+func (x *Team) GetTotals() []string {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+type Detail struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *Detail) Reset() {
+	*x = Detail{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Detail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Detail) ProtoMessage() {}
+
+func (x *Detail) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Detail.ProtoReflect.Descriptor instead.
+func (*Detail) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Detail) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *Detail) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type Play struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sport  Sport    `protobuf:"varint,1,opt,name=sport,proto3,enum=miniscore.v1.Sport" json:"sport,omitempty"`
+	GameId string   `protobuf:"bytes,2,opt,name=game_id,json=gameId,proto3" json:"game_id,omitempty"`
+	Kind   PlayKind `protobuf:"varint,3,opt,name=kind,proto3,enum=miniscore.v1.PlayKind" json:"kind,omitempty"`
+	Inning int32    `protobuf:"varint,4,opt,name=inning,proto3" json:"inning,omitempty"`
+	// half_inning is top or bottom.
+	HalfInning  string `protobuf:"bytes,5,opt,name=half_inning,json=halfInning,proto3" json:"half_inning,omitempty"`
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// away_score and home_score are the score after the play.
+	AwayScore int32 `protobuf:"varint,7,opt,name=away_score,json=awayScore,proto3" json:"away_score,omitempty"`
+	HomeScore int32 `protobuf:"varint,8,opt,name=home_score,json=homeScore,proto3" json:"home_score,omitempty"`
+}
+
+func (x *Play) Reset() {
+	*x = Play{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Play) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Play) ProtoMessage() {}
+
+func (x *Play) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Play.ProtoReflect.Descriptor instead.
+func (*Play) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Play) GetSport() Sport {
+	if x != nil {
+		return x.Sport
+	}
+	return Sport_SPORT_UNSPECIFIED
+}
+
+func (x *Play) GetGameId() string {
+	if x != nil {
+		return x.GameId
+	}
+	return ""
+}
+
+func (x *Play) GetKind() PlayKind {
+	if x != nil {
+		return x.Kind
+	}
+	return PlayKind_PLAY_KIND_UNSPECIFIED
+}
+
+func (x *Play) GetInning() int32 {
+	if x != nil {
+		return x.Inning
+	}
+	return 0
+}
+
+func (x *Play) GetHalfInning() string {
+	if x != nil {
+		return x.HalfInning
+	}
+	return ""
+}
+
+func (x *Play) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Play) GetAwayScore() int32 {
+	if x != nil {
+		return x.AwayScore
+	}
+	return 0
+}
+
+func (x *Play) GetHomeScore() int32 {
+	if x != nil {
+		return x.HomeScore
+	}
+	return 0
+}
+
+type GameUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Update:
+	//	*GameUpdate_Game
+	//	*GameUpdate_Play
+	Update isGameUpdate_Update `protobuf_oneof:"update"`
+}
+
+func (x *GameUpdate) Reset() {
+	*x = GameUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_scores_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GameUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameUpdate) ProtoMessage() {}
+
+func (x *GameUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_scores_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameUpdate.ProtoReflect.Descriptor instead.
+func (*GameUpdate) Descriptor() ([]byte, []int) {
+	return file_scores_proto_rawDescGZIP(), []int{8}
+}
+
+func (m *GameUpdate) GetUpdate() isGameUpdate_Update {
+	if m != nil {
+		return m.Update
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetGame() *Game {
+	if x, ok := x.GetUpdate().(*GameUpdate_Game); ok {
+		return x.Game
+	}
+	return nil
+}
+
+func (x *GameUpdate) GetPlay() *Play {
+	if x, ok := x.GetUpdate().(*GameUpdate_Play); ok {
+		return x.Play
+	}
+	return nil
+}
+
+type isGameUpdate_Update interface {
+	isGameUpdate_Update()
+}
+
+type GameUpdate_Game struct {
+	Game *Game `protobuf:"bytes,1,opt,name=game,proto3,oneof"`
+}
+
+type GameUpdate_Play struct {
+	Play *Play `protobuf:"bytes,2,opt,name=play,proto3,oneof"`
+}
+
+func (*GameUpdate_Game) isGameUpdate_Update() {}
+
+func (*GameUpdate_Play) isGameUpdate_Update() {}
+
+var File_scores_proto protoreflect.FileDescriptor
+
+var file_scores_proto_rawDesc = []byte{
+	0x0a, 0x0c, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x01,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x68, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x69,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x5a, 0x6f,
+	0x6e, 0x65, 0x22, 0x73, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x06, 0x73, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06, 0x73, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x69, 0x6d, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x73, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x61, 0x73, 0x5f, 0x6f, 0x66, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x04, 0x61, 0x73, 0x4f, 0x66, 0x12, 0x28, 0x0a, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x67, 0x61, 0x6d, 0x65, 0x73,
+	0x22, 0xd6, 0x03, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x05, 0x73, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x05, 0x73,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x07, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x26, 0x0a, 0x04,
+	0x61, 0x77, 0x61, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04,
+	0x61, 0x77, 0x61, 0x79, 0x12, 0x26, 0x0a, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x65, 0x61, 0x6d, 0x52, 0x04, 0x68, 0x6f, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x22, 0x62, 0x0a, 0x04, 0x54, 0x65, 0x61,
+	0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x22, 0x34, 0x0a,
+	0x06, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0x8f, 0x02, 0x0a, 0x04, 0x50, 0x6c, 0x61, 0x79, 0x12, 0x29, 0x0a, 0x05,
+	0x73, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x13, 0x2e, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x05, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x67, 0x61, 0x6d, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x67, 0x61, 0x6d, 0x65, 0x49, 0x64,
+	0x12, 0x2a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16,
+	0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x61, 0x6c, 0x66, 0x5f, 0x69, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x61, 0x6c, 0x66, 0x49,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x77, 0x61, 0x79, 0x5f,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x61, 0x77, 0x61,
+	0x79, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x6f, 0x6d, 0x65, 0x5f, 0x73,
+	0x63, 0x6f, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x68, 0x6f, 0x6d, 0x65,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x6a, 0x0a, 0x0a, 0x47, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x61, 0x6d, 0x65, 0x48, 0x00, 0x52, 0x04, 0x67, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a,
+	0x04, 0x70, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x69,
+	0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x48,
+	0x00, 0x52, 0x04, 0x70, 0x6c, 0x61, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x2a, 0x3c, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x0d, 0x0a, 0x09, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4e, 0x46, 0x4c, 0x10, 0x01,
+	0x12, 0x0d, 0x0a, 0x09, 0x53, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x4d, 0x4c, 0x42, 0x10, 0x02, 0x2a,
+	0x6c, 0x0a, 0x09, 0x47, 0x61, 0x6d, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x16,
+	0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x41, 0x4d, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x53, 0x43, 0x48, 0x45, 0x44, 0x55, 0x4c, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x4c, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x41, 0x4d, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x2a, 0x78, 0x0a,
+	0x08, 0x50, 0x6c, 0x61, 0x79, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x19, 0x0a, 0x15, 0x50, 0x4c, 0x41,
+	0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x4b, 0x49, 0x4e,
+	0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x50, 0x4c, 0x41, 0x59, 0x10, 0x01,
+	0x12, 0x16, 0x0a, 0x12, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x48, 0x4f,
+	0x4d, 0x45, 0x5f, 0x52, 0x55, 0x4e, 0x10, 0x02, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x4c, 0x41, 0x59,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x50, 0x49, 0x54, 0x43, 0x48, 0x49, 0x4e, 0x47, 0x5f, 0x43,
+	0x48, 0x41, 0x4e, 0x47, 0x45, 0x10, 0x03, 0x32, 0xdf, 0x01, 0x0a, 0x06, 0x53, 0x63, 0x6f, 0x72,
+	0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f,
+	0x61, 0x72, 0x64, 0x12, 0x22, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x12, 0x3b, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x2e, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x47,
+	0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x6d, 0x69, 0x6e,
+	0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x49,
+	0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d,
+	0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x47, 0x61, 0x6d, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x6d, 0x69, 0x6e, 0x69, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x61, 0x6d,
+	0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x30, 0x01, 0x42, 0x3e, 0x5a, 0x3c, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x6e, 0x35,
+	0x2f, 0x6d, 0x69, 0x6e, 0x69, 0x2d, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x72, 0x70, 0x63,
+	0x2f, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_scores_proto_rawDescOnce sync.Once
+	file_scores_proto_rawDescData = file_scores_proto_rawDesc
+)
+
+func file_scores_proto_rawDescGZIP() []byte {
+	file_scores_proto_rawDescOnce.Do(func() {
+		file_scores_proto_rawDescData = protoimpl.X.CompressGZIP(file_scores_proto_rawDescData)
+	})
+	return file_scores_proto_rawDescData
+}
+
+var file_scores_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_scores_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_scores_proto_goTypes = []any{
+	(Sport)(0),                    // 0: miniscore.v1.Sport
+	(GameState)(0),                // 1: miniscore.v1.GameState
+	(PlayKind)(0),                 // 2: miniscore.v1.PlayKind
+	(*GetScoreboardRequest)(nil),  // 3: miniscore.v1.GetScoreboardRequest
+	(*GetGameRequest)(nil),        // 4: miniscore.v1.GetGameRequest
+	(*WatchGamesRequest)(nil),     // 5: miniscore.v1.WatchGamesRequest
+	(*Scoreboard)(nil),            // 6: miniscore.v1.Scoreboard
+	(*Game)(nil),                  // 7: miniscore.v1.Game
+	(*Team)(nil),                  // 8: miniscore.v1.Team
+	(*Detail)(nil),                // 9: miniscore.v1.Detail
+	(*Play)(nil),                  // 10: miniscore.v1.Play
+	(*GameUpdate)(nil),            // 11: miniscore.v1.GameUpdate
+	(*timestamppb.Timestamp)(nil), // 12: google.protobuf.Timestamp
+}
+var file_scores_proto_depIdxs = []int32{
+	0,  // 0: miniscore.v1.GetScoreboardRequest.sport:type_name -> miniscore.v1.Sport
+	0,  // 1: miniscore.v1.GetGameRequest.sport:type_name -> miniscore.v1.Sport
+	0,  // 2: miniscore.v1.WatchGamesRequest.sports:type_name -> miniscore.v1.Sport
+	0,  // 3: miniscore.v1.Scoreboard.sport:type_name -> miniscore.v1.Sport
+	12, // 4: miniscore.v1.Scoreboard.as_of:type_name -> google.protobuf.Timestamp
+	7,  // 5: miniscore.v1.Scoreboard.games:type_name -> miniscore.v1.Game
+	0,  // 6: miniscore.v1.Game.sport:type_name -> miniscore.v1.Sport
+	1,  // 7: miniscore.v1.Game.state:type_name -> miniscore.v1.GameState
+	12, // 8: miniscore.v1.Game.start_time:type_name -> google.protobuf.Timestamp
+	8,  // 9: miniscore.v1.Game.away:type_name -> miniscore.v1.Team
+	8,  // 10: miniscore.v1.Game.home:type_name -> miniscore.v1.Team
+	9,  // 11: miniscore.v1.Game.details:type_name -> miniscore.v1.Detail
+	0,  // 12: miniscore.v1.Play.sport:type_name -> miniscore.v1.Sport
+	2,  // 13: miniscore.v1.Play.kind:type_name -> miniscore.v1.PlayKind
+	7,  // 14: miniscore.v1.GameUpdate.game:type_name -> miniscore.v1.Game
+	10, // 15: miniscore.v1.GameUpdate.play:type_name -> miniscore.v1.Play
+	3,  // 16: miniscore.v1.Scores.GetScoreboard:input_type -> miniscore.v1.GetScoreboardRequest
+	4,  // 17: miniscore.v1.Scores.GetGame:input_type -> miniscore.v1.GetGameRequest
+	5,  // 18: miniscore.v1.Scores.WatchGames:input_type -> miniscore.v1.WatchGamesRequest
+	6,  // 19: miniscore.v1.Scores.GetScoreboard:output_type -> miniscore.v1.Scoreboard
+	7,  // 20: miniscore.v1.Scores.GetGame:output_type -> miniscore.v1.Game
+	11, // 21: miniscore.v1.Scores.WatchGames:output_type -> miniscore.v1.GameUpdate
+	19, // [19:22] is the sub-list for method output_type
+	16, // [16:19] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_scores_proto_init() }
+func file_scores_proto_init() {
+	if File_scores_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_scores_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*GetScoreboardRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*GetGameRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchGamesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*Scoreboard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*Game); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Team); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*Detail); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*Play); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_scores_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*GameUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_scores_proto_msgTypes[8].OneofWrappers = []any{
+		(*GameUpdate_Game)(nil),
+		(*GameUpdate_Play)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_scores_proto_rawDesc,
+			NumEnums:      3,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_scores_proto_goTypes,
+		DependencyIndexes: file_scores_proto_depIdxs,
+		EnumInfos:         file_scores_proto_enumTypes,
+		MessageInfos:      file_scores_proto_msgTypes,
+	}.Build()
+	File_scores_proto = out.File
+	file_scores_proto_rawDesc = nil
+	file_scores_proto_goTypes = nil
+	file_scores_proto_depIdxs = nil
+}
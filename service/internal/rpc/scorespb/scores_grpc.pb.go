@@ -0,0 +1,219 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: scores.proto
+
+package scorespb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Scores_GetScoreboard_FullMethodName = "/miniscore.v1.Scores/GetScoreboard"
+	Scores_GetGame_FullMethodName       = "/miniscore.v1.Scores/GetGame"
+	Scores_WatchGames_FullMethodName    = "/miniscore.v1.Scores/WatchGames"
+)
+
+// ScoresClient is the client API for Scores service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScoresClient interface {
+	// GetScoreboard returns the games of a sport on a day.
+	GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...grpc.CallOption) (*Scoreboard, error)
+	// GetGame returns a game with the details of its game page.
+	GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error)
+	// WatchGames streams today's games that match the filter, each of them first and then again whenever they
+	// change, along with the plays of MLB games.
+	WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (Scores_WatchGamesClient, error)
+}
+
+type scoresClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScoresClient(cc grpc.ClientConnInterface) ScoresClient {
+	return &scoresClient{cc}
+}
+
+func (c *scoresClient) GetScoreboard(ctx context.Context, in *GetScoreboardRequest, opts ...grpc.CallOption) (*Scoreboard, error) {
+	out := new(Scoreboard)
+	err := c.cc.Invoke(ctx, Scores_GetScoreboard_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoresClient) GetGame(ctx context.Context, in *GetGameRequest, opts ...grpc.CallOption) (*Game, error) {
+	out := new(Game)
+	err := c.cc.Invoke(ctx, Scores_GetGame_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *scoresClient) WatchGames(ctx context.Context, in *WatchGamesRequest, opts ...grpc.CallOption) (Scores_WatchGamesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Scores_ServiceDesc.Streams[0], Scores_WatchGames_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &scoresWatchGamesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Scores_WatchGamesClient interface {
+	Recv() (*GameUpdate, error)
+	grpc.ClientStream
+}
+
+type scoresWatchGamesClient struct {
+	grpc.ClientStream
+}
+
+func (x *scoresWatchGamesClient) Recv() (*GameUpdate, error) {
+	m := new(GameUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// ScoresServer is the server API for Scores service.
+// All implementations must embed UnimplementedScoresServer
+// for forward compatibility
+type ScoresServer interface {
+	// GetScoreboard returns the games of a sport on a day.
+	GetScoreboard(context.Context, *GetScoreboardRequest) (*Scoreboard, error)
+	// GetGame returns a game with the details of its game page.
+	GetGame(context.Context, *GetGameRequest) (*Game, error)
+	// WatchGames streams today's games that match the filter, each of them first and then again whenever they
+	// change, along with the plays of MLB games.
+	WatchGames(*WatchGamesRequest, Scores_WatchGamesServer) error
+	mustEmbedUnimplementedScoresServer()
+}
+
+// UnimplementedScoresServer must be embedded to have forward compatible implementations.
+type UnimplementedScoresServer struct {
+}
+
+func (UnimplementedScoresServer) GetScoreboard(context.Context, *GetScoreboardRequest) (*Scoreboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetScoreboard not implemented")
+}
+func (UnimplementedScoresServer) GetGame(context.Context, *GetGameRequest) (*Game, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGame not implemented")
+}
+func (UnimplementedScoresServer) WatchGames(*WatchGamesRequest, Scores_WatchGamesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchGames not implemented")
+}
+func (UnimplementedScoresServer) mustEmbedUnimplementedScoresServer() {}
+
+// UnsafeScoresServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScoresServer will
+// result in compilation errors.
+type UnsafeScoresServer interface {
+	mustEmbedUnimplementedScoresServer()
+}
+
+func RegisterScoresServer(s grpc.ServiceRegistrar, srv ScoresServer) {
+	s.RegisterService(&Scores_ServiceDesc, srv)
+}
+
+func _Scores_GetScoreboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoresServer).GetScoreboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scores_GetScoreboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoresServer).GetScoreboard(ctx, req.(*GetScoreboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scores_GetGame_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScoresServer).GetGame(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Scores_GetGame_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScoresServer).GetGame(ctx, req.(*GetGameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Scores_WatchGames_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchGamesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScoresServer).WatchGames(m, &scoresWatchGamesServer{stream})
+}
+
+type Scores_WatchGamesServer interface {
+	Send(*GameUpdate) error
+	grpc.ServerStream
+}
+
+type scoresWatchGamesServer struct {
+	grpc.ServerStream
+}
+
+func (x *scoresWatchGamesServer) Send(m *GameUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Scores_ServiceDesc is the grpc.ServiceDesc for Scores service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Scores_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "miniscore.v1.Scores",
+	HandlerType: (*ScoresServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetScoreboard",
+			Handler:    _Scores_GetScoreboard_Handler,
+		},
+		{
+			MethodName: "GetGame",
+			Handler:    _Scores_GetGame_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchGames",
+			Handler:       _Scores_WatchGames_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "scores.proto",
+}
@@ -0,0 +1,309 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/mlb/events"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rpc/scorespb"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultInterval is how often watched games are checked for changes.
+const DefaultInterval = 15 * time.Second
+
+const dateLayout = "2006-01-02"
+
+var (
+	_ scorespb.ScoresServer = &Server{}
+	_ Source                = &cli.DirectSource{}
+)
+
+type (
+	// Source gets boards and game pages, as the HTTP handlers show them.
+	Source interface {
+		cli.Source
+		Game(ctx context.Context, sport favorites.Sport, id string, loc *time.Location) (renderer.Board, error)
+	}
+
+	// Server serves the Scores gRPC service from a source. Plays of MLB games are streamed from plays as they
+	// are published. Watched boards are polled once for every stream watching them, NFL boards are polled again
+	// as changes receives the games the scheduler changes.
+	Server struct {
+		scorespb.UnimplementedScoresServer
+		logger    zerolog.Logger
+		source    Source
+		plays     *events.Stream
+		changes   <-chan string
+		interval  time.Duration
+		now       func() time.Time
+		after     func(d time.Duration) <-chan time.Time
+		feedsLock sync.Mutex
+		feeds     map[string]*feed
+	}
+)
+
+// NewServer returns a server of source. changes may be nil, NFL boards are then polled every interval like MLB
+// boards.
+func NewServer(logger zerolog.Logger, source Source, plays *events.Stream, changes <-chan string, interval time.Duration) *Server {
+	s := &Server{
+		logger:   logger.With().Str("service", "ScoresServer").Logger(),
+		source:   source,
+		plays:    plays,
+		changes:  changes,
+		interval: interval,
+		now:      time.Now,
+		after:    time.After,
+		feeds:    make(map[string]*feed),
+	}
+	if changes != nil {
+		go s.refreshNFL()
+	}
+	return s
+}
+
+func (s *Server) GetScoreboard(ctx context.Context, req *scorespb.GetScoreboardRequest) (*scorespb.Scoreboard, error) {
+	sport, err := fromSport(req.GetSport())
+	if err != nil {
+		return nil, err
+	}
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+	teams, err := parseTeams(req.GetTeams())
+	if err != nil {
+		return nil, err
+	}
+	date := s.today(loc)
+	if req.GetDate() != "" {
+		if date, err = time.ParseInLocation(dateLayout, req.GetDate(), loc); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "date %q is not YYYY-MM-DD", req.GetDate())
+		}
+	}
+
+	board, err := s.source.Board(ctx, sport, date, teams)
+	if err != nil {
+		s.logger.Error().Err(err).Str("sport", string(sport)).Time("date", date).Msg("while getting scoreboard")
+		return nil, status.Errorf(codes.Unavailable, "%s scores are unavailable", sport)
+	}
+
+	scoreboard := &scorespb.Scoreboard{Sport: req.GetSport(), Date: date.Format(dateLayout)}
+	if !board.AsOf.IsZero() {
+		scoreboard.AsOf = timestamppb.New(board.AsOf)
+	}
+	for _, game := range board.Games {
+		scoreboard.Games = append(scoreboard.Games, toGame(req.GetSport(), game))
+	}
+	return scoreboard, nil
+}
+
+func (s *Server) GetGame(ctx context.Context, req *scorespb.GetGameRequest) (*scorespb.Game, error) {
+	sport, err := fromSport(req.GetSport())
+	if err != nil {
+		return nil, err
+	}
+	if req.GetId() == "" {
+		return nil, status.Error(codes.InvalidArgument, "a game id is required")
+	}
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.source.Game(ctx, sport, req.GetId(), loc)
+	if errors.Is(err, mlbfacade.ErrNoGame) || errors.Is(err, nflfacade.ErrNoGame) || (err == nil && len(page.Games) == 0) {
+		return nil, status.Errorf(codes.NotFound, "no %s game %q", sport, req.GetId())
+	}
+	if err != nil {
+		s.logger.Error().Err(err).Str("sport", string(sport)).Str("id", req.GetId()).Msg("while getting game")
+		return nil, status.Errorf(codes.Unavailable, "%s scores are unavailable", sport)
+	}
+	return toGame(req.GetSport(), page.Games[0]), nil
+}
+
+// WatchGames sends today's games when they have changed since they were last sent, from the boards polled for
+// every stream. Plays are sent for the MLB games being watched.
+func (s *Server) WatchGames(req *scorespb.WatchGamesRequest, stream scorespb.Scores_WatchGamesServer) error {
+	sports := req.GetSports()
+	if len(sports) == 0 {
+		sports = []scorespb.Sport{scorespb.Sport_SPORT_NFL, scorespb.Sport_SPORT_MLB}
+	}
+	for _, sport := range sports {
+		if _, err := fromSport(sport); err != nil {
+			return err
+		}
+	}
+	loc, err := location(req.GetTimeZone())
+	if err != nil {
+		return err
+	}
+	teams, err := parseTeams(req.GetTeams())
+	if err != nil {
+		return err
+	}
+
+	var plays <-chan events.Event
+	if s.plays != nil && containsSport(sports, scorespb.Sport_SPORT_MLB) {
+		subscription, unsubscribe := s.plays.Subscribe()
+		defer unsubscribe()
+		plays = subscription
+	}
+
+	wake := make(chan struct{}, 1)
+	feeds := make([]*feed, 0, len(sports))
+	for _, sport := range sports {
+		name, _ := fromSport(sport)
+		f, unwatch := s.watch(name, loc, wake)
+		defer unwatch()
+		feeds = append(feeds, f)
+	}
+
+	ctx := stream.Context()
+	sent := make(map[string]*scorespb.Game)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-wake:
+			for i, f := range feeds {
+				board, ok := f.latest()
+				if !ok {
+					continue
+				}
+				if err := sendChanges(stream, sports[i], board, teams, sent); err != nil {
+					return err
+				}
+			}
+		case event, ok := <-plays:
+			if !ok {
+				plays = nil
+				continue
+			}
+			play := toPlay(event)
+			if _, watched := sent[gameKey(play.GetSport(), play.GetGameId())]; !watched {
+				continue
+			}
+			if err := stream.Send(&scorespb.GameUpdate{Update: &scorespb.GameUpdate_Play{Play: play}}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sendChanges sends the games of teams on sport's board that are not in sent as they are, and records them in
+// sent. Every game is sent when teams is empty.
+func sendChanges(stream scorespb.Scores_WatchGamesServer, sport scorespb.Sport, board renderer.Board, teams []string, sent map[string]*scorespb.Game) error {
+	for _, g := range board.Games {
+		if len(teams) > 0 && !containsTeam(teams, g.Away.Name) && !containsTeam(teams, g.Home.Name) {
+			continue
+		}
+		game := toGame(sport, g)
+		key := gameKey(sport, game.GetId())
+		if proto.Equal(sent[key], game) {
+			continue
+		}
+		if err := stream.Send(&scorespb.GameUpdate{Update: &scorespb.GameUpdate_Game{Game: game}}); err != nil {
+			return err
+		}
+		sent[key] = game
+	}
+	return nil
+}
+
+func (s *Server) today(loc *time.Location) time.Time {
+	now := s.now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+}
+
+func fromSport(sport scorespb.Sport) (favorites.Sport, error) {
+	switch sport {
+	case scorespb.Sport_SPORT_NFL:
+		return favorites.NFL, nil
+	case scorespb.Sport_SPORT_MLB:
+		return favorites.MLB, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unknown sport %s", sport)
+	}
+}
+
+// location loads an IANA timezone, time.Local when name is empty.
+func location(name string) (*time.Location, error) {
+	if name == "" {
+		return time.Local, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown timezone %q", name)
+	}
+	return loc, nil
+}
+
+func parseTeams(teams []string) ([]string, error) {
+	var parsed []string
+	for _, team := range teams {
+		abbreviations := favorites.ParseTeams(team, ",")
+		if len(abbreviations) == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "%q is not a team abbreviation", team)
+		}
+		parsed = append(parsed, abbreviations...)
+	}
+	return parsed, nil
+}
+
+func containsSport(sports []scorespb.Sport, sport scorespb.Sport) bool {
+	for _, s := range sports {
+		if s == sport {
+			return true
+		}
+	}
+	return false
+}
+
+func containsTeam(teams []string, team string) bool {
+	for _, t := range teams {
+		if strings.EqualFold(t, team) {
+			return true
+		}
+	}
+	return false
+}
+
+func gameKey(sport scorespb.Sport, id string) string {
+	return fmt.Sprintf("%s/%s", sport, id)
+}
+
+func toPlay(event events.Event) *scorespb.Play {
+	kind := scorespb.PlayKind_PLAY_KIND_UNSPECIFIED
+	switch event.Kind {
+	case events.ScoringPlay:
+		kind = scorespb.PlayKind_PLAY_KIND_SCORING_PLAY
+	case events.HomeRun:
+		kind = scorespb.PlayKind_PLAY_KIND_HOME_RUN
+	case events.PitchingChange:
+		kind = scorespb.PlayKind_PLAY_KIND_PITCHING_CHANGE
+	}
+	return &scorespb.Play{
+		Sport:       scorespb.Sport_SPORT_MLB,
+		GameId:      strconv.Itoa(event.GamePk),
+		Kind:        kind,
+		Inning:      int32(event.Inning),
+		HalfInning:  event.HalfInning,
+		Description: event.Description,
+		AwayScore:   int32(event.AwayScore),
+		HomeScore:   int32(event.HomeScore),
+	}
+}
@@ -0,0 +1,358 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/mlb/events"
+	mlbfacade "github.com/rmarken5/mini-score/service/internal/mlb/facade"
+	nflfacade "github.com/rmarken5/mini-score/service/internal/nfl/logic/rest"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rmarken5/mini-score/service/internal/rpc/scorespb"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+type (
+	boardRequest struct {
+		sport favorites.Sport
+		date  time.Time
+		teams []string
+	}
+
+	// fakeSource answers each sport with its boards in turn, repeating the last one.
+	fakeSource struct {
+		mu       sync.Mutex
+		boards   map[favorites.Sport][]renderer.Board
+		err      error
+		games    map[string]renderer.Board
+		gameErr  error
+		requests []boardRequest
+	}
+)
+
+func (f *fakeSource) Board(_ context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, boardRequest{sport: sport, date: date, teams: teams})
+	if f.err != nil {
+		return renderer.Board{}, f.err
+	}
+	boards := f.boards[sport]
+	board := boards[0]
+	if len(boards) > 1 {
+		f.boards[sport] = boards[1:]
+	}
+	return board, nil
+}
+
+func (f *fakeSource) Game(_ context.Context, sport favorites.Sport, id string, _ *time.Location) (renderer.Board, error) {
+	if f.gameErr != nil {
+		return renderer.Board{}, f.gameErr
+	}
+	return f.games[string(sport)+"/"+id], nil
+}
+
+func testGame(id string, state renderer.State, away, home string) renderer.Game {
+	return renderer.Game{
+		ID:          id,
+		PeriodLabel: "Q",
+		Periods:     4,
+		TotalLabels: []string{"T"},
+		Away:        renderer.Team{Name: "KC", Color: "e31837", Periods: []string{away}, Totals: []string{away}},
+		Home:        renderer.Team{Name: "DET", Periods: []string{home}, Totals: []string{home}},
+		Status:      renderer.Status{State: state, Detail: "Q1", Clock: "10:00", Start: time.Date(2023, 9, 8, 0, 20, 0, 0, time.UTC)},
+	}
+}
+
+// dial serves s over an in-memory connection and returns a client of it.
+func dial(t *testing.T, s *Server) scorespb.ScoresClient {
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	scorespb.RegisterScoresServer(server, s)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return scorespb.NewScoresClient(conn)
+}
+
+func newTestServer(source *fakeSource, plays *events.Stream) *Server {
+	s := NewServer(zerolog.Nop(), source, plays, nil, DefaultInterval)
+	s.now = func() time.Time { return time.Date(2023, 9, 11, 2, 0, 0, 0, time.UTC) }
+	return s
+}
+
+func TestServer_GetScoreboard(t *testing.T) {
+	loc, err := time.LoadLocation("America/Chicago")
+	require.NoError(t, err)
+	asOf := time.Date(2023, 9, 7, 23, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		req             *scorespb.GetScoreboardRequest
+		err             error
+		expected        *scorespb.Scoreboard
+		expectedRequest *boardRequest
+		expectedCode    codes.Code
+	}{
+		"should return the games of a day": {
+			req: &scorespb.GetScoreboardRequest{Sport: scorespb.Sport_SPORT_NFL, Date: "2023-09-07", Teams: []string{"kc"}, TimeZone: "America/Chicago"},
+			expected: &scorespb.Scoreboard{
+				Sport: scorespb.Sport_SPORT_NFL,
+				Date:  "2023-09-07",
+				AsOf:  timestamppb.New(asOf),
+				Games: []*scorespb.Game{{
+					Id:          "1",
+					Sport:       scorespb.Sport_SPORT_NFL,
+					State:       scorespb.GameState_GAME_STATE_LIVE,
+					Detail:      "Q1",
+					Clock:       "10:00",
+					StartTime:   timestamppb.New(time.Date(2023, 9, 8, 0, 20, 0, 0, time.UTC)),
+					PeriodLabel: "Q",
+					Periods:     4,
+					TotalLabels: []string{"T"},
+					Away:        &scorespb.Team{Name: "KC", Color: "e31837", Periods: []string{"7"}, Totals: []string{"7"}},
+					Home:        &scorespb.Team{Name: "DET", Periods: []string{"0"}, Totals: []string{"0"}},
+				}},
+			},
+			expectedRequest: &boardRequest{sport: favorites.NFL, date: time.Date(2023, 9, 7, 0, 0, 0, 0, loc), teams: []string{"KC"}},
+		},
+		"should return today's games in the timezone": {
+			req:             &scorespb.GetScoreboardRequest{Sport: scorespb.Sport_SPORT_MLB, TimeZone: "America/Chicago"},
+			expectedRequest: &boardRequest{sport: favorites.MLB, date: time.Date(2023, 9, 10, 0, 0, 0, 0, loc)},
+		},
+		"should not accept an unknown sport": {
+			req:          &scorespb.GetScoreboardRequest{},
+			expectedCode: codes.InvalidArgument,
+		},
+		"should not accept a bad date": {
+			req:          &scorespb.GetScoreboardRequest{Sport: scorespb.Sport_SPORT_NFL, Date: "09/07/2023"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"should not accept an unknown timezone": {
+			req:          &scorespb.GetScoreboardRequest{Sport: scorespb.Sport_SPORT_NFL, TimeZone: "Mars/Olympus_Mons"},
+			expectedCode: codes.InvalidArgument,
+		},
+		"should not accept a bad team": {
+			req:          &scorespb.GetScoreboardRequest{Sport: scorespb.Sport_SPORT_NFL, Teams: []string{"chiefs!"}},
+			expectedCode: codes.InvalidArgument,
+		},
+		"should say when scores are unavailable": {
+			req:          &scorespb.GetScoreboardRequest{Sport: scorespb.Sport_SPORT_NFL},
+			err:          errors.New("connection refused"),
+			expectedCode: codes.Unavailable,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			source := &fakeSource{
+				boards: map[favorites.Sport][]renderer.Board{
+					favorites.NFL: {{AsOf: asOf, Games: []renderer.Game{testGame("1", renderer.Live, "7", "0")}}},
+					favorites.MLB: {{}},
+				},
+				err: tc.err,
+			}
+			client := dial(t, newTestServer(source, nil))
+
+			scoreboard, err := client.GetScoreboard(context.Background(), tc.req)
+
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			if tc.expected != nil {
+				assert.Equal(t, tc.expected.String(), scoreboard.String())
+			}
+			require.Len(t, source.requests, 1)
+			assert.Equal(t, *tc.expectedRequest, source.requests[0])
+		})
+	}
+}
+
+func TestServer_GetGame(t *testing.T) {
+	testCases := map[string]struct {
+		req          *scorespb.GetGameRequest
+		gameErr      error
+		expectedID   string
+		expectedCode codes.Code
+	}{
+		"should return the game with its details": {
+			req:        &scorespb.GetGameRequest{Sport: scorespb.Sport_SPORT_MLB, Id: "717465"},
+			expectedID: "717465",
+		},
+		"should not find a missing MLB game": {
+			req:          &scorespb.GetGameRequest{Sport: scorespb.Sport_SPORT_MLB, Id: "1"},
+			gameErr:      mlbfacade.ErrNoGame,
+			expectedCode: codes.NotFound,
+		},
+		"should not find a missing NFL game": {
+			req:          &scorespb.GetGameRequest{Sport: scorespb.Sport_SPORT_NFL, Id: "1"},
+			gameErr:      nflfacade.ErrNoGame,
+			expectedCode: codes.NotFound,
+		},
+		"should not find a game without a page": {
+			req:          &scorespb.GetGameRequest{Sport: scorespb.Sport_SPORT_NFL, Id: "2"},
+			expectedCode: codes.NotFound,
+		},
+		"should ask for an id": {
+			req:          &scorespb.GetGameRequest{Sport: scorespb.Sport_SPORT_NFL},
+			expectedCode: codes.InvalidArgument,
+		},
+		"should say when scores are unavailable": {
+			req:          &scorespb.GetGameRequest{Sport: scorespb.Sport_SPORT_NFL, Id: "1"},
+			gameErr:      errors.New("connection refused"),
+			expectedCode: codes.Unavailable,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			game := testGame("717465", renderer.Live, "1", "0")
+			game.Details = []renderer.Detail{{Label: "Count", Value: "2-1"}}
+			source := &fakeSource{
+				games:   map[string]renderer.Board{"mlb/717465": {Games: []renderer.Game{game}}},
+				gameErr: tc.gameErr,
+			}
+			client := dial(t, newTestServer(source, nil))
+
+			got, err := client.GetGame(context.Background(), tc.req)
+
+			if tc.expectedCode != codes.OK {
+				assert.Equal(t, tc.expectedCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedID, got.GetId())
+			assert.Equal(t, scorespb.Sport_SPORT_MLB, got.GetSport())
+			require.Len(t, got.GetDetails(), 1)
+			assert.Equal(t, "2-1", got.GetDetails()[0].GetValue())
+		})
+	}
+}
+
+func TestServer_WatchGames(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	other := testGame("3", renderer.Live, "0", "0")
+	other.Away.Name, other.Home.Name = "NYJ", "BUF"
+	source := &fakeSource{boards: map[favorites.Sport][]renderer.Board{
+		favorites.MLB: {
+			{Games: []renderer.Game{testGame("1", renderer.Scheduled, "", ""), testGame("2", renderer.Live, "0", "0"), other}},
+			{Games: []renderer.Game{testGame("1", renderer.Scheduled, "", ""), testGame("2", renderer.Live, "0", "0")}},
+			{Games: []renderer.Game{testGame("1", renderer.Scheduled, "", ""), testGame("2", renderer.Live, "1", "0")}},
+		},
+	}}
+	plays := events.NewStream()
+	s := newTestServer(source, plays)
+	ticks := make(chan time.Time)
+	s.after = func(time.Duration) <-chan time.Time { return ticks }
+	client := dial(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream, err := client.WatchGames(ctx, &scorespb.WatchGamesRequest{Sports: []scorespb.Sport{scorespb.Sport_SPORT_MLB}, Teams: []string{"KC"}, TimeZone: "America/New_York"})
+	require.NoError(t, err)
+
+	// Every game of the teams is sent first.
+	for _, id := range []string{"1", "2"} {
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, id, update.GetGame().GetId())
+	}
+
+	// Games are only sent again when they change.
+	ticks <- time.Time{}
+	ticks <- time.Time{}
+	update, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "2", update.GetGame().GetId())
+	assert.Equal(t, []string{"1"}, update.GetGame().GetAway().GetTotals())
+
+	// Plays are only sent for watched games.
+	plays.Publish(99, []events.Event{{GamePk: 99, Kind: events.HomeRun, Description: "Not watched."}})
+	plays.Publish(2, []events.Event{{GamePk: 2, Kind: events.HomeRun, Inning: 1, HalfInning: "top", Description: "Homers.", AwayScore: 1}})
+	update, err = stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, "2", update.GetPlay().GetGameId())
+	assert.Equal(t, scorespb.PlayKind_PLAY_KIND_HOME_RUN, update.GetPlay().GetKind())
+	assert.Equal(t, "Homers.", update.GetPlay().GetDescription())
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	for _, r := range source.requests {
+		assert.Nil(t, r.teams, "whole boards should be polled for every stream")
+		assert.Equal(t, time.Date(2023, 9, 10, 0, 0, 0, 0, loc), r.date, "today should be in the timezone")
+	}
+}
+
+func TestServer_WatchGames_changes(t *testing.T) {
+	source := &fakeSource{boards: map[favorites.Sport][]renderer.Board{
+		favorites.NFL: {
+			{Games: []renderer.Game{testGame("1", renderer.Live, "0", "0")}},
+			{Games: []renderer.Game{testGame("1", renderer.Live, "7", "0")}},
+		},
+	}}
+	changes := make(chan string)
+	s := NewServer(zerolog.Nop(), source, nil, changes, DefaultInterval)
+	s.now = func() time.Time { return time.Date(2023, 9, 11, 2, 0, 0, 0, time.UTC) }
+	s.after = func(time.Duration) <-chan time.Time { return nil }
+	client := dial(t, s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var streams []scorespb.Scores_WatchGamesClient
+	for i := 0; i < 2; i++ {
+		stream, err := client.WatchGames(ctx, &scorespb.WatchGamesRequest{Sports: []scorespb.Sport{scorespb.Sport_SPORT_NFL}})
+		require.NoError(t, err)
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"0"}, update.GetGame().GetAway().GetTotals())
+		streams = append(streams, stream)
+	}
+
+	// A change from the scheduler polls the board again for every stream.
+	changes <- "1"
+	for _, stream := range streams {
+		update, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"7"}, update.GetGame().GetAway().GetTotals())
+	}
+
+	source.mu.Lock()
+	defer source.mu.Unlock()
+	assert.Len(t, source.requests, 2, "the board should be polled once for both streams")
+}
+
+func TestServer_WatchGames_invalid(t *testing.T) {
+	client := dial(t, newTestServer(&fakeSource{}, nil))
+
+	stream, err := client.WatchGames(context.Background(), &scorespb.WatchGamesRequest{Sports: []scorespb.Sport{scorespb.Sport(7)}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,60 @@
+package shell
+
+import (
+	"net"
+	"time"
+)
+
+const (
+	// idleTimeout ends sessions that have sent nothing for this long, watching a board does not keep a session.
+	idleTimeout = time.Hour
+	// maxSessions bounds the sessions served at once over telnet and ssh together.
+	maxSessions = 256
+	// maxSessionsPerHost bounds the sessions served at once to one address.
+	maxSessionsPerHost = 4
+)
+
+// idleConn is a connection whose reads fail once the other end has sent nothing for timeout.
+type idleConn struct {
+	net.Conn
+	timeout time.Duration
+}
+
+func (c idleConn) Read(p []byte) (int, error) {
+	if err := c.Conn.SetReadDeadline(time.Now().Add(c.timeout)); err != nil {
+		return 0, err
+	}
+	return c.Conn.Read(p)
+}
+
+// acquire takes a session for the host of addr and returns the func that gives it back, false when there are
+// maxSessions sessions or maxSessionsPerHost sessions of the host.
+func (s *Server) acquire(addr net.Addr) (func(), bool) {
+	host := hostOf(addr)
+
+	s.sessionsLock.Lock()
+	defer s.sessionsLock.Unlock()
+	if s.sessionCount >= s.maxSessions || s.sessions[host] >= s.maxSessionsPerHost {
+		return nil, false
+	}
+	s.sessionCount++
+	s.sessions[host]++
+
+	return func() {
+		s.sessionsLock.Lock()
+		defer s.sessionsLock.Unlock()
+		s.sessionCount--
+		if s.sessions[host]--; s.sessions[host] == 0 {
+			delete(s.sessions, host)
+		}
+	}, true
+}
+
+// hostOf returns the host of addr, which sessions are counted and limited by.
+func hostOf(addr net.Addr) string {
+	host, _, err := net.SplitHostPort(addr.String())
+	if err != nil {
+		return addr.String()
+	}
+	return host
+}
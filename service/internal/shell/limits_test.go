@@ -0,0 +1,65 @@
+package shell
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestServer_acquire(t *testing.T) {
+	s := newTestServer(&fakeSource{})
+	s.maxSessions, s.maxSessionsPerHost = 3, 2
+	first := &net.TCPAddr{IP: net.IPv4(192, 0, 2, 1), Port: 1}
+	second := &net.TCPAddr{IP: net.IPv4(192, 0, 2, 2), Port: 1}
+
+	release, ok := s.acquire(first)
+	require.True(t, ok)
+	_, ok = s.acquire(first)
+	require.True(t, ok)
+	_, ok = s.acquire(first)
+	assert.False(t, ok, "a host should not get more than maxSessionsPerHost")
+	_, ok = s.acquire(second)
+	require.True(t, ok)
+	_, ok = s.acquire(second)
+	assert.False(t, ok, "no host should get more than maxSessions")
+
+	release()
+	_, ok = s.acquire(second)
+	assert.True(t, ok, "released sessions should be given out again")
+}
+
+func TestServer_ServeTelnet_busy(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	s := newTestServer(&fakeSource{})
+	s.maxSessions = 0
+	go s.ServeTelnet(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+
+	out, err := io.ReadAll(conn)
+	require.NoError(t, err)
+	assert.Equal(t, busy+"\r\n", string(out))
+}
+
+func TestIdleConn_Read(t *testing.T) {
+	server, client := net.Pipe()
+	defer client.Close()
+	conn := idleConn{Conn: server, timeout: 10 * time.Millisecond}
+
+	go func() { _, _ = client.Write([]byte("q")) }()
+	p := make([]byte, 1)
+	n, err := conn.Read(p)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	_, err = conn.Read(p)
+	assert.ErrorIs(t, err, os.ErrDeadlineExceeded)
+}
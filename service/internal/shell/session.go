@@ -0,0 +1,292 @@
+package shell
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	"github.com/rs/zerolog"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultInterval is how often watched boards are refreshed.
+	DefaultInterval = 30 * time.Second
+
+	dateLayout = "2006-01-02"
+	footer     = "Boards refresh by themselves. Press Enter for the menu or q to quit."
+	busy       = "Too many sessions, try again later."
+	perLine    = 3
+	// maxLine bounds a typed line, longer lines end the session.
+	maxLine = 256
+
+	ctrlC = 0x03
+	ctrlD = 0x04
+)
+
+// errQuit ends a session when the user asks to leave.
+var errQuit = errors.New("quit")
+
+type (
+	// Server serves a menu of boards to terminals connected over telnet or ssh. The picked boards are drawn
+	// from source and redrawn in place as scores change, sessions watching the same boards share them.
+	Server struct {
+		logger             zerolog.Logger
+		source             cli.Source
+		interval           time.Duration
+		now                func() time.Time
+		idleTimeout        time.Duration
+		maxSessions        int
+		maxSessionsPerHost int
+		sessionsLock       sync.Mutex
+		sessionCount       int
+		// sessions counts the sessions of each host.
+		sessions map[string]int
+	}
+
+	// terminal is a connected terminal. Lines are the lines the user enters and are closed when they hang up.
+	terminal struct {
+		lines <-chan string
+		out   io.Writer
+		// width is the number of columns of the terminal, zero when it is not known.
+		width func() int
+		color bool
+	}
+
+	// crlfWriter ends lines with CRLF as telnet and ssh terminals expect.
+	crlfWriter struct {
+		w io.Writer
+	}
+)
+
+func NewServer(logger zerolog.Logger, source cli.Source, interval time.Duration) *Server {
+	return &Server{
+		logger:             logger,
+		source:             newSharedSource(source, interval/2),
+		interval:           interval,
+		now:                time.Now,
+		idleTimeout:        idleTimeout,
+		maxSessions:        maxSessions,
+		maxSessionsPerHost: maxSessionsPerHost,
+		sessions:           make(map[string]int),
+	}
+}
+
+// serve shows the menu and the boards picked from it until the user quits or hangs up.
+func (s *Server) serve(ctx context.Context, t terminal) error {
+	fmt.Fprintln(t.out, "mini-score")
+	for {
+		opts, err := s.menu(t)
+		if errors.Is(err, errQuit) {
+			fmt.Fprintln(t.out, "Bye!")
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := s.watch(ctx, t, opts); errors.Is(err, errQuit) {
+			fmt.Fprintln(t.out, "Bye!")
+			return nil
+		} else if err != nil {
+			return err
+		}
+	}
+}
+
+// menu asks for the sport, date and teams of the boards, asking again until each answer makes sense.
+func (s *Server) menu(t terminal) (cli.Options, error) {
+	opts := cli.Options{Location: time.Local, Footer: footer}
+
+	for opts.Sports == nil {
+		answer, err := ask(t, "Sport: 1) NFL  2) MLB  3) both, or q to quit")
+		if err != nil {
+			return cli.Options{}, err
+		}
+		switch strings.ToLower(answer) {
+		case "1", string(favorites.NFL):
+			opts.Sports = []favorites.Sport{favorites.NFL}
+		case "2", string(favorites.MLB):
+			opts.Sports = []favorites.Sport{favorites.MLB}
+		case "3", "both", "":
+			opts.Sports = favorites.Sports
+		default:
+			fmt.Fprintf(t.out, "%q is not one of the sports.\n", answer)
+		}
+	}
+
+	for dated := false; !dated; {
+		answer, err := ask(t, "Date: today, yesterday, tomorrow or YYYY-MM-DD; empty for today")
+		if err != nil {
+			return cli.Options{}, err
+		}
+		opts.Date, dated = s.parseDate(answer)
+		if !dated {
+			fmt.Fprintf(t.out, "%q is not a date.\n", answer)
+		}
+	}
+
+	for picked := false; !picked; {
+		answer, err := ask(t, "Teams: abbreviations like KC BUF; empty for every game")
+		if err != nil {
+			return cli.Options{}, err
+		}
+		opts.Teams, picked = parseTeams(answer)
+		if !picked {
+			fmt.Fprintf(t.out, "%q are not team abbreviations.\n", answer)
+		}
+	}
+
+	return opts, nil
+}
+
+// watch draws the boards until the user enters a line. errQuit is returned when the line asks to quit and io.EOF
+// when the user hung up.
+func (s *Server) watch(ctx context.Context, t terminal, opts cli.Options) error {
+	opts.Text = renderer.TextOptions{Width: t.width(), PerLine: perLine, Color: t.color}
+	// terminals drawn in color understand ANSI escapes, telnet clients are not assumed to
+	opts.ClearScreen = t.color
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- cli.NewClient(s.source, opts).Watch(ctx, t.out, s.interval)
+	}()
+
+	select {
+	case err := <-done:
+		// Watch only stops by itself when the boards can not be written or the session is over.
+		if err == nil {
+			err = ctx.Err()
+		}
+		return err
+	case line, ok := <-t.lines:
+		cancel()
+		if err := <-done; err != nil {
+			return err
+		}
+		if !ok {
+			return io.EOF
+		}
+		if isQuit(line) {
+			return errQuit
+		}
+		return nil
+	}
+}
+
+// parseDate reads a date answer. The zero time is returned for today, so watched boards move on to the next day.
+func (s *Server) parseDate(answer string) (time.Time, bool) {
+	now := s.now().In(time.Local)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	switch strings.ToLower(answer) {
+	case "", "today":
+		return time.Time{}, true
+	case "yesterday":
+		return today.AddDate(0, 0, -1), true
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), true
+	}
+	date, err := time.ParseInLocation(dateLayout, answer, time.Local)
+	return date, err == nil
+}
+
+// parseTeams reads team abbreviations separated by spaces or commas. Nil is returned for every game.
+func parseTeams(answer string) ([]string, bool) {
+	var teams []string
+	for _, word := range strings.Fields(strings.ReplaceAll(answer, ",", " ")) {
+		team := favorites.ParseTeams(word, ",")
+		if len(team) == 0 {
+			return nil, false
+		}
+		teams = append(teams, team...)
+	}
+	return teams, true
+}
+
+// ask writes the question and returns the answer, or errQuit when the user asks to quit.
+func ask(t terminal, question string) (string, error) {
+	fmt.Fprintf(t.out, "\n%s\n> ", question)
+	line, ok := <-t.lines
+	if !ok {
+		return "", io.EOF
+	}
+	if isQuit(line) {
+		return "", errQuit
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func isQuit(line string) bool {
+	line = strings.ToLower(strings.TrimSpace(line))
+	return line == "q" || line == "quit" || line == "exit"
+}
+
+// readLines sends each line read from r until ctx is done, r ends, the user presses Ctrl-C or Ctrl-D or types a
+// line longer than maxLine. When echo is not nil, what is typed is written back to it for terminals that do not echo themselves.
+func readLines(ctx context.Context, r io.Reader, echo io.Writer) <-chan string {
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		br := bufio.NewReader(r)
+		var line []byte
+		cr := false
+		for {
+			b, err := br.ReadByte()
+			if err != nil {
+				return
+			}
+			// Enter is sent as CR, CR LF or CR NUL depending on the terminal.
+			if cr && (b == '\n' || b == 0) {
+				cr = false
+				continue
+			}
+			cr = b == '\r'
+
+			switch {
+			case b == '\r' || b == '\n':
+				writeEcho(echo, "\n")
+				select {
+				case lines <- string(line):
+				case <-ctx.Done():
+					return
+				}
+				line = line[:0]
+			case b == ctrlC || b == ctrlD:
+				return
+			case b == 0x7f || b == '\b':
+				if len(line) > 0 {
+					line = line[:len(line)-1]
+					writeEcho(echo, "\b \b")
+				}
+			case b >= ' ':
+				if len(line) == maxLine {
+					return
+				}
+				line = append(line, b)
+				writeEcho(echo, string(b))
+			}
+		}
+	}()
+	return lines
+}
+
+func writeEcho(echo io.Writer, s string) {
+	if echo != nil {
+		_, _ = io.WriteString(echo, s)
+	}
+}
+
+func (c crlfWriter) Write(p []byte) (int, error) {
+	if _, err := c.w.Write([]byte(strings.ReplaceAll(string(p), "\n", "\r\n"))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
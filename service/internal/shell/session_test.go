@@ -0,0 +1,271 @@
+package shell
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+var errHungUp = errors.New("hung up")
+
+type (
+	boardRequest struct {
+		sport favorites.Sport
+		date  time.Time
+		teams []string
+		// client is the client the board is counted against.
+		client string
+	}
+
+	fakeSource struct {
+		mu       sync.Mutex
+		requests []boardRequest
+	}
+
+	failingWriter struct{}
+
+	// syncBuffer is written by sessions while tests read it.
+	syncBuffer struct {
+		mu  sync.Mutex
+		buf bytes.Buffer
+	}
+)
+
+func (f *fakeSource) Board(ctx context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requests = append(f.requests, boardRequest{sport: sport, date: date, teams: teams, client: rate_limit.Client(ctx)})
+	return renderer.Board{Date: date, Games: []renderer.Game{{
+		PeriodLabel: "Q",
+		Periods:     4,
+		TotalLabels: []string{"T"},
+		Away:        renderer.Team{Name: "KC", Totals: []string{"7"}},
+		Home:        renderer.Team{Name: "DET", Totals: []string{"0"}},
+		Status:      renderer.Status{State: renderer.Live, Detail: "Q1", Clock: "10:00"},
+	}}}, nil
+}
+
+func (f *fakeSource) Requests() []boardRequest {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]boardRequest(nil), f.requests...)
+}
+
+func (failingWriter) Write([]byte) (int, error) {
+	return 0, errHungUp
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
+
+// waitFor waits until out has been written s.
+func waitFor(t *testing.T, out interface{ String() string }, s string) {
+	t.Helper()
+	require.Eventually(t, func() bool { return strings.Contains(out.String(), s) }, 5*time.Second, 10*time.Millisecond,
+		"expected output to contain %q, got %q", s, out)
+}
+
+func newTestServer(source *fakeSource) *Server {
+	s := NewServer(zerolog.Nop(), source, DefaultInterval)
+	s.now = func() time.Time { return time.Date(2023, 9, 10, 12, 0, 0, 0, time.Local) }
+	return s
+}
+
+func TestServer_serve(t *testing.T) {
+	source := &fakeSource{}
+	s := newTestServer(source)
+	lines := make(chan string)
+	out := &syncBuffer{}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- s.serve(context.Background(), terminal{lines: lines, out: out, width: func() int { return 0 }})
+	}()
+
+	lines <- "hockey"
+	lines <- "nfl"
+	lines <- "someday"
+	lines <- "tomorrow"
+	lines <- "KC, ?"
+	lines <- "kc"
+	waitFor(t, out, footer)
+	lines <- ""
+	lines <- "2"
+	lines <- ""
+	lines <- ""
+	waitFor(t, out, "MLB\n")
+	lines <- "q"
+
+	require.NoError(t, <-done)
+	assert.Contains(t, out.String(), `"hockey" is not one of the sports.`)
+	assert.Contains(t, out.String(), `"someday" is not a date.`)
+	assert.Contains(t, out.String(), `"KC, ?" are not team abbreviations.`)
+	assert.True(t, strings.HasSuffix(out.String(), "Bye!\n"))
+
+	requests := source.Requests()
+	require.Len(t, requests, 2)
+	assert.Equal(t, boardRequest{sport: favorites.NFL, date: time.Date(2023, 9, 11, 0, 0, 0, 0, time.Local), teams: []string{"KC"}}, requests[0])
+	assert.Equal(t, favorites.MLB, requests[1].sport)
+	assert.Equal(t, time.Now().Format(dateLayout), requests[1].date.Format(dateLayout), "today should be the real day while watching")
+	assert.Nil(t, requests[1].teams)
+}
+
+func TestServer_serve_hangUp(t *testing.T) {
+	testCases := map[string]struct {
+		lines []string
+	}{
+		"should end in the menu": {
+			lines: []string{"1"},
+		},
+		"should end while watching": {
+			lines: []string{"1", "", ""},
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			lines := make(chan string, len(tc.lines))
+			for _, line := range tc.lines {
+				lines <- line
+			}
+			close(lines)
+
+			err := newTestServer(&fakeSource{}).serve(context.Background(), terminal{lines: lines, out: &syncBuffer{}, width: func() int { return 0 }})
+
+			assert.ErrorContains(t, err, "EOF")
+		})
+	}
+}
+
+func TestServer_parseDate(t *testing.T) {
+	s := newTestServer(&fakeSource{})
+
+	testCases := map[string]struct {
+		answer       string
+		expectedDate time.Time
+		expectedOk   bool
+	}{
+		"should watch today when empty": {
+			answer:     "",
+			expectedOk: true,
+		},
+		"should watch today": {
+			answer:     "Today",
+			expectedOk: true,
+		},
+		"should read yesterday": {
+			answer:       "yesterday",
+			expectedDate: time.Date(2023, 9, 9, 0, 0, 0, 0, time.Local),
+			expectedOk:   true,
+		},
+		"should read a date": {
+			answer:       "2023-09-07",
+			expectedDate: time.Date(2023, 9, 7, 0, 0, 0, 0, time.Local),
+			expectedOk:   true,
+		},
+		"should not read anything else": {
+			answer: "09/07",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			date, ok := s.parseDate(tc.answer)
+
+			assert.Equal(t, tc.expectedOk, ok)
+			assert.Equal(t, tc.expectedDate, date)
+		})
+	}
+}
+
+func TestReadLines(t *testing.T) {
+	testCases := map[string]struct {
+		input         string
+		expectedLines []string
+		expectedEcho  string
+	}{
+		"should read lines ending in CR LF, CR NUL, CR or LF": {
+			input:         "nfl\r\nkc\r\x00today\rmlb\n",
+			expectedLines: []string{"nfl", "kc", "today", "mlb"},
+			expectedEcho:  "nfl\nkc\ntoday\nmlb\n",
+		},
+		"should erase with backspace": {
+			input:         "nfx\x7fl\r",
+			expectedLines: []string{"nfl"},
+			expectedEcho:  "nfx\b \bl\n",
+		},
+		"should stop at Ctrl-C": {
+			input:         "nfl\r\x03kc\r",
+			expectedLines: []string{"nfl"},
+			expectedEcho:  "nfl\n",
+		},
+		"should leave out control characters": {
+			input:         "n\x1bfl\n",
+			expectedLines: []string{"nfl"},
+			expectedEcho:  "nfl\n",
+		},
+		"should stop at a line longer than maxLine": {
+			input:         strings.Repeat("a", maxLine) + "\r" + strings.Repeat("b", maxLine+1) + "\r",
+			expectedLines: []string{strings.Repeat("a", maxLine)},
+			expectedEcho:  strings.Repeat("a", maxLine) + "\n" + strings.Repeat("b", maxLine),
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			echo := &syncBuffer{}
+			var lines []string
+			for line := range readLines(context.Background(), strings.NewReader(tc.input), echo) {
+				lines = append(lines, line)
+			}
+
+			assert.Equal(t, tc.expectedLines, lines)
+			assert.Equal(t, tc.expectedEcho, echo.String())
+		})
+	}
+}
+
+func TestCrlfWriter(t *testing.T) {
+	out := bytes.Buffer{}
+
+	n, err := crlfWriter{w: &out}.Write([]byte("NFL\nKC 7\n"))
+
+	require.NoError(t, err)
+	assert.Equal(t, 9, n)
+	assert.Equal(t, "NFL\r\nKC 7\r\n", out.String())
+}
+
+func TestServer_watch_writeError(t *testing.T) {
+	lines := make(chan string)
+	opts := cli.Options{Sports: []favorites.Sport{favorites.NFL}}
+
+	err := newTestServer(&fakeSource{}).watch(context.Background(), terminal{lines: lines, out: failingWriter{}, width: func() int { return 0 }}, opts)
+
+	assert.ErrorIs(t, err, errHungUp)
+}
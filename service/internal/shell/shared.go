@@ -0,0 +1,75 @@
+package shell
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/cli"
+	"github.com/rmarken5/mini-score/service/internal/renderer"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"strings"
+	"sync"
+	"time"
+)
+
+var _ cli.Source = &sharedSource{}
+
+type (
+	// sharedSource gets each board once for every session watching it. A board is got again once it is older than
+	// maxAge, while it is got the sessions asking for it wait for it.
+	sharedSource struct {
+		source cli.Source
+		maxAge time.Duration
+		now    func() time.Time
+		lock   sync.Mutex
+		boards map[string]*sharedBoard
+	}
+
+	sharedBoard struct {
+		// done is closed once board and err are set.
+		done  chan struct{}
+		at    time.Time
+		board renderer.Board
+		err   error
+	}
+)
+
+func newSharedSource(source cli.Source, maxAge time.Duration) *sharedSource {
+	return &sharedSource{source: source, maxAge: maxAge, now: time.Now, boards: make(map[string]*sharedBoard)}
+}
+
+func (s *sharedSource) Board(ctx context.Context, sport favorites.Sport, date time.Time, teams []string) (renderer.Board, error) {
+	key := string(sport) + "/" + date.Format(time.RFC3339) + "/" + date.Location().String() + "/" + strings.Join(teams, ",")
+
+	s.lock.Lock()
+	now := s.now()
+	b, ok := s.boards[key]
+	if !ok || now.Sub(b.at) >= s.maxAge {
+		b = &sharedBoard{done: make(chan struct{}), at: now}
+		s.boards[key] = b
+		s.prune(now)
+		// The board is got for every session waiting for it, so the session that asked first hanging up does not
+		// end it. It is counted against the client of that session.
+		client := rate_limit.WithClient(context.Background(), rate_limit.Client(ctx))
+		go func() {
+			b.board, b.err = s.source.Board(client, sport, date, teams)
+			close(b.done)
+		}()
+	}
+	s.lock.Unlock()
+
+	select {
+	case <-b.done:
+		return b.board, b.err
+	case <-ctx.Done():
+		return renderer.Board{}, ctx.Err()
+	}
+}
+
+// prune forgets boards older than maxAge, no session has asked for them since.
+func (s *sharedSource) prune(now time.Time) {
+	for key, b := range s.boards {
+		if now.Sub(b.at) >= s.maxAge {
+			delete(s.boards, key)
+		}
+	}
+}
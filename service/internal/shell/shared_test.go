@@ -0,0 +1,44 @@
+package shell
+
+import (
+	"context"
+	"github.com/rmarken5/mini-score/service/internal/rest/favorites"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSharedSource_Board(t *testing.T) {
+	source := &fakeSource{}
+	now := time.Date(2023, 9, 10, 12, 0, 0, 0, time.UTC)
+	shared := newSharedSource(source, 15*time.Second)
+	shared.now = func() time.Time { return now }
+	date := time.Date(2023, 9, 10, 0, 0, 0, 0, time.UTC)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			board, err := shared.Board(context.Background(), favorites.NFL, date, []string{"KC"})
+			assert.NoError(t, err)
+			assert.Len(t, board.Games, 1)
+		}()
+	}
+	wg.Wait()
+	require.Len(t, source.Requests(), 1, "sessions watching the same board should share it")
+
+	_, err := shared.Board(rate_limit.WithClient(context.Background(), "203.0.113.1"), favorites.NFL, date, nil)
+	require.NoError(t, err)
+	require.Len(t, source.Requests(), 2, "other boards should be got")
+	assert.Equal(t, "203.0.113.1", source.Requests()[1].client, "boards should be counted against the client asking")
+
+	now = now.Add(15 * time.Second)
+	_, err = shared.Board(context.Background(), favorites.NFL, date, []string{"KC"})
+	require.NoError(t, err)
+	assert.Len(t, source.Requests(), 3, "old boards should be got again")
+	assert.Len(t, shared.boards, 1, "old boards should be forgotten")
+}
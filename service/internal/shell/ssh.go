@@ -0,0 +1,155 @@
+package shell
+
+import (
+	"context"
+	"errors"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"golang.org/x/crypto/ssh"
+	"io"
+	"net"
+	"sync/atomic"
+)
+
+type (
+	// ptyRequest is the payload of a pty-req request, see RFC 4254 section 6.2.
+	ptyRequest struct {
+		Term    string
+		Columns uint32
+		Rows    uint32
+		Width   uint32
+		Height  uint32
+		Modes   string
+	}
+
+	// windowChange is the payload of a window-change request, see RFC 4254 section 6.7.
+	windowChange struct {
+		Columns uint32
+		Rows    uint32
+		Width   uint32
+		Height  uint32
+	}
+
+	exitStatus struct {
+		Status uint32
+	}
+)
+
+// NewSSHConfig returns a config that lets anyone in without authenticating, identified by the PEM encoded
+// private host key.
+func NewSSHConfig(hostKey []byte) (*ssh.ServerConfig, error) {
+	signer, err := ssh.ParsePrivateKey(hostKey)
+	if err != nil {
+		return nil, err
+	}
+	config := &ssh.ServerConfig{NoClientAuth: true}
+	config.AddHostKey(signer)
+	return config, nil
+}
+
+// ServeSSH serves the menu to each ssh connection accepted on l until l is closed. Each connection gets one
+// session, connections over the session limits are closed.
+func (s *Server) ServeSSH(l net.Listener, config *ssh.ServerConfig) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		release, ok := s.acquire(conn.RemoteAddr())
+		if !ok {
+			_ = conn.Close()
+			continue
+		}
+		go func() {
+			defer release()
+			s.serveSSH(idleConn{Conn: conn, timeout: s.idleTimeout}, config)
+		}()
+	}
+}
+
+func (s *Server) serveSSH(netConn net.Conn, config *ssh.ServerConfig) {
+	defer netConn.Close()
+	logger := s.logger.With().Str("remote", netConn.RemoteAddr().String()).Str("protocol", "ssh").Logger()
+
+	conn, channels, requests, err := ssh.NewServerConn(netConn, config)
+	if err != nil {
+		logger.Debug().Err(err).Msg("ssh handshake failed")
+		return
+	}
+	defer conn.Close()
+	go ssh.DiscardRequests(requests)
+
+	served := false
+	for newChannel := range channels {
+		if newChannel.ChannelType() != "session" {
+			_ = newChannel.Reject(ssh.UnknownChannelType, "only sessions are served")
+			continue
+		}
+		if served {
+			_ = newChannel.Reject(ssh.ResourceShortage, "one session is served for each connection")
+			continue
+		}
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			logger.Debug().Err(err).Msg("while accepting ssh session")
+			continue
+		}
+		served = true
+		go func() {
+			s.serveSSHSession(hostOf(netConn.RemoteAddr()), channel, channelRequests)
+			_ = conn.Close()
+		}()
+	}
+}
+
+// serveSSHSession answers the requests of a session until it is closed, starting the menu when a shell is asked
+// for. Commands are refused, there is nothing to run. Past boards are counted against the budget of client.
+func (s *Server) serveSSHSession(client string, channel ssh.Channel, requests <-chan *ssh.Request) {
+	defer channel.Close()
+
+	ctx, cancel := context.WithCancel(rate_limit.WithClient(context.Background(), client))
+	defer cancel()
+
+	var cols atomic.Int32
+	pty, color, started := false, false, false
+	for req := range requests {
+		ok := false
+		switch req.Type {
+		case "pty-req":
+			var msg ptyRequest
+			if ok = !started && ssh.Unmarshal(req.Payload, &msg) == nil; ok {
+				pty, color = true, msg.Term != "" && msg.Term != "dumb"
+				cols.Store(int32(msg.Columns))
+			}
+		case "window-change":
+			var msg windowChange
+			if ssh.Unmarshal(req.Payload, &msg) == nil {
+				cols.Store(int32(msg.Columns))
+			}
+		case "shell":
+			ok, started = !started, true
+			if ok {
+				go s.serveSSHShell(ctx, channel, pty, color, func() int { return int(cols.Load()) })
+			}
+		}
+		if req.WantReply {
+			_ = req.Reply(ok, nil)
+		}
+	}
+}
+
+// serveSSHShell runs the menu and closes the channel once the user quits. With a pty the client sends each key as
+// it is pressed, so typed lines are echoed back.
+func (s *Server) serveSSHShell(ctx context.Context, channel ssh.Channel, pty, color bool, width func() int) {
+	defer channel.Close()
+
+	out := crlfWriter{w: channel}
+	var echo io.Writer
+	if pty {
+		echo = out
+	}
+	t := terminal{lines: readLines(ctx, channel, echo), out: out, width: width, color: color}
+	if err := s.serve(ctx, t); err != nil && !errors.Is(err, io.EOF) {
+		s.logger.Debug().Err(err).Msg("ssh session ended")
+	}
+	_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(exitStatus{}))
+}
@@ -0,0 +1,94 @@
+package shell
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func testHostKey(t *testing.T) []byte {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestNewSSHConfig(t *testing.T) {
+	testCases := map[string]struct {
+		hostKey     []byte
+		expectedErr bool
+	}{
+		"should read a PEM host key": {
+			hostKey: testHostKey(t),
+		},
+		"should not read anything else": {
+			hostKey:     []byte("not a key"),
+			expectedErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			config, err := NewSSHConfig(tc.hostKey)
+
+			assert.Equal(t, tc.expectedErr, err != nil)
+			if !tc.expectedErr {
+				assert.True(t, config.NoClientAuth)
+			}
+		})
+	}
+}
+
+func TestServer_ServeSSH(t *testing.T) {
+	config, err := NewSSHConfig(testHostKey(t))
+	require.NoError(t, err)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	source := &fakeSource{}
+	go newTestServer(source).ServeSSH(l, config)
+
+	client, err := ssh.Dial("tcp", l.Addr().String(), &ssh.ClientConfig{
+		User:            "fan",
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	})
+	require.NoError(t, err)
+	defer client.Close()
+	session, err := client.NewSession()
+	require.NoError(t, err)
+	defer session.Close()
+
+	require.NoError(t, session.RequestPty("xterm", 24, 100, ssh.TerminalModes{}))
+	stdin, err := session.StdinPipe()
+	require.NoError(t, err)
+	out := &syncBuffer{}
+	session.Stdout = out
+	require.NoError(t, session.Shell())
+
+	// With a pty keys are sent as they are pressed and Enter as CR.
+	_, err = io.WriteString(stdin, "nfl\r\r\r")
+	require.NoError(t, err)
+	waitFor(t, out, footer)
+	require.NoError(t, session.WindowChange(24, 60))
+	_, err = io.WriteString(stdin, "q\r")
+	require.NoError(t, err)
+
+	require.NoError(t, session.Wait(), "the session should exit cleanly")
+	assert.Contains(t, out.String(), "> nfl\r\n", "typed keys should be echoed")
+	assert.Contains(t, out.String(), ansi.Bold("NFL"), "boards should be in color")
+	assert.Contains(t, out.String(), ansi.ClearScreen, "boards should be redrawn in place")
+	assert.True(t, strings.HasSuffix(out.String(), "Bye!\r\n"))
+	require.Len(t, source.Requests(), 1)
+}
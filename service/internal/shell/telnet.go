@@ -0,0 +1,160 @@
+package shell
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	rate_limit "github.com/rmarken5/mini-score/service/internal/rest/rate-limit"
+	"io"
+	"net"
+	"sync/atomic"
+)
+
+// Telnet commands and options, see RFC 854 and RFC 1073.
+const (
+	iac  = 255
+	dont = 254
+	do   = 253
+	wont = 252
+	will = 251
+	sb   = 250
+	ip   = 244
+	se   = 240
+
+	optNAWS = 31
+
+	// maxSubnegotiation bounds the data of a subnegotiation, a window size is only 5 bytes.
+	maxSubnegotiation = 256
+)
+
+// errSubnegotiation ends connections that send a subnegotiation longer than maxSubnegotiation.
+var errSubnegotiation = errors.New("telnet subnegotiation too long")
+
+// telnetReader reads what the user typed from a telnet connection, keeping the window size the client reports.
+type telnetReader struct {
+	r    *bufio.Reader
+	cols atomic.Int32
+}
+
+// ServeTelnet serves the menu to each telnet connection accepted on l until l is closed. Clients are left to
+// echo and edit lines themselves, so the oldest of them work. Connections over the session limits are told so and
+// closed.
+func (s *Server) ServeTelnet(l net.Listener) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		release, ok := s.acquire(conn.RemoteAddr())
+		if !ok {
+			_, _ = io.WriteString(conn, busy+"\r\n")
+			_ = conn.Close()
+			continue
+		}
+		go func() {
+			defer release()
+			s.serveTelnet(idleConn{Conn: conn, timeout: s.idleTimeout})
+		}()
+	}
+}
+
+func (s *Server) serveTelnet(conn net.Conn) {
+	defer conn.Close()
+	logger := s.logger.With().Str("remote", conn.RemoteAddr().String()).Str("protocol", "telnet").Logger()
+
+	// Ask for the window size so boards fit it. Clients that do not know the option refuse it.
+	if _, err := conn.Write([]byte{iac, do, optNAWS}); err != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(rate_limit.WithClient(context.Background(), hostOf(conn.RemoteAddr())))
+	defer cancel()
+	r := newTelnetReader(conn)
+	t := terminal{lines: readLines(ctx, r, nil), out: crlfWriter{w: conn}, width: r.width}
+	if err := s.serve(ctx, t); err != nil && !errors.Is(err, io.EOF) {
+		logger.Debug().Err(err).Msg("telnet session ended")
+	}
+}
+
+func newTelnetReader(r io.Reader) *telnetReader {
+	return &telnetReader{r: bufio.NewReader(r)}
+}
+
+// Read returns the bytes the user typed. Telnet commands are left out, except interrupt which is read as Ctrl-C.
+func (t *telnetReader) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		// Only wait for the first byte, after that return what has arrived.
+		if n > 0 && t.r.Buffered() == 0 {
+			break
+		}
+		b, err := t.r.ReadByte()
+		if err != nil {
+			if n > 0 {
+				return n, nil
+			}
+			return 0, err
+		}
+		if b != iac {
+			p[n] = b
+			n++
+			continue
+		}
+
+		cmd, err := t.r.ReadByte()
+		if err != nil {
+			return n, err
+		}
+		switch cmd {
+		case iac:
+			p[n] = iac
+			n++
+		case ip:
+			p[n] = ctrlC
+			n++
+		case will, wont, do, dont:
+			if _, err := t.r.ReadByte(); err != nil {
+				return n, err
+			}
+		case sb:
+			if err := t.subnegotiation(); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// subnegotiation reads a subnegotiation up to IAC SE, keeping the window size when it is one. errSubnegotiation
+// is returned when it does not end within maxSubnegotiation bytes.
+func (t *telnetReader) subnegotiation() error {
+	var data []byte
+	for {
+		b, err := t.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b == iac {
+			if b, err = t.r.ReadByte(); err != nil {
+				return err
+			}
+			if b == se {
+				break
+			}
+		}
+		if len(data) == maxSubnegotiation {
+			return errSubnegotiation
+		}
+		data = append(data, b)
+	}
+
+	if len(data) == 5 && data[0] == optNAWS {
+		t.cols.Store(int32(data[1])<<8 | int32(data[2]))
+	}
+	return nil
+}
+
+// width is the number of columns the client last reported, zero when it has not.
+func (t *telnetReader) width() int {
+	return int(t.cols.Load())
+}
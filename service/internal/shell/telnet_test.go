@@ -0,0 +1,105 @@
+package shell
+
+import (
+	"github.com/rmarken5/mini-score/service/internal/ansi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestTelnetReader_Read(t *testing.T) {
+	testCases := map[string]struct {
+		input         []byte
+		expected      string
+		expectedWidth int
+	}{
+		"should pass typed bytes through": {
+			input:    []byte("nfl\r\n"),
+			expected: "nfl\r\n",
+		},
+		"should leave out option negotiation": {
+			input:    append([]byte{iac, will, optNAWS}, "kc\r\n"...),
+			expected: "kc\r\n",
+		},
+		"should keep the window size": {
+			input:         append([]byte{iac, sb, optNAWS, 0, 120, 0, 40, iac, se}, "mlb\r\n"...),
+			expected:      "mlb\r\n",
+			expectedWidth: 120,
+		},
+		"should read escaped bytes in the window size": {
+			input:         []byte{iac, sb, optNAWS, 1, iac, iac, 0, 40, iac, se},
+			expected:      "",
+			expectedWidth: 511,
+		},
+		"should read interrupt as Ctrl-C": {
+			input:    []byte{'n', iac, ip},
+			expected: "n\x03",
+		},
+		"should read an escaped IAC": {
+			input:    []byte{iac, iac},
+			expected: "\xff",
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			r := newTelnetReader(strings.NewReader(string(tc.input)))
+
+			out, err := io.ReadAll(r)
+
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(out))
+			assert.Equal(t, tc.expectedWidth, r.width())
+		})
+	}
+}
+
+func TestTelnetReader_Read_longSubnegotiation(t *testing.T) {
+	input := append([]byte{'n', iac, sb, optNAWS}, make([]byte, maxSubnegotiation)...)
+	r := newTelnetReader(strings.NewReader(string(append(input, iac, se))))
+
+	out, err := io.ReadAll(r)
+
+	assert.ErrorIs(t, err, errSubnegotiation)
+	assert.Equal(t, "n", string(out))
+}
+
+func TestServer_ServeTelnet(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer l.Close()
+	source := &fakeSource{}
+	go newTestServer(source).ServeTelnet(l)
+
+	conn, err := net.Dial("tcp", l.Addr().String())
+	require.NoError(t, err)
+	defer conn.Close()
+	out := &syncBuffer{}
+	closed := make(chan struct{})
+	go func() {
+		_, _ = io.Copy(out, conn)
+		close(closed)
+	}()
+
+	waitFor(t, out, string([]byte{iac, do, optNAWS}))
+	_, err = conn.Write([]byte{iac, will, optNAWS, iac, sb, optNAWS, 0, 80, 0, 24, iac, se})
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("1\r\n\r\nKC\r\n"))
+	require.NoError(t, err)
+	waitFor(t, out, footer+"\r\n")
+	_, err = conn.Write([]byte("q\r\n"))
+	require.NoError(t, err)
+
+	<-closed
+	assert.Contains(t, out.String(), "\r\nSport: ")
+	assert.True(t, strings.HasSuffix(out.String(), "Bye!\r\n"))
+	assert.NotContains(t, strings.ReplaceAll(out.String(), "\r\n", ""), "\n", "lines should end in CR LF")
+	assert.NotContains(t, out.String(), ansi.ClearScreen, "telnet clients should not get ANSI escapes")
+	require.Len(t, source.Requests(), 1)
+	assert.Equal(t, []string{"KC"}, source.Requests()[0].teams)
+}
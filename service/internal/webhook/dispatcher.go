@@ -0,0 +1,163 @@
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/rs/zerolog"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultAttempts is how many times a delivery is tried before it is dead lettered.
+	defaultAttempts = 4
+	// defaultBackoff is the wait before the first retry, doubled before each retry after.
+	defaultBackoff = time.Second
+)
+
+type (
+	// Store holds the registered subscriptions and the deliveries that could not be made.
+	Store interface {
+		Subscriptions() ([]Subscription, error)
+		DeadLetter(letter DeadLetter) error
+	}
+
+	// DeadLetter is a delivery that failed every attempt.
+	DeadLetter struct {
+		SubscriptionID string
+		Event          Event
+		Payload        []byte
+		Attempts       int
+		LastError      string
+	}
+
+	// Dispatcher posts signed events to the subscriptions they match.
+	Dispatcher struct {
+		logger   zerolog.Logger
+		client   *http.Client
+		store    Store
+		attempts int
+		backoff  time.Duration
+		now      func() time.Time
+		sleep    func(d time.Duration)
+	}
+
+	// permanentError is a delivery failure that retrying will not fix, like a receiver rejecting the payload.
+	permanentError struct {
+		err error
+	}
+)
+
+func NewDispatcher(logger zerolog.Logger, client *http.Client, store Store) *Dispatcher {
+	return &Dispatcher{
+		logger:   logger.With().Str("service", "webhook").Logger(),
+		client:   client,
+		store:    store,
+		attempts: defaultAttempts,
+		backoff:  defaultBackoff,
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+// Dispatch posts event to every subscription it matches and returns once each delivery has been made or dead
+// lettered. Deliveries are retried with exponential backoff when the receiver cannot be reached, answers 429 or
+// fails with a 5xx status.
+func (d *Dispatcher) Dispatch(event Event) {
+	logger := d.logger.With().Str("method", "Dispatch").Str("event", event.ID).Logger()
+
+	subscriptions, err := d.store.Subscriptions()
+	if err != nil {
+		logger.Error().Err(err).Msg("while getting subscriptions")
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		logger.Error().Err(err).Msg("while encoding event")
+		return
+	}
+
+	wg := sync.WaitGroup{}
+	for _, s := range subscriptions {
+		if !s.Matches(event) {
+			continue
+		}
+		wg.Add(1)
+		go func(s Subscription) {
+			defer wg.Done()
+			d.deliver(s, event, payload)
+		}(s)
+	}
+	wg.Wait()
+}
+
+func (d *Dispatcher) deliver(s Subscription, event Event, payload []byte) {
+	logger := d.logger.With().Str("method", "deliver").Str("event", event.ID).Str("subscription", s.ID).Logger()
+
+	var err error
+	attempt := 0
+	for attempt < d.attempts {
+		if attempt > 0 {
+			d.sleep(d.backoff << (attempt - 1))
+		}
+		attempt++
+		if err = d.post(s, event, payload); err == nil {
+			logger.Info().Msgf("delivered on attempt %d", attempt)
+			return
+		}
+		logger.Error().Err(err).Msgf("attempt %d failed", attempt)
+		if errors.As(err, &permanentError{}) {
+			break
+		}
+	}
+
+	letter := DeadLetter{SubscriptionID: s.ID, Event: event, Payload: payload, Attempts: attempt, LastError: err.Error()}
+	if err := d.store.DeadLetter(letter); err != nil {
+		logger.Error().Err(err).Msg("while dead lettering delivery")
+	}
+}
+
+// post makes a single delivery, signed with the time it is made.
+func (d *Dispatcher) post(s Subscription, event Event, payload []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(payload))
+	if err != nil {
+		return permanentError{err: err}
+	}
+	now := d.now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(event.Type))
+	req.Header.Set(HeaderDelivery, event.ID)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(s.Secret, now, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	err = fmt.Errorf("receiver answered %s", resp.Status)
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError {
+		return err
+	}
+	return permanentError{err: err}
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e permanentError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,127 @@
+package webhook
+
+import (
+	"errors"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type memoryStore struct {
+	lock          sync.Mutex
+	subscriptions []Subscription
+	err           error
+	deadLetters   []DeadLetter
+}
+
+func (m *memoryStore) Subscriptions() ([]Subscription, error) {
+	return m.subscriptions, m.err
+}
+
+func (m *memoryStore) DeadLetter(letter DeadLetter) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.deadLetters = append(m.deadLetters, letter)
+	return nil
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	event := Event{ID: "401547353-score-7-0", Sport: SportNFL, Type: ScoreChange, GameID: "401547353", AwayTeam: "KC", HomeTeam: "BUF", AwayScore: 7}
+
+	testCases := map[string]struct {
+		// answers are the statuses the receiver answers with in turn, 0 lets the receiver verify and accept
+		answers             []int
+		subscription        Subscription
+		expectedDeliveries  int
+		expectedEvents      int
+		expectedDeadLetters int
+		expectedSleeps      []time.Duration
+	}{
+		"should deliver signed events": {
+			answers:            []int{0},
+			subscription:       Subscription{ID: "1", Secret: "s3cret"},
+			expectedDeliveries: 1,
+			expectedEvents:     1,
+		},
+		"should not deliver events the subscription filters out": {
+			subscription: Subscription{ID: "1", Secret: "s3cret", Teams: []string{"MIA"}},
+		},
+		"should retry server errors with backoff": {
+			answers:            []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, 0},
+			subscription:       Subscription{ID: "1", Secret: "s3cret"},
+			expectedDeliveries: 3,
+			expectedEvents:     1,
+			expectedSleeps:     []time.Duration{time.Second, 2 * time.Second},
+		},
+		"should dead letter deliveries that fail every attempt": {
+			answers:             []int{http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError, http.StatusInternalServerError},
+			subscription:        Subscription{ID: "1", Secret: "s3cret"},
+			expectedDeliveries:  4,
+			expectedDeadLetters: 1,
+			expectedSleeps:      []time.Duration{time.Second, 2 * time.Second, 4 * time.Second},
+		},
+		"should dead letter deliveries the receiver rejects without retrying": {
+			answers:             []int{0},
+			subscription:        Subscription{ID: "1", Secret: "wrong"},
+			expectedDeliveries:  1,
+			expectedDeadLetters: 1,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			events := 0
+			receiver := &Receiver{Secret: "s3cret", OnEvent: func(received Event) error {
+				assert.Equal(t, event, received)
+				events++
+				return nil
+			}}
+			deliveries := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer func() { deliveries++ }()
+				if deliveries < len(tc.answers) && tc.answers[deliveries] != 0 {
+					w.WriteHeader(tc.answers[deliveries])
+					return
+				}
+				assert.Equal(t, "score_change", r.Header.Get(HeaderEvent))
+				assert.Equal(t, event.ID, r.Header.Get(HeaderDelivery))
+				receiver.ServeHTTP(w, r)
+			}))
+			defer server.Close()
+
+			tc.subscription.URL = server.URL
+			store := &memoryStore{subscriptions: []Subscription{tc.subscription}}
+			var sleeps []time.Duration
+			d := NewDispatcher(zerolog.Nop(), server.Client(), store)
+			d.sleep = func(d time.Duration) { sleeps = append(sleeps, d) }
+
+			d.Dispatch(event)
+
+			assert.Equal(t, tc.expectedDeliveries, deliveries)
+			assert.Equal(t, tc.expectedEvents, events)
+			assert.Equal(t, tc.expectedSleeps, sleeps)
+			require.Len(t, store.deadLetters, tc.expectedDeadLetters)
+			if tc.expectedDeadLetters > 0 {
+				assert.Equal(t, "1", store.deadLetters[0].SubscriptionID)
+				assert.Equal(t, tc.expectedDeliveries, store.deadLetters[0].Attempts)
+				assert.JSONEq(t, `{"id":"401547353-score-7-0","sport":"nfl","type":"score_change","game_id":"401547353",
+					"away_team":"KC","home_team":"BUF","away_score":7,"home_score":0,"period":0,"occurred_at":"0001-01-01T00:00:00Z"}`,
+					string(store.deadLetters[0].Payload))
+			}
+		})
+	}
+}
+
+func TestDispatcher_Dispatch_StoreError(t *testing.T) {
+	d := NewDispatcher(zerolog.Nop(), http.DefaultClient, &memoryStore{err: errors.New("database down")})
+	d.sleep = func(time.Duration) { t.Fatal("nothing should be retried") }
+
+	d.Dispatch(Event{ID: "1"})
+}
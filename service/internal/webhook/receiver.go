@@ -0,0 +1,63 @@
+package webhook
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// DefaultTolerance is how old a delivery may be before Receiver rejects it as a replay.
+	DefaultTolerance = 5 * time.Minute
+	// maxPayload bounds the body Receiver reads.
+	maxPayload = 64 << 10
+)
+
+// Receiver is a http.Handler that checks the signature of deliveries and hands their events to OnEvent. It is what
+// a subscriber runs, and lets webhooks be tried out and tested without leaving the machine.
+type Receiver struct {
+	Secret string
+	// Tolerance is how far a delivery's timestamp may be from now. Zero means DefaultTolerance.
+	Tolerance time.Duration
+	// OnEvent is called with each verified event. An error answers the delivery with 500 so it is retried.
+	OnEvent func(event Event) error
+	now     func() time.Time
+}
+
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "deliveries must be posted", http.StatusMethodNotAllowed)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxPayload))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	now, tolerance := time.Now, r.Tolerance
+	if r.now != nil {
+		now = r.now
+	}
+	if tolerance == 0 {
+		tolerance = DefaultTolerance
+	}
+	if err := Verify(r.Secret, req.Header.Get(HeaderTimestamp), req.Header.Get(HeaderSignature), body, now(), tolerance); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	var event Event
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if r.OnEvent != nil {
+		if err := r.OnEvent(event); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,80 @@
+package webhook
+
+import (
+	"bytes"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestReceiver_ServeHTTP(t *testing.T) {
+	now := time.Date(2023, 9, 10, 17, 0, 0, 0, time.UTC)
+	body := []byte(`{"id":"401547353-kickoff","sport":"nfl","type":"kickoff","game_id":"401547353"}`)
+
+	testCases := map[string]struct {
+		method         string
+		secret         string
+		timestamp      time.Time
+		body           []byte
+		onEventErr     error
+		expectedStatus int
+		expectedEvent  bool
+	}{
+		"should accept signed deliveries": {
+			secret: "s3cret", timestamp: now, body: body, expectedStatus: http.StatusNoContent, expectedEvent: true,
+		},
+		"should reject deliveries signed with another secret": {
+			secret: "other", timestamp: now, body: body, expectedStatus: http.StatusUnauthorized,
+		},
+		"should reject old deliveries": {
+			secret: "s3cret", timestamp: now.Add(-DefaultTolerance - time.Second), body: body, expectedStatus: http.StatusUnauthorized,
+		},
+		"should reject payloads that are not events": {
+			secret: "s3cret", timestamp: now, body: []byte("not json"), expectedStatus: http.StatusBadRequest,
+		},
+		"should ask for a retry when the event is not handled": {
+			secret: "s3cret", timestamp: now, body: body, onEventErr: errors.New("busy"), expectedStatus: http.StatusInternalServerError, expectedEvent: true,
+		},
+		"should only accept posts": {
+			method: http.MethodGet, expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var received *Event
+			r := &Receiver{
+				Secret: "s3cret",
+				OnEvent: func(event Event) error {
+					received = &event
+					return tc.onEventErr
+				},
+				now: func() time.Time { return now },
+			}
+
+			method := tc.method
+			if method == "" {
+				method = http.MethodPost
+			}
+			req := httptest.NewRequest(method, "/", bytes.NewReader(tc.body))
+			req.Header.Set(HeaderTimestamp, strconv.FormatInt(tc.timestamp.Unix(), 10))
+			req.Header.Set(HeaderSignature, Sign(tc.secret, tc.timestamp, tc.body))
+			rec := httptest.NewRecorder()
+
+			r.ServeHTTP(rec, req)
+
+			assert.Equal(t, tc.expectedStatus, rec.Code)
+			assert.Equal(t, tc.expectedEvent, received != nil)
+			if tc.expectedEvent {
+				assert.Equal(t, Kickoff, received.Type)
+				assert.Equal(t, "401547353", received.GameID)
+			}
+		})
+	}
+}
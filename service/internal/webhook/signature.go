@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Mini-Score-Event"
+	HeaderDelivery  = "X-Mini-Score-Delivery"
+	HeaderTimestamp = "X-Mini-Score-Timestamp"
+	HeaderSignature = "X-Mini-Score-Signature"
+
+	signaturePrefix = "sha256="
+)
+
+var (
+	ErrSignature = errors.New("webhook signature does not match")
+	ErrTimestamp = errors.New("webhook timestamp is missing or too old")
+)
+
+// Sign returns the signature sent in HeaderSignature, a HMAC-SHA256 of the timestamp and body keyed by the
+// secret of the subscription. Signing the timestamp keeps a delivery from being replayed later.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the timestamp and signature headers of a delivery of body made within tolerance of now.
+func Verify(secret, timestampHeader, signatureHeader string, body []byte, now time.Time, tolerance time.Duration) error {
+	seconds, err := strconv.ParseInt(timestampHeader, 10, 64)
+	if err != nil {
+		return ErrTimestamp
+	}
+	timestamp := time.Unix(seconds, 0)
+	if age := now.Sub(timestamp); age > tolerance || age < -tolerance {
+		return ErrTimestamp
+	}
+
+	if !strings.HasPrefix(signatureHeader, signaturePrefix) ||
+		!hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signatureHeader)) {
+		return ErrSignature
+	}
+	return nil
+}
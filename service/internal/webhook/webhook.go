@@ -0,0 +1,92 @@
+package webhook
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	SportNFL = "nfl"
+	SportMLB = "mlb"
+)
+
+const (
+	// Kickoff is sent when an NFL game starts.
+	Kickoff EventType = "kickoff"
+	// ScoreChange is sent when either team scores, or in the NFL when a score is taken back.
+	ScoreChange EventType = "score_change"
+	// QuarterEnd is sent when an NFL quarter ends, once the next quarter has started.
+	QuarterEnd EventType = "quarter_end"
+	// Final is sent when a game ends.
+	Final EventType = "final"
+)
+
+var (
+	// Sports are the sports subscriptions may filter on, the sports events are sent for.
+	Sports = []string{SportNFL, SportMLB}
+	// EventTypes are the event types subscriptions may filter on.
+	EventTypes = []EventType{Kickoff, ScoreChange, QuarterEnd, Final}
+)
+
+type (
+	EventType string
+
+	// Event is the JSON payload posted to subscriptions. ID is the same every time the same change is sent so
+	// receivers can drop deliveries they have already handled. Period is the quarter of NFL games and the inning of
+	// MLB games.
+	Event struct {
+		ID         string    `json:"id"`
+		Sport      string    `json:"sport"`
+		Type       EventType `json:"type"`
+		GameID     string    `json:"game_id"`
+		AwayTeam   string    `json:"away_team"`
+		HomeTeam   string    `json:"home_team"`
+		AwayScore  int       `json:"away_score"`
+		HomeScore  int       `json:"home_score"`
+		Period     int       `json:"period"`
+		OccurredAt time.Time `json:"occurred_at"`
+	}
+
+	// Subscription is a registered webhook. Empty filters match everything.
+	Subscription struct {
+		ID         string
+		URL        string
+		Secret     string
+		Sports     []string
+		Teams      []string
+		EventTypes []EventType
+	}
+)
+
+// Matches reports if the event passes every filter of the subscription. The team filter matches either team.
+func (s Subscription) Matches(event Event) bool {
+	if len(s.Sports) > 0 && !contains(s.Sports, event.Sport) {
+		return false
+	}
+	if len(s.Teams) > 0 && !contains(s.Teams, event.AwayTeam) && !contains(s.Teams, event.HomeTeam) {
+		return false
+	}
+	if len(s.EventTypes) > 0 && !contains(s.EventTypes, event.Type) {
+		return false
+	}
+	return true
+}
+
+// IsSport reports if sport is one of Sports.
+func IsSport(sport string) bool {
+	return contains(Sports, sport)
+}
+
+// IsEventType reports if eventType is one of EventTypes.
+func IsEventType(eventType string) bool {
+	return contains(EventTypes, EventType(eventType))
+}
+
+func contains[T ~string](values []T, value T) bool {
+	for _, v := range values {
+		if strings.EqualFold(string(v), string(value)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+package webhook
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestSubscription_Matches(t *testing.T) {
+	event := Event{Sport: SportNFL, Type: ScoreChange, AwayTeam: "KC", HomeTeam: "BUF"}
+
+	testCases := map[string]struct {
+		subscription Subscription
+		expected     bool
+	}{
+		"should match everything without filters": {subscription: Subscription{}, expected: true},
+		"should match the sport":                  {subscription: Subscription{Sports: []string{"nfl"}}, expected: true},
+		"should not match other sports":           {subscription: Subscription{Sports: []string{"mlb"}}},
+		"should match the home team":              {subscription: Subscription{Teams: []string{"MIA", "BUF"}}, expected: true},
+		"should match the away team in any case":  {subscription: Subscription{Teams: []string{"kc"}}, expected: true},
+		"should not match other teams":            {subscription: Subscription{Teams: []string{"MIA"}}},
+		"should match the event type":             {subscription: Subscription{EventTypes: []EventType{Final, ScoreChange}}, expected: true},
+		"should not match other event types":      {subscription: Subscription{EventTypes: []EventType{Kickoff}}},
+		"should need every filter to match":       {subscription: Subscription{Sports: []string{"nfl"}, Teams: []string{"MIA"}}},
+		"should match when every filter does":     {subscription: Subscription{Sports: []string{"nfl"}, Teams: []string{"BUF"}, EventTypes: []EventType{ScoreChange}}, expected: true},
+	}
+
+	for name, tc := range testCases {
+		name := name
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.subscription.Matches(event))
+		})
+	}
+}